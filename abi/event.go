@@ -6,6 +6,8 @@
 package abi
 
 import (
+	"errors"
+
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/vechain/thor/thor"
 )
@@ -50,3 +52,58 @@ func (e *Event) Encode(args ...interface{}) ([]byte, error) {
 func (e *Event) Decode(data []byte, v interface{}) error {
 	return e.argsWithoutIndexed.Unpack(v, data)
 }
+
+// EventArg is a decoded argument of event.
+type EventArg struct {
+	Name    string
+	Indexed bool
+	// Value of the argument. For indexed argument of dynamic type (string, bytes, array or tuple),
+	// only its keccak256 hash is available in topics, so it's a thor.Bytes32.
+	Value interface{}
+}
+
+// DecodeArgs decodes all arguments of event from topics and data, in the declared order.
+func (e *Event) DecodeArgs(topics []thor.Bytes32, data []byte) ([]*EventArg, error) {
+	if !e.event.Anonymous {
+		if len(topics) == 0 || topics[0] != e.id {
+			return nil, errors.New("event id mismatch")
+		}
+		topics = topics[1:]
+	}
+
+	values, err := e.argsWithoutIndexed.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]*EventArg, 0, len(e.event.Inputs))
+	for _, input := range e.event.Inputs {
+		arg := &EventArg{Name: input.Name, Indexed: input.Indexed}
+		if input.Indexed {
+			if len(topics) == 0 {
+				return nil, errors.New("insufficient topics")
+			}
+			if arg.Value, err = decodeTopic(input.Type, topics[0]); err != nil {
+				return nil, err
+			}
+			topics = topics[1:]
+		} else {
+			arg.Value, values = values[0], values[1:]
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+func decodeTopic(typ ethabi.Type, topic thor.Bytes32) (interface{}, error) {
+	switch typ.T {
+	case ethabi.IntTy, ethabi.UintTy, ethabi.BoolTy, ethabi.AddressTy, ethabi.FixedBytesTy, ethabi.HashTy:
+		values, err := ethabi.Arguments{{Type: typ}}.UnpackValues(topic[:])
+		if err != nil {
+			return nil, err
+		}
+		return values[0], nil
+	default:
+		return topic, nil
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/vechain/thor/api/accounts"
@@ -18,11 +20,15 @@ import (
 	"github.com/vechain/thor/api/debug"
 	"github.com/vechain/thor/api/doc"
 	"github.com/vechain/thor/api/events"
+	apievidence "github.com/vechain/thor/api/evidence"
+	apiliveness "github.com/vechain/thor/api/liveness"
 	"github.com/vechain/thor/api/node"
 	"github.com/vechain/thor/api/subscriptions"
 	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/api/transfers"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/liveness"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
@@ -36,6 +42,8 @@ func New(
 	txPool *txpool.TxPool,
 	logDB *logdb.LogDB,
 	nw node.Network,
+	evidencePool *evidence.Pool,
+	livenessTracker *liveness.Tracker,
 	allowedOrigins string,
 	backtraceLimit uint32,
 	callGasLimit uint64,
@@ -78,13 +86,24 @@ func New(
 		Mount(router, "/blocks")
 	transactions.New(repo, txPool).
 		Mount(router, "/transactions")
-	debug.New(repo, stater, forkConfig).
+	debug.New(repo, stater, callGasLimit, forkConfig).
 		Mount(router, "/debug")
 	node.New(nw).
 		Mount(router, "/node")
+	if evidencePool != nil {
+		apievidence.New(evidencePool).
+			Mount(router, "/evidence")
+	}
+	if livenessTracker != nil {
+		apiliveness.New(livenessTracker).
+			Mount(router, "/liveness")
+	}
 	subs := subscriptions.New(repo, origins, backtraceLimit)
 	subs.Mount(router, "/subscriptions")
 
+	if metrics.Enabled {
+		router.Handle("/debug/metrics", exp.ExpHandler(metrics.DefaultRegistry))
+	}
 	if pprofOn {
 		router.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
 		router.HandleFunc("/debug/pprof/profile", pprof.Profile)
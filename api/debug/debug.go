@@ -8,6 +8,8 @@ package debug
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
@@ -17,6 +19,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/consensus"
 	"github.com/vechain/thor/muxdb"
@@ -25,21 +28,25 @@ import (
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tracers"
 	"github.com/vechain/thor/trie"
+	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/vm"
+	"github.com/vechain/thor/xenv"
 )
 
 var devNetGenesisID = thor.MustParseBytes32("0x00000000973ceb7f343a58b08f0693d6701a5fd354ff73d7058af3fba222aea4")
 
 type Debug struct {
-	repo       *chain.Repository
-	stater     *state.Stater
-	forkConfig thor.ForkConfig
+	repo         *chain.Repository
+	stater       *state.Stater
+	callGasLimit uint64
+	forkConfig   thor.ForkConfig
 }
 
-func New(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfig) *Debug {
+func New(repo *chain.Repository, stater *state.Stater, callGasLimit uint64, forkConfig thor.ForkConfig) *Debug {
 	return &Debug{
 		repo,
 		stater,
+		callGasLimit,
 		forkConfig,
 	}
 }
@@ -109,6 +116,47 @@ func (d *Debug) traceTransaction(ctx context.Context, tracer vm.Tracer, blockID
 	if err != nil {
 		return nil, err
 	}
+	return traceResult(tracer, gasUsed, output)
+}
+
+//trace a call with given parameters, on the state of the given block
+func (d *Debug) traceCall(ctx context.Context, tracer vm.Tracer, header *block.Header, clause *tx.Clause, gas uint64, txCtx *xenv.TransactionContext) (interface{}, error) {
+	signer, _ := header.Signer()
+	rt := runtime.New(d.repo.NewChain(header.ParentID()), d.stater.NewState(header.StateRoot()),
+		&xenv.BlockContext{
+			Beneficiary: header.Beneficiary(),
+			Signer:      signer,
+			Number:      header.Number(),
+			Time:        header.Timestamp(),
+			GasLimit:    header.GasLimit(),
+			TotalScore:  header.TotalScore(),
+		},
+		d.forkConfig)
+	rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
+
+	exec, interrupt := rt.PrepareClause(clause, 0, gas, txCtx)
+	type result struct {
+		output *runtime.Output
+		err    error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		output, _, err := exec()
+		resultCh <- result{output, err}
+	}()
+	select {
+	case <-ctx.Done():
+		interrupt()
+		return nil, ctx.Err()
+	case r := <-resultCh:
+		if r.err != nil {
+			return nil, r.err
+		}
+		return traceResult(tracer, gas-r.output.LeftOverGas, r.output)
+	}
+}
+
+func traceResult(tracer vm.Tracer, gasUsed uint64, output *runtime.Output) (interface{}, error) {
 	switch tr := tracer.(type) {
 	case *vm.StructLogger:
 		return &ExecutionResult{
@@ -132,23 +180,9 @@ func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request)
 	if opt == nil {
 		return utils.BadRequest(errors.New("body: empty body"))
 	}
-	var tracer vm.Tracer
-	if opt.Name == "" {
-		tracer = vm.NewStructLogger(nil)
-	} else {
-		name := opt.Name
-		if !strings.HasSuffix(name, "Tracer") {
-			name += "Tracer"
-		}
-		code, ok := tracers.CodeByName(name)
-		if !ok {
-			return utils.BadRequest(errors.New("name: unsupported tracer"))
-		}
-		tr, err := tracers.New(code)
-		if err != nil {
-			return err
-		}
-		tracer = tr
+	tracer, err := createTracer(opt.Name)
+	if err != nil {
+		return err
 	}
 	blockID, txIndex, clauseIndex, err := d.parseTarget(opt.Target)
 	if err != nil {
@@ -161,6 +195,98 @@ func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request)
 	return utils.WriteJSON(w, res)
 }
 
+func (d *Debug) handleTraceCall(w http.ResponseWriter, req *http.Request) error {
+	var opt *TraceCallOption
+	if err := utils.ParseJSON(req.Body, &opt); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if opt == nil {
+		return utils.BadRequest(errors.New("body: empty body"))
+	}
+	tracer, err := createTracer(opt.Name)
+	if err != nil {
+		return err
+	}
+	header, err := d.handleRevision(req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	txCtx, gas, clause, err := d.handleTraceCallOption(opt)
+	if err != nil {
+		return err
+	}
+	res, err := d.traceCall(req.Context(), tracer, header, clause, gas, txCtx)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, res)
+}
+
+// createTracer creates the named tracer, or the struct logger if name is empty.
+func createTracer(name string) (vm.Tracer, error) {
+	if name == "" {
+		return vm.NewStructLogger(nil), nil
+	}
+	if !strings.HasSuffix(name, "Tracer") {
+		name += "Tracer"
+	}
+	code, ok := tracers.CodeByName(name)
+	if !ok {
+		return nil, utils.BadRequest(errors.New("name: unsupported tracer"))
+	}
+	return tracers.New(code)
+}
+
+func (d *Debug) handleTraceCallOption(opt *TraceCallOption) (*xenv.TransactionContext, uint64, *tx.Clause, error) {
+	gas := opt.Gas
+	if opt.Gas > d.callGasLimit {
+		return nil, 0, nil, utils.Forbidden(errors.New("gas: exceeds limit"))
+	} else if opt.Gas == 0 {
+		gas = d.callGasLimit
+	}
+
+	txCtx := &xenv.TransactionContext{
+		GasPrice:   new(big.Int),
+		ProvedWork: new(big.Int),
+		Expiration: opt.Expiration,
+	}
+	if opt.GasPrice != nil {
+		txCtx.GasPrice = (*big.Int)(opt.GasPrice)
+	}
+	if opt.ProvedWork != nil {
+		txCtx.ProvedWork = (*big.Int)(opt.ProvedWork)
+	}
+	if opt.Caller != nil {
+		txCtx.Origin = *opt.Caller
+	}
+	if opt.GasPayer != nil {
+		txCtx.GasPayer = *opt.GasPayer
+	}
+	if opt.BlockRef != "" {
+		blockRef, err := hexutil.Decode(opt.BlockRef)
+		if err != nil {
+			return nil, 0, nil, utils.BadRequest(errors.WithMessage(err, "blockRef"))
+		}
+		if len(blockRef) != 8 {
+			return nil, 0, nil, utils.BadRequest(errors.New("blockRef: invalid length"))
+		}
+		copy(txCtx.BlockRef[:], blockRef)
+	}
+
+	value := new(big.Int)
+	if opt.Value != nil {
+		value = (*big.Int)(opt.Value)
+	}
+	var data []byte
+	if opt.Data != "" {
+		var err error
+		if data, err = hexutil.Decode(opt.Data); err != nil {
+			return nil, 0, nil, utils.BadRequest(errors.WithMessage(err, "data"))
+		}
+	}
+	return txCtx, gas, tx.NewClause(opt.To).WithValue(value).WithData(data), nil
+}
+
 func (d *Debug) debugStorage(ctx context.Context, contractAddress thor.Address, blockID thor.Bytes32, txIndex uint64, clauseIndex uint64, keyStart []byte, maxResult int) (*StorageRangeResult, error) {
 	rt, _, err := d.handleTxEnv(ctx, blockID, txIndex, clauseIndex)
 	if err != nil {
@@ -260,10 +386,46 @@ func (d *Debug) parseTarget(target string) (blockID thor.Bytes32, txIndex uint64
 	return
 }
 
+func (d *Debug) handleRevision(revision string) (*block.Header, error) {
+	if revision == "" || revision == "best" {
+		return d.repo.BestBlock().Header(), nil
+	}
+	if len(revision) == 66 || len(revision) == 64 {
+		blockID, err := thor.ParseBytes32(revision)
+		if err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
+		}
+		summary, err := d.repo.GetBlockSummary(blockID)
+		if err != nil {
+			if d.repo.IsNotFound(err) {
+				return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
+			}
+			return nil, err
+		}
+		return summary.Header, nil
+	}
+	n, err := strconv.ParseUint(revision, 0, 0)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
+	}
+	if n > math.MaxUint32 {
+		return nil, utils.BadRequest(errors.WithMessage(errors.New("block number out of max uint32"), "revision"))
+	}
+	h, err := d.repo.NewBestChain().GetBlockHeader(uint32(n))
+	if err != nil {
+		if d.repo.IsNotFound(err) {
+			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
 func (d *Debug) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("/tracers").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceTransaction))
+	sub.Path("/tracers/call").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceCall))
 	sub.Path("/storage-range").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleDebugStorage))
 
 }
@@ -0,0 +1,106 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package debug_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/debug"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+var ts *httptest.Server
+
+func TestTraceCall(t *testing.T) {
+	initDebugServer(t)
+	defer ts.Close()
+
+	accs := genesis.DevAccounts()
+	transfer, _ := builtin.Energy.ABI.MethodByName("transfer")
+	data, _ := transfer.EncodeInput(accs[1].Address, big.NewInt(1))
+
+	res, statusCode := httpPost(t, ts.URL+"/debug/tracers/call", &debug.TraceCallOption{
+		Name:   "call",
+		To:     &builtin.Energy.Address,
+		Data:   hexutil.Encode(data),
+		Caller: &accs[0].Address,
+	})
+	assert.Equal(t, http.StatusOK, statusCode)
+	var call map[string]interface{}
+	if err := json.Unmarshal(res, &call); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "CALL", call["type"])
+	assert.Equal(t, hexutil.Encode(data), call["input"])
+
+	// default struct logger
+	res, statusCode = httpPost(t, ts.URL+"/debug/tracers/call", &debug.TraceCallOption{
+		To:     &builtin.Energy.Address,
+		Data:   hexutil.Encode(data),
+		Caller: &accs[0].Address,
+	})
+	assert.Equal(t, http.StatusOK, statusCode)
+	var result debug.ExecutionResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Failed)
+	assert.NotEmpty(t, result.StructLogs)
+
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/call", &debug.TraceCallOption{Name: "unknown"})
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad tracer name")
+
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/call?revision=4294967296", &debug.TraceCallOption{})
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad revision")
+
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/call", &debug.TraceCallOption{Gas: 100000000})
+	assert.Equal(t, http.StatusForbidden, statusCode, "gas exceeds limit")
+}
+
+func initDebugServer(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, err := genesis.NewDevnet().Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b0)
+
+	router := mux.NewRouter()
+	debug.New(repo, stater, 10000000, thor.NoFork).Mount(router, "/debug")
+	ts = httptest.NewServer(router)
+}
+
+func httpPost(t *testing.T, url string, body interface{}) ([]byte, int) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.Post(url, "application/x-www-form-urlencoded", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, res.StatusCode
+}
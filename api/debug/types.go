@@ -14,6 +14,20 @@ type TracerOption struct {
 	Target string `json:"target"`
 }
 
+type TraceCallOption struct {
+	Name       string                `json:"name"`
+	To         *thor.Address         `json:"to"`
+	Value      *math.HexOrDecimal256 `json:"value"`
+	Data       string                `json:"data"`
+	Gas        uint64                `json:"gas"`
+	GasPrice   *math.HexOrDecimal256 `json:"gasPrice"`
+	ProvedWork *math.HexOrDecimal256 `json:"provedWork"`
+	Caller     *thor.Address         `json:"caller"`
+	GasPayer   *thor.Address         `json:"gasPayer"`
+	Expiration uint32                `json:"expiration"`
+	BlockRef   string                `json:"blockRef"`
+}
+
 type ExecutionResult struct {
 	Gas         uint64         `json:"gas"`
 	Failed      bool           `json:"failed"`
@@ -246,7 +246,7 @@ func swaggerUiSwaggerUiJs() (*asset, error) {
 	return a, nil
 }
 
-var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfd\x93\xdb\xb8\x91\xe8\xef\xf3\x57\xa0\x9c\x57\x4f\xde\x94\xad\x01\xc1\x2f\x70\x7e\xdb\x5d\x3b\xb7\x53\xd9\x8b\xfd\xbc\x7e\xc9\x55\x5d\x5d\x3d\xe1\xa3\x21\x31\xa6\x48\x85\x84\x66\x34\x6f\x73\xff\xfb\x15\x00\x92\x22\x25\x8a\x92\x66\x34\x9b\x71\x62\x6d\xd5\xd6\x98\xc4\x47\xa3\xbb\xd1\xe8\x6e\x74\x37\x8b\x15\xe4\x6c\x95\xde\x20\x7f\x8a\xa7\xde\x55\x9a\xab\xe2\xe6\x0a\x21\x9d\xea\x0c\x6e\xd0\xe7\x45\x51\x42\xa5\xaf\x10\x92\x50\x89\x32\x5d\xe9\xb4\xc8\x6f\xd0\xdf\xaf\x10\x42\xe8\xd3\xfb\x5f\x3e\xab\x75\x86\xbe\xff\x78\x8b\x74\x81\x98\x10\x50\x55\xe8\xcf\xf0\xe3\x82\xa5\xb9\xed\x8a\xfe\x04\xfa\xbe\x28\xbf\x5c\xd9\xf6\xff\xf9\xb1\x2c\xfe\x0a\x42\xa3\x9f\x8a\x25\xfc\xd7\xeb\x85\xd6\xab\xea\xe6\xfa\x7a\x9e\xea\xc5\x9a\x4f\x45\xb1\xbc\xbe\x03\x61\xfa\x5e\xeb\x45\x51\x7e\x77\x85\x50\x96\x0a\xc8\x2b\xb8\xb1\xdd\x73\xb6\x84\x1b\xf4\xf3\xbf\x7d\xfc\xd9\xc0\x6a\x1f\xad\xcb\xec\x06\x4d\x9a\x81\xee\xef\xef\xa7\xf3\x7c\x3d\x2d\xca\xf9\x75\xdd\xb3\xba\xce\xe6\xab\xec\xad\x59\x1b\xe4\xd3\x85\x5e\x66\x93\x2b\x84\xee\xa0\xac\xec\x3a\xbc\x69\x30\xc5\x57\x57\x15\x94\xe6\x91\x99\xe6\x6d\x3d\xe6\xf5\xc4\x4e\xd0\x5b\x75\x56\x08\x96\x21\x03\x1b\xca\x0b\x09\x57\x57\x9a\xcd\xeb\x4e\x0e\xb6\xef\x85\x28\xd6\xb9\xae\xf6\xbb\x7e\xef\x70\xe3\xb0\x64\xda\xa0\x82\x1b\x54\x54\x9d\xde\x9f\x4b\x96\x57\x4c\x98\x0e\xa3\x23\xe8\x7e\xbb\xa6\xfb\x0f\x59\x21\xbe\x8c\x76\xe4\x4d\x8b\xa6\xcb\xcf\xc5\x7c\xb4\x03\xdc\x41\xae\xd1\xff\x76\x33\x2a\x28\x51\xe6\x3a\x34\xfd\xff\x64\xb0\x30\xd2\xdf\x60\x09\x55\x9a\xe9\x75\x85\x0c\x63\x75\xba\xfe\xb2\xe6\x6d\x97\x01\x18\xea\xd7\x1c\x50\x9a\x6b\x30\x2c\x08\x12\x55\xeb\x3d\x9c\xbd\x03\xbe\x9e\xef\x77\xb7\x8f\xd1\x5a\xa7\x59\xaa\x53\x70\xe3\x5f\xad\x98\x5e\x58\x72\x5d\xd7\x34\xa8\xae\x7f\x65\x52\x96\x50\x55\xff\xed\x38\x6c\xc5\x4a\xb6\x04\x5d\xb3\x82\xf9\xbd\x45\xff\xab\x04\x75\x83\x26\xbf\xbb\x16\xc5\x72\x55\xe4\x60\xba\x6d\xdb\x5d\x7f\xef\x06\xb8\xcd\x3f\x32\xbd\x98\x9c\xda\xeb\x13\xdc\xa5\x86\x03\x6f\xf3\xff\xb3\x86\xf2\xc1\xf5\x9b\x83\x6e\xa6\x6d\x18\xab\x19\xae\xc7\x58\x08\x55\xeb\xe5\x92\x95\x0f\x37\xe8\x13\xe8\x32\x85\x3b\x68\xb9\x4a\x82\x66\x69\x56\x37\x1b\xd8\xb2\xe6\x97\xe6\x22\x5b\x4b\xa8\xd0\x8c\xb3\x8c\xe5\x02\x66\x6f\xd0\x0c\x72\x28\xe7\x0f\x33\xc4\x72\x89\x66\x0b\x56\xfd\x58\x48\xf3\x9c\x3f\xb4\x43\xcf\x6a\x5c\xcd\xa6\xe8\xfb\xbc\x7d\x7a\x9f\xea\xc5\xb6\x03\xe2\x80\x7e\xaf\xcb\x35\xfc\x1e\xa5\x15\x62\x48\x14\xb9\x2e\x99\xd0\xd3\xab\x76\xf6\x9f\xd2\x4a\x17\x65\x6a\x76\x52\x1f\x68\x24\x58\x6e\xfa\xff\x6d\x0d\x65\x0a\xd2\x4c\x5d\xad\x40\xa4\xea\x21\xcd\xe7\x68\x56\xd6\x28\x9b\xd9\x06\x0f\xa8\xd2\x65\x9a\xcf\xa7\xf5\xb8\x25\x54\xab\xc2\xec\xf7\x2d\xd6\x26\x04\xe3\xc9\xf6\x9f\x3b\xe8\xf8\xf0\xc7\xce\x1b\x03\x26\xe4\xba\xdb\x18\x21\xb6\x5a\x65\xa9\x60\xa6\xf9\xf5\x5f\xab\x22\xef\xbf\x45\xa8\x12\x0b\x58\xb2\xdd\xa7\x68\x90\xf4\xae\x6d\x75\x5d\xd3\x71\xe2\xd0\xb1\x2a\xaa\x76\x4e\x09\xab\x12\x04\xd3\x20\x6f\x90\x41\xe0\x99\x8c\xf0\x7e\x03\x62\xad\xb7\x7c\x20\x9a\x9d\x79\x90\x0b\x74\x81\xaa\x74\xb9\xce\x98\x86\x96\x4c\x68\x09\x7a\x51\x48\x24\x58\x96\xbd\xb1\xa4\x2d\xd6\x1a\x55\x90\x4b\x43\x82\x8e\xdc\x69\xa5\x09\xb2\xf2\x7a\xda\x8e\xda\xfe\x71\xab\x27\x15\x5a\x57\x60\xce\x07\x23\x49\x2a\x9d\x2e\xcd\x54\x73\x66\x1e\xb3\x39\x58\x4e\x03\x0b\xb6\x19\xb0\x84\x6a\x9d\x69\x54\x28\xc3\x35\x19\x5b\x57\xb0\x25\xed\xdf\xd6\x50\xe9\x1f\x0a\xf9\xb0\xc5\x44\x6f\x51\xac\x9c\xaf\x97\x06\xcf\x6e\xcc\xfc\x2e\x2d\x8b\xdc\x3c\x68\x9b\x9b\x31\xd2\x72\x07\xb7\x83\x74\x1f\xa7\xfa\x30\xcd\xc7\x28\xfe\x23\xcb\xb2\x77\x4c\xb3\xc9\xd7\xc5\xa8\x06\xec\x4f\x96\x24\x93\x9e\xc0\xfc\xfd\xcd\x1e\xe7\xee\x0b\xcd\xc7\x0a\xc0\x47\xb0\x3b\xe2\x4c\x8b\x85\x61\x1b\xc3\xf1\xd5\xe9\x2c\xbf\xe5\x3c\xcb\x72\x1d\xde\xfe\xe7\xe0\xbb\x1f\x0c\x5e\xbe\x52\xe6\x6b\x61\x6f\x38\xb0\xcb\x82\xfb\x0c\x78\x40\x74\xfe\x23\xf9\x92\x3f\x68\x38\x93\x21\x5b\x19\x2c\x61\x95\x15\x0f\x86\x8d\x7e\x0b\x09\x3c\x34\xed\x61\x59\xdc\x19\xfe\x77\xbf\xfb\x1d\xfa\x7c\xfb\xf1\x97\x2e\x69\xdf\xa2\x99\x64\x9a\xcd\x50\x9a\x37\xdb\x07\xf1\x42\x3e\x18\x65\x40\x2f\x3a\x68\xa9\xc7\xae\xe7\x3e\x38\x82\xe3\xd6\xde\x10\xe5\x3a\xd7\xe9\xb2\x3b\x14\xab\xaa\x74\x9e\x83\xec\x2a\xd7\xf7\x8b\x54\x2c\x6c\xfb\x76\x7d\x06\x5f\x50\xaf\x12\xe4\x3f\xc5\x1e\xff\x4a\xb7\xf7\xa1\x9d\xbd\xd5\xc6\xaf\x0d\x65\xff\x59\x54\xf2\xe3\xaa\x58\xaa\x10\xcb\x1f\xa6\xe8\x27\x28\xa1\x66\x5a\x09\x86\xe1\xf7\x98\xfd\x2b\x53\x77\x8d\x4d\x70\x90\xc6\xc6\x0c\x60\x73\xb8\xfe\xf5\x0b\x3c\xfc\xd6\xf6\xd7\x2f\x6e\xee\x3f\xc2\xc3\x4b\xe1\x92\x1a\x1b\xe8\x8e\x65\xeb\x23\xec\xa2\x8a\x12\xcd\xd3\x3b\xc8\xd1\x17\x78\xf8\xca\x38\xa2\x46\xbc\x63\x8a\xae\x23\xe3\xfa\xd7\x54\x3e\x9e\x0b\x3e\x6f\x6e\xdf\x9d\x4b\x49\x76\xbf\x73\xc8\x1f\xed\xf2\x13\x30\x79\x6e\x9f\x8f\xee\xe8\x3e\x95\x5f\xf6\x7c\x40\x43\x3c\xd3\xc1\xdb\x38\xa7\xf0\x07\x74\xfb\x6e\x8a\xfe\xb2\x80\x1c\xcd\x56\x0e\x92\x99\x3d\x49\xcb\x35\xbc\x41\x0c\xad\x1a\xc5\x62\xe3\x0c\xf9\x7c\x9d\x65\x68\xb6\x04\x73\x02\x2f\xd3\xf9\x42\x9b\x33\xb3\x04\xbd\x2e\x73\x90\x2f\x90\xd5\x8a\x1c\x3e\xa8\xfd\xc7\x06\x93\x2c\xcb\x86\x5f\x1d\x22\x5a\xc3\xa2\x9f\x37\x93\xc1\x5e\xab\xb2\x58\x41\xa9\xd3\xee\xba\xfb\x3f\x83\xb7\x43\xef\xba\x7a\x82\x62\x59\x05\x07\xdb\x8d\xc3\xf6\xef\xb0\x3d\xef\x2f\xb4\xe0\x4f\xec\xfe\xeb\x5c\xf3\x0e\x9b\x95\xec\x7e\x60\x6b\x6c\x7f\xb0\x61\xcb\x55\x06\x43\xd0\xa6\xf2\x06\x4d\xf0\x26\x90\x10\x7b\x8a\xc8\x90\x52\xc6\x28\xf3\x80\x61\xac\x80\xfa\x1e\x91\x09\x49\xa2\x48\xb2\x80\x04\x32\x49\xfc\x84\x85\x9e\xa7\x04\xe6\x40\x3d\x88\x42\xc5\x64\x48\x98\xa2\x43\x40\x5a\xf5\xfc\x33\x9b\xdf\x20\x6f\xe0\xad\x55\xe1\x3f\xd9\xc5\xe3\x0d\x76\x3f\xaf\x19\x7b\x68\x38\xd8\xac\xd2\x92\xb9\x05\xfb\x78\x68\x3e\xab\xb0\x57\x37\xe8\x3f\xff\x6b\xe0\xed\x9c\x55\x1f\xcb\x54\xc0\x8f\x85\x99\xd3\x23\x74\xb8\xcd\x0d\x22\x1e\xc6\x43\xc3\x17\x65\x3a\x4f\x73\x0b\x6e\x1c\x46\xb1\xa4\x3e\x8f\x39\x95\x14\x33\x29\x05\x27\xd4\x63\xb1\x27\xc3\x40\x89\x98\xfb\x7e\x14\x28\x05\x72\x68\x19\x12\x32\x98\x33\x5d\x94\x37\x56\xe6\x0c\xb4\xc8\x8b\x5c\x80\x9d\x67\x17\xf7\xc3\xe3\x19\x51\x56\x7d\xc8\x0f\x8e\x57\xa5\xff\x1f\x6e\x90\x47\x87\x16\x75\x98\x89\x2d\x7d\x6e\xdf\xf5\xc8\x23\x82\x90\x26\x41\x92\xd0\x90\x45\x92\x46\x3c\xf6\xfc\x24\x4a\x30\xa7\xd4\xf3\xa4\xf4\x79\x10\x05\xb1\xc0\x44\x06\x2a\xf0\x84\x04\xc5\x63\xe9\x13\x9f\xc4\xc3\x2c\x6c\x67\xf8\xd3\x7a\xc9\xa1\x1c\x66\x91\xba\xc9\xe7\x74\x09\x95\x66\xcb\xd5\x0d\xf2\x42\xe2\x7b\x61\x44\x62\x6f\xf8\x18\xbd\x2e\x41\x40\xba\xd2\xbf\xe5\x71\xba\x77\x36\x5e\xf0\x90\x43\xf5\x7a\x4e\x39\xec\x5e\xde\x19\x75\x50\x2e\x1f\x91\xca\x6e\xcd\xfb\x4c\xd3\x91\xc9\xdd\xc7\x67\xb1\xf5\x09\x13\x3b\xa1\xbb\xcb\x5f\xfb\xde\x97\x73\x88\xfb\x63\xb1\x5c\xa6\xfa\x74\xfd\x25\xcd\x8d\x50\x1f\x75\xc8\xfd\xe3\xac\xef\xde\xb1\xf9\x82\xf8\x6d\xf4\x2c\xfd\x8f\xdb\x77\x8e\xa8\xee\x2e\xf0\xfa\xd7\xe6\x5a\xe5\xf1\xba\xf7\xd6\x24\x3a\x4b\x60\xbc\xdf\xac\x58\x2e\xe1\x64\xa1\xd1\xb9\xde\x1c\x12\x17\x76\x3d\x27\x08\x08\x54\x94\x28\xb7\xd2\xf6\x8d\xf9\x73\xc2\xa1\xd2\x13\x6b\x52\x65\x4c\x5b\x7f\x95\x19\x68\x8a\x6e\x15\x9a\x41\x0d\x62\x73\xe5\x54\xd8\x21\x3b\xfa\x73\x96\xf5\x2e\x63\x11\xcb\x8a\x7c\x6e\x35\xe9\x76\x52\xbd\x80\xb4\x6c\x04\x58\x85\xee\xd3\x2c\x33\x3a\x35\x2c\x39\x48\x09\x12\xad\x73\x09\x25\x9a\x75\x87\x99\x21\x95\x42\x26\x51\x9a\x57\x1a\x98\x44\x85\x42\xa9\x7c\x89\xa6\xff\x73\x68\xdf\x96\xcc\xc3\x27\xe5\x78\xc7\xdb\xea\x73\xb9\xce\x0f\x74\x3d\xae\xc7\xee\x0b\xb9\xa1\x5f\x0f\xb1\xdd\x13\xea\xf6\x5d\x75\xa8\xcf\xa0\x68\xae\x27\x7d\x58\xc1\x0d\x62\x65\xc9\x1e\x0e\xb6\x49\x35\x2c\x47\x20\x6a\x06\x71\xd7\xa1\x23\xcd\x1a\xed\xd7\x68\x32\x84\x06\x9c\xb3\x10\x83\x8a\xe3\x98\xd2\x44\x29\x8f\xf9\x51\x0c\x12\x73\x9f\xca\x10\xc2\x88\x44\xb1\x17\x04\x71\x2c\x02\x2c\xc1\xa7\x32\xf6\x04\x48\x19\xa9\x44\xb1\x20\x1e\xd4\x64\xfe\x35\x68\xde\xee\xdb\x03\xfb\x7e\x67\xbf\x3f\x2f\xe5\x47\x10\xde\xfc\x1e\x67\xea\x9e\xd6\xfb\xa0\x86\x82\xf6\xb0\x56\x0b\xd2\x5a\x4a\xef\x74\x38\x68\x97\xe5\xb5\x56\xec\x93\xd0\x27\xc1\xde\xeb\xda\x68\xc3\x18\x07\x2a\x12\x82\x52\xce\x83\x88\x44\x2c\x21\x09\x8e\x63\x8f\x02\x25\x8a\x84\x21\xa7\xca\x58\x6b\x41\xe8\xb3\x98\x02\x8d\x93\x18\x38\x15\xc0\x7c\x3f\xf1\x39\xf1\xc2\x7d\xf8\x9d\xa9\xe0\xc7\xfe\xbe\xee\xc5\x4a\xc8\xf5\xd6\x1e\x30\x13\xf3\xd8\xc7\x92\xcb\x04\x2b\x90\x38\x91\x5e\x14\x72\x25\x95\xef\x0b\x81\x01\x64\x10\x83\xc0\x11\x4d\x7c\xaa\x22\x80\x98\xc7\xc2\x23\x2c\x00\x96\xd0\x01\xbb\x48\x77\x75\x7c\xdf\x27\x51\x9c\x0c\x18\x61\x73\x56\xfd\x9c\x2e\x53\x7d\x83\x3c\x8f\x84\x7e\x18\x27\x7b\x4d\x38\xe4\xa0\x52\x91\xda\x33\x72\x82\x37\x3c\xc0\x49\x20\x48\xa8\x68\x24\x23\x42\x95\x94\x61\xec\x31\x25\x02\x1c\xc7\x0a\x4b\xec\x25\x11\x53\x3c\x18\x30\x60\xe7\xac\xfa\xbf\x95\x51\xaf\x86\x0d\x42\x5d\x68\x96\xfd\x22\x8a\xd2\xd8\x56\x98\x24\x09\xdd\xb7\x28\xf5\xa6\xfa\x54\x14\xda\x02\x42\x13\xa9\x64\xa2\x84\xf4\xb0\x48\x20\xf4\x65\x44\xc3\x84\x08\x45\x79\x18\x60\x4e\x28\xe6\x31\x91\x3e\xf5\x38\x8d\x68\x48\x7c\x42\xfc\x24\x21\xca\x07\x9c\x30\x8a\x23\xce\x07\x70\xb6\xa9\xfe\x00\x4c\xaf\x4b\xa3\x0f\xef\x03\x58\x69\xa6\x61\x3b\x7d\xc4\x85\x88\x24\xf1\x02\x2e\x12\x49\x25\x96\x20\x39\xf3\xb0\x47\x58\xe4\x0b\xea\x7b\xb1\xf4\x12\x01\x49\xac\x22\x2c\x28\x23\xa0\x42\x11\x26\x9c\xcb\x00\xcb\x80\x44\xde\xfe\xf4\xcd\x4e\x6f\xa7\xf0\xc2\x98\xc6\x40\x42\xdf\x17\x41\x8c\x81\xb2\x88\x52\x88\x84\xf4\x62\xe6\x01\x78\x44\xd2\x20\x34\x52\x57\x86\x8a\x12\x49\x84\x87\x13\x20\x32\x22\x24\x92\x14\xc2\x60\xc0\xe8\xb7\xd7\x5b\xa5\x1d\x9c\xf1\x98\x93\x58\x89\x04\x62\x49\x12\x95\x28\x02\x21\x97\x7e\xe4\xc5\x41\xcc\xc2\xd0\x0b\x25\x16\x82\xc8\x01\x38\x53\x27\x2a\x77\xd4\xe4\x16\x89\x47\x24\xe1\xdb\xcb\x9c\x1a\x46\xf1\xcc\x8a\x79\x75\x6d\x03\xcb\x8e\xdb\x12\x6d\x7c\x5a\x47\xe3\xfb\x43\x9a\x69\x28\xeb\xd0\xb4\x6c\xdb\xe0\x80\xd2\xf7\xbe\x6d\x87\x58\x09\xe6\x50\x90\x6b\xe1\xa2\x8b\x66\x1f\x3e\xfe\xbf\x9f\x3f\xfc\x9b\xbd\x6b\x7c\xff\xe7\x7f\x7f\xa1\x66\x86\x5d\x80\x5b\xf4\x0b\x34\x36\xc6\xce\xb1\x83\xe7\xd7\xa3\x15\x05\x8b\x8b\xa1\xf3\xe6\xd8\x59\x3f\xe6\xa5\x1c\x9b\xf0\xe7\x62\xbe\xb5\x83\x2d\xe7\x36\xa1\x90\x4f\x62\xde\xdd\x78\x4a\x74\x98\x7f\x3f\x77\x9b\x5a\x16\x2e\x41\x14\xa5\x39\x4c\x8b\x1c\xfd\xf9\xfd\xe7\x76\xb0\x7e\x38\xdc\x8b\xe2\xe1\x66\x11\xdf\xd8\xb8\x87\x8e\x7f\x18\x27\xe7\x85\x84\xeb\xdc\x85\x66\x5f\xaf\xa0\xb5\xf6\x47\xcc\xef\x3f\x6d\x6f\xb1\xf7\x8d\x6f\x51\xe4\x39\x08\x0d\x12\xd9\xc1\x5e\x1e\x7d\x0f\xd2\x70\x0c\x65\x1f\x01\xca\x5f\x34\xd3\x95\x43\x5a\xd5\x8d\x58\x76\xfe\x93\xa3\x58\xdb\x8f\x72\xee\xa0\xef\xf5\x5f\x80\x57\x85\xf8\x02\xfa\xbb\x4e\xbc\x73\x0e\xf7\xdb\x40\x6d\xf4\xd8\x38\xa6\x8f\x45\x95\xea\xfd\x38\xa6\x97\x47\x99\x47\x7a\x47\x0f\x9a\x8c\xe3\xdd\x3e\xf0\xaa\xc8\x40\x0f\x28\x59\xe3\xfb\xee\xb8\xa5\x38\xe6\x19\x18\xec\x70\xcc\x02\x1c\xb5\xfe\x4e\xb0\xf9\x2f\x6b\xef\xef\x6f\x80\x8e\x0a\x77\xf9\x0d\x60\x07\x3f\x72\x32\xba\x18\xaf\x8a\xe9\xb4\x52\x0f\x48\x94\xa9\x86\x32\x65\x46\x99\xb3\xde\xba\xbd\x90\xb5\x4b\xee\xa3\x6d\x02\x02\x93\xb2\xec\x50\x20\xcd\x6f\xdc\xf4\x9d\x67\x43\xac\x7f\x80\x80\xfd\xd0\x33\x17\xda\x82\x0a\x55\x6b\xbb\xb0\x4c\xb5\x86\x72\x0f\x06\x8d\x9f\x09\x02\x5d\xac\x52\x81\x5b\x00\xf6\x27\xf6\x9e\x73\x62\x6f\x64\x62\xf2\x9c\x13\x93\x91\x89\xfd\xe7\x9c\xd8\x1f\x99\x38\x78\xce\x89\x83\xdd\x89\xbf\xfe\x13\xe2\xa0\xad\xf0\x3c\x27\xc4\xe3\xee\xda\x5a\xad\x6c\xb7\xd3\x55\xef\xcf\x1d\xd1\xdb\xb7\x41\x2e\x2f\x7d\x9b\xf1\x2f\x23\x80\x9f\x47\xee\xea\xcd\x07\x1b\x89\xf0\x4c\xbb\xc2\xf9\x5c\xba\x22\x58\x6f\xea\x05\x1b\xe6\x66\x69\xee\xc2\x91\x1b\x54\xed\xc1\x57\x41\x2e\xe1\x37\x38\x19\x74\xf1\x05\xf2\xdd\xd9\x1a\x20\x4a\x10\xe9\x2a\xed\x8a\x93\x67\x86\x63\x77\xc2\xaf\x41\x8c\x3c\xc5\x5a\x7b\xa1\xd2\x64\xc0\x5c\x01\xf6\x2c\xca\x5a\x27\x05\x61\x52\x21\x33\xcb\x49\x42\xa3\xde\x43\xcd\xe8\x86\x81\xb6\x76\xcf\x1b\x1b\x6a\xcf\xb3\xa2\x58\x22\x65\x3d\x06\x66\xaf\x31\x8d\xec\x92\xab\xd4\xd8\x99\xf6\xae\x85\x29\xe5\xac\xce\x9a\x0f\xb7\xf1\xd1\x97\x94\x39\xff\x0c\x3c\xfc\x03\xb0\x03\xf7\x34\xa7\xf2\xef\x30\x4b\x91\x6f\x3c\xf5\x2f\xcd\x53\xe4\xe9\x4c\x25\x81\xaf\xe7\x46\xa5\x11\x2d\x66\xc7\xbc\xaa\xdb\x84\xef\x6e\x5c\x51\x09\xcc\x66\x03\xba\x61\x06\xb8\xa5\x1d\x41\x15\x65\x9b\xd5\xd4\x12\xe3\xa5\x39\x4b\x05\x94\x1f\x2c\xdc\x13\xd7\xfc\xea\xe5\xb1\x8d\x53\x0f\x5c\x11\x83\x0e\x1d\xeb\x34\x86\xb7\x25\xcb\xe7\xf0\x48\x6a\xb6\x6e\xc5\x26\x27\xc2\x0e\x36\x2e\x02\x8c\x06\xb2\x80\x7e\x56\xba\xcb\x91\xa8\xf7\xf1\xcb\xa4\x75\x9d\x0e\xf1\xc9\x2c\xb0\xa6\xf8\xcb\x23\xf5\xa9\x0b\x98\x5c\x5d\x6d\x5b\x98\x61\xea\x46\x6e\xc4\x3a\x13\xa6\x19\x7e\x48\xff\xa9\xcb\x11\x74\x21\x38\x45\x0b\xad\xbb\x19\xc3\x63\x9d\xa7\x1a\xfd\xe5\xfd\xed\x1b\xb4\x2a\xa1\x82\xbc\x15\xeb\x0b\xd8\xec\x8f\xd2\x75\x91\x05\xb1\x52\x9e\x4a\xb0\x4f\x62\xc6\xb0\xa2\x1d\x95\xcd\x95\x46\x38\x17\x2a\xd7\xcb\x02\x95\xe6\x8f\x04\x4a\xa8\x88\x04\x5e\x48\x65\x98\x78\x7e\xd2\xb9\x9d\xaf\xeb\x2d\xec\xc3\xc4\x8b\x22\x03\x96\x1f\x02\xea\x7e\x01\x7a\x01\x65\x6f\xaf\x2c\x58\xd5\xcd\x51\xeb\xc1\xe0\xa2\xf6\xed\x9b\xee\x7c\x43\xc4\x13\x83\xf0\x8c\x2e\x2f\xc2\xe6\xbf\x00\x87\x24\xc2\x18\x53\xac\x24\xc6\xcc\x8b\xc2\x88\xc4\x2c\x66\x31\xf1\x71\x48\x09\x16\xc4\x97\x3e\x03\x22\x05\x8d\x98\xf4\x7c\x1c\x46\x1e\x23\x94\x24\x92\xc6\x22\x16\x9c\x06\x7e\xe8\x47\x61\x90\x10\x2e\xbd\x30\xa0\xc0\x63\x88\x95\xc0\xca\x8f\x7c\xc2\x21\xc1\x98\x24\x75\xc1\x85\x9a\x5b\xc7\x96\x61\xf3\xaf\xce\x5c\x07\x7e\xda\xcf\xab\xa1\x73\x89\x0e\x37\x43\x82\xae\xeb\x50\x36\xb6\x41\x53\x4d\xe5\xd0\x2a\x9a\xb0\xf5\x73\x77\x92\xd5\xbc\x52\x09\xb9\x4e\x55\x0a\x25\x7a\xcd\x1f\x34\x54\x3e\xf9\xee\xf0\xca\x2f\x14\x7b\xd3\x0d\x83\xdf\x83\x3a\xcd\x35\xcc\x7b\xd6\xb4\x2a\xca\x25\xd3\x37\x76\x6f\xf9\x64\x7c\x3d\x2e\x8c\x08\xbd\x5e\x40\x3a\x5f\xe8\xc1\xa5\xec\x44\x18\xed\x04\xdc\x9f\x09\x4f\x14\x8c\xc3\xb3\xce\xd3\xcd\x36\xd4\x67\x08\x9c\x4e\xf0\x8f\x7d\xdd\x09\xc9\x1e\x66\x8f\x4d\x13\x87\xf2\x8d\x3b\xfe\xa5\xb8\xa3\x9d\x78\x73\x3e\x39\xbb\x32\x65\x4b\xd4\x03\xa4\xbc\x5c\xc4\x68\xe3\xb8\x7b\x0a\xb8\x2e\x09\x09\xbd\x76\x5e\xba\x43\xec\x27\x79\x80\x49\x1c\xc4\x31\x27\x8c\x2a\x08\x04\xf5\x45\x24\x99\x82\x58\xd1\x28\x8a\x29\xe7\x1e\xa7\x8c\xca\x5a\xfc\xd6\xde\x93\xc1\x0d\xe6\xee\x5f\x8a\x7e\xe4\xc6\xb7\xbd\xb6\xcb\xdb\xdf\xf6\xda\x09\xcc\xfb\x2f\xbe\xd7\x9a\xde\xce\x04\xbf\xcd\x25\x6c\x2e\xc7\x66\xa9\x19\xce\x56\x03\xb2\xa3\xd7\x9e\xa1\xb9\xd1\xc5\x99\x86\x0a\xe9\x45\x5a\x99\xad\x3b\xb4\x8a\xfa\xac\xfd\x61\x1b\xd8\x31\xbc\xa3\xf3\x17\xb2\x35\x52\x79\x02\x59\x1b\x10\x6a\xe9\x71\xaa\xb8\x79\x76\x21\x63\x43\xac\x2f\x86\xc2\x4f\x3f\x7f\x44\x90\x1b\x0b\xa4\x0e\x31\xb7\xe3\x1b\xdb\xcb\xae\x7b\x10\x99\x9d\xe8\xee\x36\xaa\xfb\x62\xf8\x74\x23\xd6\xb0\xdc\xbe\x1b\x47\xe7\x05\x02\xc8\xf5\x8b\x92\x90\x6d\x80\xfa\x85\x81\x99\xb3\x0a\x65\x66\x60\xf4\x7a\xc9\x36\x88\x65\x59\x71\x0f\xd2\x18\xb2\x6b\x5b\x44\x29\xbd\xeb\x56\x37\x2a\x54\x2f\x6c\x67\x70\x4b\xed\x05\xd0\x77\x03\xe7\x2f\xc6\x0d\x9d\x6b\xb2\xc6\xe8\xd6\x85\xd3\xd8\x9b\xcc\x35\x54\xc2\x3d\x2b\xe5\x01\x46\x39\x3f\x7c\xbf\x09\xdb\xbf\x18\x05\x4e\x43\xf2\x10\xfc\xfd\xc4\x81\x4e\xc2\xc0\xc5\x60\xab\xd6\x4b\x8b\xdb\x2c\x43\x2c\x17\x50\xe9\x92\x65\xb5\xdf\x7f\x82\x2a\x33\xd7\x20\xed\x77\xd2\x15\x9a\x34\x85\x8b\x91\xbd\x2c\x0a\xeb\x5d\x59\xec\x62\xc9\x08\x26\x5b\x0e\xcb\x52\xfe\x00\xcd\x2f\x97\x29\xd1\xcd\x90\xb8\x98\xc8\xad\xd6\xab\x55\x51\x6a\x90\x66\x78\xa4\xea\xf1\x11\x4f\x75\x05\x7a\xf8\x78\x6d\x1e\x6d\x53\x32\x9e\x07\xd5\x4d\xad\x21\x37\xd1\x21\xf4\x5e\x2c\x13\xa4\x97\x01\xf2\xec\xcc\x33\x94\x5a\xd6\x5d\xd7\xe5\xd2\x4f\xea\xb4\x93\x33\x57\x44\xf0\x41\xa5\x72\x01\xa8\xc8\x8d\x4e\x56\xa0\xa6\x62\x9b\x51\xc7\xba\x99\xbb\xbb\xab\x39\x3d\xdf\xc5\x0e\xf0\xa3\xd5\xfa\xc6\x94\x37\x5d\x9c\xab\x0b\x4f\xda\x98\x86\xad\x5e\xf9\xc6\x15\xca\x51\x45\x39\x58\x3f\xaf\xb5\xd5\x26\x07\x96\x15\x62\x3f\x60\x2c\x4c\xb0\x47\x42\x1e\x05\x98\xf8\x0c\x93\x88\x78\x1e\xe1\x09\x95\x31\x01\x5f\x50\x08\x70\x87\x18\xa7\xba\x25\x7b\xa0\x2f\x60\x63\x89\xb3\x8d\xcf\x70\x45\xf0\xda\x8c\x01\x38\x74\xe0\x04\xb1\x92\xdc\x17\xbe\x0a\xc2\x48\x60\x8c\xf1\x16\x12\xc9\xfa\xa1\x09\xa7\x00\x92\xe6\xab\xb5\xb6\x3d\x6b\xdc\x1c\x32\x23\x5a\x4f\xe8\x18\x0d\x4f\x52\x7c\xfb\xf3\x6f\xed\xe8\xfa\xa2\x68\xb8\x4e\xcc\xf3\x58\x61\xc5\xe3\x6c\xb0\xa1\xed\x72\x0a\xe0\xe7\x9b\x62\xdb\x62\x2c\x8f\x80\xb1\xed\x6c\x21\x5d\xb1\xd4\xc1\x69\x54\x04\x05\x83\xd2\xb7\x57\xa0\xe5\xb2\x86\x80\x61\x2e\xa7\xfb\xef\xd3\xd9\xdd\xf7\xa7\x55\xd7\x5a\x18\xd4\x0b\x3a\x65\x75\xda\xe2\x3d\x67\x42\x48\x0f\x01\x98\xb1\x4a\x3b\x28\x0b\x65\xed\xd2\xaa\x91\x80\x07\xcc\x04\x3f\xe9\xfb\x42\x3e\x81\x3a\x97\x4a\xd4\x49\x66\xb4\x2a\x41\xa5\xd6\x3a\xae\x8a\x25\x9c\x6b\x9c\x74\xee\xc3\xb6\x25\x88\x2e\x46\xb8\xc9\x76\x50\x54\x42\xad\x66\x36\x25\x4e\x3f\x81\x7a\xd3\xde\xee\xf1\xdd\xc8\xff\x16\xe8\xb8\x73\xf6\x34\x55\x90\xf6\x00\xdc\x0d\xb8\x1f\x08\xb3\x1f\xad\x68\x68\xc7\xed\xe9\xd9\xdb\x7a\x4a\x97\x62\x12\x51\x80\x32\x46\x88\x39\x4b\xd6\x95\xab\x6c\x2a\x58\x26\x5c\xa1\x58\xc3\xd6\x2a\xcd\x59\x66\xf7\xd8\xca\xcc\x3e\xae\x6f\xcd\xd9\xd9\x7a\xdf\x61\x5d\xdb\x1a\x5e\x4b\xf7\x05\x03\x65\x21\xa8\xcb\xb6\x8b\x22\xaf\xd6\x4b\x07\x2c\xd4\x65\x70\xed\xf9\x7e\x44\x62\xf5\xcd\x83\x6d\x1d\xa7\x8b\x69\x52\xb7\xef\x86\x84\x41\x91\x77\x8b\xc4\xae\x4b\x6b\xaf\x77\x1b\xd4\x90\xa0\x22\x9f\x36\x4b\x34\x82\x6b\x7a\x54\xa2\xb9\xc2\x55\xc7\xc1\xef\x1d\x36\x89\x20\x61\x0c\x7e\x04\x2c\x82\x98\xb0\xc9\x55\x7d\xf1\xc3\xee\xc7\xcf\xc2\x92\xdd\x3f\x45\x2b\x68\x9c\x26\xc7\x4f\x15\x45\xa3\x84\x7a\x9c\x51\x8c\x99\x64\x32\x49\x82\x53\xae\x36\xe3\x20\x52\x94\x90\xd8\xc3\x14\x63\x8f\x92\x90\x60\x6a\xfe\x12\x98\xd3\xc0\x0b\xe2\x84\x88\x24\xf0\x93\x30\x09\x70\x42\x7d\xe2\x27\x18\x43\x14\xc4\x38\x0e\x88\x90\x34\x8e\x41\x24\x2a\x49\x70\xc4\x05\xc3\x61\xe8\x61\x08\x88\xa7\x7c\x8e\x3d\x1f\x24\x21\x9e\x4f\x02\x88\x63\xc1\x3c\x2c\xfd\x20\x8a\xb8\x4f\xb8\x47\x31\x16\x31\x01\x8f\xc4\x5e\xc2\x89\xe7\x2b\x4f\x06\xc2\x8f\xb1\x8f\x43\x3f\x49\xa4\x24\x31\x53\x49\x44\x22\x12\x05\x46\xad\xb1\xcb\x7d\x7f\x07\xe3\x91\x09\xb5\x05\xff\x98\xf3\xb1\x63\xfc\xb7\xba\xa2\xe3\xbc\x3a\x17\xd9\x05\x12\xbb\x1b\x86\xd7\xb5\x0e\x7d\x48\x3f\x3a\xbf\xe4\x9a\x0d\xef\x7f\x9c\x1c\x3c\x98\x64\xd4\xd3\x14\x2f\x56\x32\xef\x44\xc5\xf2\xb2\x93\x3b\x75\xb3\x17\x4f\x3f\xcc\x01\x2e\xc2\xfa\x5c\x06\x68\x88\x6f\x55\x8f\xca\xca\x13\xab\x88\x1f\xb2\xde\xce\xd7\xdd\x5a\xeb\xe4\x49\xa0\xd5\xbe\xa8\x23\xd0\x9d\x6f\xb6\xb8\x93\xe2\x6c\xd0\xda\xf3\x65\x14\x9c\x01\x23\xc5\x09\xcd\x6e\xf5\xbb\x61\x6a\x5e\xc2\x3d\x76\xe0\x04\x33\x1a\x01\x7b\x78\x3c\xab\x74\x9c\x84\xad\x42\x6d\x95\x80\x39\xbb\x1c\xd7\x98\x51\x9f\x72\x6e\x6c\x29\x64\xe1\x73\xb1\x4e\x87\x3c\x12\xc4\x8f\x40\x09\x2e\x38\xf7\x83\xbe\x2d\xe9\x9c\x9e\x97\x01\x64\xd4\x81\x1a\xc6\x11\x78\x34\x51\x46\xa5\xdd\x05\xe1\x0e\x4a\x3d\xc4\x0a\x47\x42\xa9\x74\xb9\x06\xb4\x04\xd6\x4d\x04\xa9\x55\x87\x7b\x56\xb5\xe3\x0e\x01\xd4\xaf\x85\x5a\xac\xf5\x6a\xad\x1f\x27\xa2\x0f\x67\x11\x34\x67\xcd\xf7\xfb\x27\xd7\x28\xa6\x07\x56\xfa\xf7\xbd\xd7\xa8\xad\xa3\xbf\x3d\xd3\x6a\xfe\x7d\x83\x52\x55\xd7\x30\x2f\x5d\x0c\xa3\x2d\x30\x5c\xdf\xc7\xa5\x15\x62\x03\xa3\x0d\x39\x51\x7a\x21\xba\x03\x48\x1c\x28\xf3\xe9\xb2\x46\x0f\x2d\xf5\xcc\xec\xfe\xb3\x73\xcb\xda\xac\xa9\xdf\x00\x80\x6d\x4e\x8a\xed\xd8\x7c\x08\xe0\x12\x81\x6d\x63\x92\x78\xc4\x7f\xf4\x44\xb7\x50\xcf\x95\x26\x58\x87\xb4\x97\xb7\x5e\xea\x6b\x23\xeb\xa1\x28\xca\xed\x97\x60\xf6\x8c\xba\xb3\xb1\xc5\xab\x22\x5b\xd7\x9f\xd8\xe8\x1b\x66\x66\x49\xe7\x9f\x09\xae\x57\x7b\x34\xbc\x5e\x56\xf3\xa9\x53\x44\x1a\x05\x71\xaf\x76\x96\x23\xb3\x3d\x15\x00\xf3\x88\xfb\x2c\x8e\x82\x01\x0f\x9e\x95\x8a\x51\x14\x06\x7e\x44\x23\x2f\x4a\x22\x20\x38\x0c\x22\x1a\xa9\x98\x74\xb8\xca\x7d\xa7\x61\x8c\xaf\x1e\x43\x78\xeb\xdb\xb2\x62\xcf\x76\x3f\x74\x70\x60\x3f\x0c\x23\x16\xfb\xc2\xc3\xe0\x53\xa5\x80\x28\x61\x14\x10\xac\x44\x22\x83\x88\x49\xec\x05\x54\xe1\x18\x48\x14\x78\x31\x78\x5e\xcc\xa5\x07\x02\x12\x99\x04\x94\x77\x6e\x9b\xf7\x05\xc3\x45\x9c\x01\x3b\x62\x60\x50\x00\x5c\x64\xa2\xfd\x14\xb4\x8b\xdf\xef\xb9\x2b\x3d\x90\x48\xae\x0d\xe5\x06\x76\xc5\x41\x8d\xe7\x9c\x23\xf4\xc0\x19\x78\xb7\x7c\x5f\x96\x27\xf9\x1f\xb7\x0c\x52\x73\x69\xef\x43\x47\xa3\x01\xca\xbf\x9d\x4b\xe8\x9b\xc0\x3a\x28\xb0\x2c\x6d\xee\x40\xfe\xa5\x28\xbf\x9c\x2d\x36\x36\x75\x67\x74\x5f\x94\x5f\x5e\x3b\x5c\x68\xc8\x2b\xa3\x74\x35\xa7\xc7\x77\x4f\xd6\xc4\x2d\x32\x4c\xc7\xa3\x33\x3c\x87\x27\x54\x6f\x3a\xc3\x1e\x85\xe0\xb1\x3e\xe1\x26\xe8\x40\x41\x09\xb9\x80\x23\xf3\xec\x9d\x32\x03\x7b\xe9\x2d\xd2\xc5\x23\xad\x44\x74\xda\xb9\x85\x4e\x3a\xbb\x50\x6f\x23\xa2\x10\xef\x1a\x67\x76\xa3\xa0\x89\xb7\xe3\xaa\x9a\xec\xb2\xfe\xe3\xfc\x2d\x1d\xee\x76\x73\x4c\xf6\xf9\xd1\xae\xd2\x67\x10\x53\x42\x08\x07\x26\x39\xf6\x29\xc1\x3e\x07\xe2\x81\x0c\x05\xc4\x22\xe1\x1e\x57\x2a\xc2\x64\xd0\xed\x8e\x7a\xf2\x77\xe8\x1b\x02\x98\x86\x9e\x60\xca\x17\xdb\xfe\x7d\x69\xd9\x3f\xd8\xf7\x05\xe1\x8e\x10\x3c\xf9\x7b\x4e\xb6\x87\xab\xf3\xe5\x12\x9a\xaa\x31\x99\x5c\x28\x55\xc1\x49\x71\x42\x03\x7e\xed\x51\x33\xc5\x8d\x8c\xd2\x1c\x2d\xcd\x92\x41\xd6\x75\xd3\x50\x37\x3c\x21\x3b\x35\x4a\xa9\x13\x34\x72\xda\xf4\x2e\x4c\xc9\x9a\xa5\x66\x56\xfb\x35\x5c\xa7\xf1\x5c\x8d\xf6\x5d\x31\xeb\x92\x81\x0a\x3a\xf9\xa6\xc6\xa4\x7a\x28\xd6\x28\x07\x90\x75\xf6\xaa\x5d\x8f\x41\xb9\x11\x55\x73\x90\x53\x04\xd3\xf9\x74\xcb\xfb\xb3\xd9\xac\xfd\xfb\xd7\x0e\x64\xaf\x5c\x0d\xeb\xea\xd5\x4d\xef\xb1\x79\x61\x11\xf6\xea\x06\xe1\x37\xfd\x17\x76\x29\xaf\xcc\xd2\xfb\x75\x29\xfe\xfb\x6a\xff\xaf\xee\xb4\xd6\xf9\xc9\x8b\x3b\x70\x62\xa6\xf6\x34\xad\x5c\xd4\x90\x23\x4e\x85\xb0\x4b\xcc\x35\x6d\xed\x1b\x17\xb7\x57\x21\x0f\x4f\xfb\x38\xa9\xe1\x46\x33\x63\xf7\xcd\x1a\x8c\xc8\x22\x9f\x68\x87\x17\x5d\x20\x09\x4b\x33\xd8\x8a\xcd\x6d\x29\xbc\x0e\x2b\x7e\xda\xa6\x23\x0e\x33\xe2\x3a\x1f\x62\x84\x7d\xed\x23\x5f\x2f\xfb\x9a\xc1\xdb\xbd\xf8\x05\x2b\x0a\xd3\x25\x5c\x0d\xf1\xcf\x6e\xe3\x11\x16\x92\xa0\xd2\xbc\xf6\x0e\xdb\x9b\xaf\x42\xa1\x99\x2a\x8b\x65\xfd\x91\x5e\x5d\xcc\xa6\xbd\x0e\x33\x3b\xf8\xac\x76\x4a\x74\xc3\x4a\xdf\xa0\x99\x81\xa8\xff\xaa\x8d\xea\x7b\x63\xa6\x62\xeb\xcc\x46\xa5\xd5\x83\xf4\x47\x6e\xff\x61\xa6\xbf\x8c\xd3\xac\xbb\x8f\xb6\x2c\x33\x10\x9d\xf1\x98\xc1\x9d\x6c\xbf\x1a\xdf\x6a\x5d\xfc\xda\x0c\x53\xb3\x7c\xb7\xbb\x50\x9a\xbb\x0d\x75\x7c\x3f\xd9\x9e\xfb\xbb\xc9\x10\xec\xd5\x0d\x7a\x65\xb1\xf9\x6a\x67\x47\x19\x2c\xda\x0d\xb5\xf3\x5c\x17\xaf\x76\x44\xfb\xf1\x5d\xd6\xec\xad\xa2\xb3\x0e\x33\x7e\x4d\x64\x0f\x6f\x3f\x14\x69\x46\xee\xac\xc8\x6d\xa4\x4a\xb3\x5c\x3a\xbd\xd2\x5e\x2c\xae\xb3\xcc\x8d\x32\xc0\x01\xd6\xde\xf9\xb1\xae\xf3\xf2\x0c\xd7\x25\x47\x0b\x5e\xb9\x7a\x54\x47\x87\x75\xd5\xa3\x4e\x6b\x46\x4e\x6b\xe6\x9f\xd6\x2c\x38\xd2\xec\x00\x2b\xb6\xb5\x73\xb6\x1c\x58\xac\xb5\x43\xc2\x14\x7d\x9f\x65\xee\x8b\x00\xae\xfe\xe7\x5f\x8b\x34\x6f\x32\x48\x67\x2c\x97\x33\x64\x08\xc0\x74\x51\x4e\x1b\xa2\xba\xef\x07\x98\xc6\xe9\x3c\x2f\xca\x33\x8e\x87\x9a\x04\x86\x75\xc7\xf3\x1a\x83\x30\x7a\x1f\x85\x31\x89\xe2\x38\xe9\xf1\xf7\x2b\x47\x24\x37\x82\x94\x8a\x84\x84\x49\x8f\x03\x11\x34\xe1\x51\x22\x08\xc7\x11\x55\xc2\x8f\xa9\x64\x2c\x09\x09\x67\xb1\xf2\x22\x5f\x04\xcc\xf3\x22\x42\x55\x18\xb2\x40\xaa\x90\xf8\xdc\x07\xf5\xea\x08\xf7\xbb\xb3\xbd\xaa\x0d\xfc\x9a\x5f\x5c\x71\x5f\xbc\x81\x30\x91\x41\x1c\x32\x0e\x51\x12\x8a\x58\x45\x31\xa3\x8c\xf8\xc4\x53\xbe\xcf\x68\x18\x71\xcc\x03\x11\x7b\xd2\xc9\x53\x87\x4f\x07\xfc\x0c\xc1\xdf\xd6\x2c\xab\xcc\x28\x4f\x5d\x42\x2b\x4a\xf7\x94\xe8\x66\x97\x9c\x85\xea\xdd\xbd\x60\x75\xc9\x27\x82\x38\xd9\xdd\x39\x63\x49\xad\x8f\x53\xef\xb7\xf2\xc3\x1d\xc8\xe3\x77\xda\x9d\xc3\x1a\x1d\x51\x3e\x3b\xe7\x7b\x27\xe2\xaa\xaf\x79\x9e\x36\x46\xad\xae\x4e\xf6\x76\xe5\x2f\x43\x1a\xea\x25\x5c\x47\x8d\x28\xed\x86\x8a\xed\x5c\x32\x8e\x69\xb8\xa6\xad\x91\x95\x75\x3d\x93\xf6\x18\xb7\xa7\xc1\x8c\x55\x62\xf6\x38\x85\x86\x55\x62\xe7\x89\x81\x62\x9f\x9c\x8d\x57\xea\x94\x13\xe1\x8c\x4c\xa4\xae\x0b\xf0\xd4\x2d\xdc\x89\x5a\x3d\xf5\x9e\xf6\x69\xd3\x9c\x73\xed\xfa\xb8\x0b\xfc\x1e\x8a\xbf\x6d\x9a\xae\x1b\xf4\xeb\xdb\x37\xf6\x7f\x6d\x3d\xe1\x31\x3a\xda\xc2\x6d\xe7\xf0\x94\x5e\x14\xe5\xf5\x9d\x37\xc5\x53\xfc\x36\x8a\x28\xe6\x09\x7d\x2b\xe1\xee\x3a\x4b\xf3\xf5\xe6\x7a\x5e\x78\x53\x0f\x4f\xfd\x4e\x7a\x27\x54\xfa\x87\x93\x93\x52\x77\xcb\x1c\xd0\x98\xfb\x2c\x90\x81\x90\xca\x13\x22\x24\x32\x8c\x78\x12\xe3\x40\x05\xc2\xa3\x0a\x13\x0c\x1e\x0f\xa8\xe4\x5c\x05\x8c\xf8\xd2\x03\x08\x94\xa7\x58\xa8\x54\x12\x74\x8f\x99\x73\x92\x40\x5a\x18\x22\x1a\x24\xf1\xd6\x2c\x04\x28\xcf\x5c\x43\x88\xc1\x23\x84\x85\x38\x04\x08\x43\x4e\x03\xdf\xf7\x70\x44\x99\x50\x92\x86\x31\xf8\x31\x93\x21\x55\x41\xe4\x33\xac\x18\x4f\x18\x53\x8a\x08\x0f\x02\x4e\x80\x48\x42\x18\xc4\x9e\x14\x5e\xa0\x24\x53\x11\x00\x93\x71\xc0\xa5\xaf\x22\x1c\x26\x41\x14\x04\x8c\xf9\xa1\x08\x29\x55\x89\x60\x11\x07\xdf\x0f\x3c\x20\x02\x3c\x2a\xa5\x08\x3c\xdf\x27\x9d\xa4\x81\x1c\xec\xcd\xec\x59\xd0\x7b\x84\x4e\xbd\xa9\x9f\x4c\x3d\x82\x6f\x3c\x8f\xf8\x9d\x1b\x8e\x34\xe7\xc5\x3a\x7f\x8a\x0b\x5e\xae\x4f\xf7\x64\x6e\x2f\x02\x68\x2d\xa7\xfe\x63\x4b\x89\x47\x85\x6c\xef\x29\x47\x17\xfd\x22\xe7\xf6\x7f\x4d\xed\xa9\x51\xa7\xd4\x4e\x9b\x31\x64\x8e\xc8\x99\x34\x97\xa9\xb0\x59\xa7\xdd\xaa\x2b\x75\x71\x33\x57\xab\xcc\x7e\x7a\x77\x91\x56\xee\x0e\x94\x83\xb0\x71\xb9\x25\xcb\xc5\xa2\xfb\xa9\x9c\x6e\x45\xa8\x4b\xc8\x8e\x01\xd9\x15\xf0\x07\x0d\x3b\xcf\x78\x3a\x2f\xd9\x72\xe7\x61\xef\x6e\xd6\x3d\x82\xbb\xa5\x4c\xab\x9d\x87\x79\x51\xac\x76\x1e\x15\x2b\x1b\xd6\xb2\xf3\x74\x55\xc2\x6e\x9e\x8e\xf5\xa0\x94\x43\xb3\xaf\xf3\xdd\xa7\x23\x04\x30\xe8\xa8\xb3\x67\x04\x94\x53\xf4\x7e\xb9\xd2\x0f\xee\x69\xc7\xea\x6d\x7c\x1f\x95\x2e\xd7\xc2\x7e\x29\x64\xee\xbe\xd0\x60\xfa\x0c\xf1\xfc\xab\x8e\x0e\xce\xca\xf9\xd0\x39\x37\x6e\xe6\xf6\xa1\xac\xdd\x3b\x2a\x05\x89\x56\x4c\xbb\x7c\x1f\x3b\xee\xf6\xb6\x5d\x6c\x3f\xbe\xec\x7e\x3f\xba\x80\xd5\xec\xe1\x0d\x2a\xf2\xec\xa1\x13\x5e\xd1\xe6\x65\x4d\xd1\x1f\x9c\x9f\xa4\xd7\x71\x56\xd7\x23\xb8\x7e\xad\x37\x36\xf7\xfa\xef\x7a\x73\x2b\xbf\xbb\xee\x64\x63\xcf\x0e\x8b\x7f\xc9\x38\x0f\x64\xa4\x30\x33\xba\x4b\xcc\x64\x2c\x24\x06\x1c\x33\x4f\x11\xcc\xc3\x20\x92\x1c\xc7\x3e\x96\x34\x4a\x64\x28\x04\xc7\x52\x12\xe6\x45\x10\x87\x49\xc8\xaf\xf1\x35\xee\xd7\xe1\xe9\x94\xbd\x7a\x06\x6f\x42\x1f\xcd\xfb\xe1\x55\x07\x96\xc9\x82\x88\xc4\xd8\x8f\x80\xe0\x24\x04\x1e\x7b\x82\xf8\x81\x87\xc3\x40\x32\x16\xf9\x61\x1c\x0b\x1c\x91\xa0\x5b\x8c\xe9\x0b\x3c\xfc\xa2\x59\x79\xae\x2a\x78\x3c\x7a\x76\xfc\x37\xe9\x58\xc1\x9b\xbe\x3b\x7f\x0b\x81\xf3\xff\x0d\x41\xd0\xf1\x64\x9f\xcc\xc6\x3b\xe0\x83\x04\xc5\x83\x80\x46\x34\x54\x89\x88\x89\x12\x84\x27\x41\x94\x50\x0c\x2a\xf4\x24\x95\x04\x53\xce\x19\x0b\xa4\xaf\xa4\x50\x58\x84\xb1\x0c\x68\x10\x33\xc1\x08\x1c\x60\x87\x51\xf9\x06\x1b\xfd\x47\x38\x25\x31\x77\xf0\x93\x62\xfd\xbc\x93\x7e\x29\xa8\xed\x58\x75\x49\xbb\x23\x63\x4d\xf0\xc6\xf7\x21\x20\x7e\x42\xb1\x48\xb8\x1f\x4b\x1c\x50\x2e\xcd\xb9\xc3\x65\xc0\x08\x03\x9e\x84\x5e\x10\x25\x84\xe0\x20\x0c\x70\xc8\x84\x10\x44\x05\x11\x95\x18\x54\x12\x25\x94\x4e\x76\x83\x7e\xbe\xf4\x97\xd6\x4e\xf4\x44\x3e\xd9\xfb\x18\xd4\x5e\x24\xd0\x85\x66\x12\xf5\x9e\xf8\x01\xd8\x68\xc4\xc8\xb7\x02\x0a\x17\x28\xa0\xf0\xad\x66\xc1\x41\x2a\x3c\xb2\xaa\xcb\xcb\x4a\x92\xb6\xa5\x6a\xcf\x20\xee\x02\x36\xa7\xeb\x1b\xdd\x3a\xb8\x27\x54\xc0\x7d\xa6\x03\xec\xdb\xef\xeb\xfe\x75\x34\xa0\xcb\x6d\x99\x7d\x66\xad\x05\x7b\xa1\x5c\x3a\xbc\x5a\xe7\x75\x15\x05\xa3\xbd\x77\x39\x79\x50\xe4\x6f\x9f\x5d\xb5\x87\x53\x7b\xe7\xb3\x53\xfb\xf8\xf4\x1a\xda\x07\x72\x0a\x9e\xb7\xea\xc9\xa0\x24\x23\x8e\x16\x6e\x71\xf5\xf7\x70\x47\x2d\xf1\x7e\x13\x74\xaa\x6d\xbb\x6f\xc3\xa6\x15\xb2\x49\xb5\x48\x9b\x01\x0d\x00\xbb\x15\xb2\xeb\xd8\x73\xf7\x61\xf0\x66\xc6\xed\x67\x73\xb6\x55\x79\x53\x7b\xfc\xb4\x5f\xcd\x3e\x92\x17\x51\xb7\x1a\xac\xda\xbb\x5b\xc3\x76\x50\x6a\x0e\x17\x2c\x78\x5c\xa6\x8b\xed\xfc\x89\xdd\xd7\x05\xbe\xfb\xab\x2c\xd9\x7d\x67\x85\xdd\xaf\x2e\x0c\xe2\xb6\x6c\x0a\x1f\x33\xd3\xb3\x9b\x52\x30\xdd\x5b\x73\xd7\x79\x33\xbc\xe8\x86\xa0\x0e\xc2\xf6\x23\xed\x43\x60\xd6\x2f\x4f\x81\xb5\x4e\x85\xec\xe9\x5c\x45\x89\x6e\xdf\x4d\xad\x67\x71\xcb\x1b\xac\x72\xe9\xa0\xa9\x42\x85\xbb\x96\x9b\x9e\x42\xa3\x1d\x68\xf7\x39\x67\x00\xd8\x43\xac\xf3\xf7\xee\x69\x6a\x95\x9a\xe1\xaf\xbf\x77\xbc\x01\xdd\x8f\xc0\x3f\x91\xcf\xb6\x31\x1f\x50\x69\xb7\xae\x9f\x80\xc9\x41\x0a\x2c\x80\xc9\x53\xb0\xef\x72\x59\x4d\xeb\xe6\x3b\xf5\xc7\x90\x7e\x32\xce\x6b\x23\xec\x8f\xf0\xd0\xc7\xfa\x18\x82\x8d\x30\xf8\x02\x0f\xaf\x57\x75\x95\xfb\xef\x90\x2e\xcc\x2e\x35\xfb\xb5\x49\x62\xaa\x0d\xad\x31\x64\x3a\x1c\x7c\x81\x87\x47\x20\xf7\x72\xe5\x77\xdd\x85\x46\x2b\xb3\x06\xa8\xb4\x2f\xb4\x0e\x12\x6a\x30\x9b\x2b\x15\x0b\x43\xa4\x36\xdd\xb3\xda\x09\x70\x38\x67\x77\x3f\x0a\x1b\x41\x18\x41\x73\x93\xdc\x5b\xf5\x87\x52\x42\x39\xb8\x66\x7b\x5b\x72\xca\x8a\x3b\xb5\xd8\x4f\xbe\x60\x79\xf4\x82\xf7\x9d\x98\xbb\xd7\x2f\xbd\x4b\xcb\x16\x3f\xa6\x8d\x7d\xf6\x79\x73\xfb\xee\x74\x3e\xaf\x53\xc8\xf7\x92\xa4\x47\xb8\x39\x3d\x69\xe7\xed\x93\x2f\xe1\x42\x44\x21\x89\x58\x1c\x31\x08\x23\x4c\x82\x40\x45\x09\xa5\x38\x14\x02\x63\x2f\x89\x63\x12\x44\x82\x27\x44\x10\x1e\x28\x0f\x08\x8f\x19\xc1\x01\x04\x41\x18\xe0\x04\x58\xef\x4b\x8f\x3b\x5f\x9f\xe8\x53\x76\x55\x9c\xc4\xc9\x5b\xba\x32\x54\xb1\xbb\xb6\xc2\xde\xed\x3b\x2b\x30\x4b\xa8\xd6\x4b\xe7\xc7\x06\xd4\xfd\x40\x48\x4f\x34\xdd\xbe\x7b\xea\x91\xf0\xbe\xfe\x84\xfc\xe0\x52\x9a\xef\xcb\x1f\x58\xcf\x30\x9b\x1d\xfc\x98\xc8\x56\xd1\x29\x41\xaf\xcb\xbc\x5d\x72\x5a\xb5\x33\x8d\x03\xde\x3b\x7a\x3f\x82\x4d\x81\x1b\xa6\x81\x7b\x77\x51\xb8\x8b\x1a\x6c\xa4\x37\x6f\xac\x9c\x41\xa9\x9e\x54\x3b\x53\x8d\xc3\xfd\x3f\x01\x00\x00\xff\xff\x71\xb2\x16\x2f\x14\xa4\x00\x00")
+var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\xfd\x93\xdb\x36\x92\xe8\xef\xfa\x2b\x50\xde\x57\x4f\xce\x96\xad\x01\xc1\x6f\xfd\x96\xc4\xde\xcb\xd4\xe6\x36\x7e\x8e\xdf\xee\x55\x5d\x5d\x3d\xe1\xa3\x21\x71\x2d\x91\x5a\x02\x9a\xd1\xbc\xec\xfd\xef\x57\x00\x28\x8a\xa4\x28\x8e\xa4\xd1\x64\xc7\x89\x95\xaa\xd4\x98\xc4\x47\xa3\xbb\xd1\xe8\x6e\x74\x37\x8b\x35\xe4\x74\x9d\x4d\x91\x3f\xc1\x13\x6f\x94\xe5\xb2\x98\x8e\x10\xd2\x99\x5e\xc2\x14\x7d\x5a\x14\x25\x28\x3d\x42\x48\x80\xe2\x65\xb6\xd6\x59\x91\x4f\xd1\x3f\x47\x08\x21\xf4\xf1\xfd\xcf\x9f\xe4\x66\x89\xbe\xfd\x70\x8b\x74\x81\x28\xe7\xa0\x14\xfa\x2b\x7c\xbf\xa0\x59\x6e\xbb\xa2\xbf\x80\xbe\x2f\xca\xcf\x23\xdb\xfe\x3f\x3f\x94\xc5\xdf\x81\x6b\xf4\x43\xb1\x82\xff\x7a\xbd\xd0\x7a\xad\xa6\x37\x37\xf3\x4c\x2f\x36\x6c\xc2\x8b\xd5\xcd\x1d\x70\xd3\xf7\x46\x2f\x8a\xf2\x9b\x11\x42\xcb\x8c\x43\xae\x60\x6a\xbb\xe7\x74\x05\x53\xf4\xe3\xbf\x7d\xf8\xd1\xc0\x6a\x1f\x6d\xca\xe5\x14\x8d\x77\x03\xdd\xdf\xdf\x4f\xe6\xf9\x66\x52\x94\xf3\x9b\xaa\xa7\xba\x59\xce\xd7\xcb\xb7\x66\x6d\x90\x4f\x16\x7a\xb5\x1c\x8f\x10\xba\x83\x52\xd9\x75\x78\x93\x60\x82\x47\x23\x05\xa5\x79\x64\xa6\x79\x5b\x8d\x79\x33\xb6\x13\xb4\x56\xbd\x2c\x38\x5d\x22\x03\x1b\xca\x0b\x01\xa3\x91\xa6\xf3\xaa\x93\x83\xed\x5b\xce\x8b\x4d\xae\xd5\x61\xd7\x6f\x1d\x6e\x1c\x96\x4c\x1b\x54\x30\x83\x0a\xd5\xe8\xfd\xa9\xa4\xb9\xa2\xdc\x74\x18\x1c\x41\xb7\xdb\xed\xba\x7f\xb7\x2c\xf8\xe7\xc1\x8e\x6c\xd7\x62\xd7\xe5\xc7\x62\x3e\xd8\x01\xee\x20\xd7\xe8\x7f\xbb\x19\x25\x94\x68\x59\xcc\x9b\xfd\xff\x62\xb0\x30\xd0\xdf\x60\x09\x29\x4d\xf5\x46\x21\xc3\x58\x8d\xae\x3f\x6f\x58\xdd\xa5\x07\x86\xea\x35\x03\x94\xe5\x1a\x0c\x0b\x82\x40\x6a\x73\x80\xb3\x77\xc0\x36\xf3\xc3\xee\xf6\x31\xda\xe8\x6c\x99\xe9\x0c\xdc\xf8\xa3\x35\xd5\x0b\x4b\xae\x9b\x8a\x06\xea\xe6\x17\x2a\x44\x09\x4a\xfd\xf7\xd4\x36\x59\xd3\x92\xae\x40\x57\xac\x60\x7e\x6f\xd1\xff\x2a\x41\x4e\xd1\xf8\x0f\x37\xbc\x58\xad\x8b\x1c\x4c\xb7\x7d\xbb\x9b\x6f\xdd\x00\xb7\xf9\x07\xaa\x17\xe3\x53\x7b\x7d\x84\xbb\xcc\x70\xe0\x6d\xfe\x7f\x36\x50\x3e\xb8\x7e\x73\xd0\xbb\x69\x77\x8c\xb5\x1b\xae\xc5\x58\x08\xa9\xcd\x6a\x45\xcb\x87\x29\xfa\x08\xba\xcc\xe0\x0e\x6a\xae\x12\xa0\x69\xb6\xac\x9a\xf5\x6c\x59\xf3\xcb\x72\xbe\xdc\x08\x50\x68\xc6\xe8\x92\xe6\x1c\x66\x6f\xd0\x0c\x72\x28\xe7\x0f\x33\x44\x73\x81\x66\x0b\xaa\xbe\x2f\x84\x79\xce\x1e\xea\xa1\x67\x15\xae\x66\x13\xf4\x6d\x5e\x3f\xbd\xcf\xf4\x62\xdf\x01\x31\x40\x7f\xd4\xe5\x06\xfe\x88\x32\x85\x28\xe2\x45\xae\x4b\xca\xf5\x64\x54\xcf\xfe\x43\xa6\x74\x51\x66\x66\x27\xb5\x81\x46\x9c\xe6\xa6\xff\x3f\x36\x50\x66\x20\xcc\xd4\x6a\x0d\x3c\x93\x0f\x59\x3e\x47\xb3\xb2\x42\xd9\xcc\x36\x78\x40\x4a\x97\x59\x3e\x9f\x54\xe3\x96\xa0\xd6\x85\xd9\xef\x7b\xac\x8d\x09\xc6\xe3\xfd\x3f\x3b\xe8\xf8\xe9\xcf\x8d\x37\x06\x4c\xc8\x75\xb3\x31\x42\x74\xbd\x5e\x66\x9c\x9a\xe6\x37\x7f\x57\x45\xde\x7e\x8b\x90\xe2\x0b\x58\xd1\xee\x53\xd4\x4b\x7a\xd7\x56\xdd\x54\x74\x1c\x3b\x74\xac\x0b\x55\xcf\x29\x60\x5d\x02\xa7\x1a\xc4\x14\x19\x04\x9e\xc9\x08\xef\xb7\xc0\x37\x7a\xcf\x07\x7c\xb7\x33\x8f\x72\x81\x2e\x90\xca\x56\x9b\x25\xd5\x50\x93\x09\xad\x40\x2f\x0a\x81\x38\x5d\x2e\xdf\x58\xd2\x16\x1b\x8d\x14\xe4\xc2\x90\xa0\x21\x77\x6a\x69\x82\xac\xbc\x9e\xd4\xa3\xd6\x7f\xdc\xea\xb1\x42\x1b\x05\xe6\x7c\xd0\x05\x02\xa5\xb3\x95\x99\x6a\x4e\xcd\x63\x3a\x07\xcb\x69\x60\xc1\x36\x03\x96\xa0\x36\x4b\x8d\x0a\x89\x28\xe2\x4b\xba\x51\xb0\x27\xed\x3f\x36\xa0\xf4\x77\x85\x78\xd8\x63\xa2\xb5\x28\x5a\xce\x37\x2b\x83\x67\x37\x66\x7e\x97\x95\x45\x6e\x1e\xd4\xcd\xcd\x18\x59\xd9\xc1\x6d\x2f\xdd\x87\xa9\xde\x4f\xf3\x21\x8a\x7f\x4f\x97\xcb\x77\x54\xd3\xf1\x97\xc5\xa8\x06\xec\x8f\x96\x24\xe3\x96\xc0\xfc\xe3\xf4\x80\x73\x0f\x85\xe6\xa5\x02\xf0\x02\x76\x47\x8c\x6a\xbe\x40\x85\xb4\x1c\xaf\x4e\x67\xf9\x3d\xe7\x59\x96\x6b\xf0\xf6\x6f\x83\xef\xbe\x33\x78\xf9\x42\x99\xaf\x86\x7d\xc7\x81\x4d\x16\x9c\x9e\x2a\x3a\xff\x95\x7c\xc9\x1e\x34\x9c\xc9\x90\xb5\x0c\x16\xb0\x5e\x16\x0f\x86\x8d\x7e\x0d\x09\xdc\x37\xed\x71\x59\xdc\x18\xfe\x0f\x7f\xf8\x03\xfa\x74\xfb\xe1\xe7\x26\x69\xdf\xa2\x99\xa0\x9a\xce\x50\x96\xef\xb6\x0f\x62\x85\x78\x40\x99\x42\x7a\xd1\x40\x4b\x35\x76\x35\xf7\xd1\x11\x1c\xb7\xb6\x86\x28\x37\xb9\xce\x56\xcd\xa1\xa8\x52\xd9\x3c\x07\xd1\x54\xae\xef\x17\x19\x5f\xd8\xf6\xf5\xfa\x0c\xbe\xa0\x5a\x25\x88\xaf\x67\xcb\xcb\x38\x5b\xfa\xb5\xf1\x1b\x43\xd9\xdf\x8a\x4a\xfe\xb8\x2a\x96\x49\x44\xf3\x87\x09\xfa\x01\x4a\xa8\x98\x56\x00\xca\xd4\x21\xb3\x7f\x61\xea\xae\xb1\x09\x8e\xd2\xd8\x98\x01\x74\x0e\x37\xbf\x7c\x86\x87\x5f\xdb\xfe\xfa\xd9\xcd\xfd\x67\x78\x78\x29\x5c\x52\x61\x03\xdd\xd1\xe5\xe6\x11\x76\x91\x45\x89\xe6\xd9\x1d\xe4\xe8\x33\x3c\x7c\x61\x1c\x51\x21\xde\x31\x45\xd3\x91\x71\xf3\x4b\x26\x2e\xe7\x82\x4f\xdb\xdb\x77\xe7\x52\x92\xde\x77\x0e\xf9\x47\xbb\xfc\x00\x54\x9c\xdb\xe7\x83\x3b\xba\x4f\xe5\x97\x03\x1f\x50\x1f\xcf\x34\xf0\x36\xcc\x29\xec\x01\xdd\xbe\x9b\xa0\xbf\x2d\x20\x47\xb3\xb5\x83\x64\x66\x4f\xd2\x72\x03\x6f\x10\x45\xd5\x33\xa4\xb7\xce\x90\xcf\x37\xcb\x25\x9a\xad\xc0\x9c\xc0\xab\x6c\xbe\xd0\x88\x01\x2a\x41\x6f\xca\x1c\xc4\x0b\x64\xb5\x22\x87\x9f\xe4\xe1\x63\x84\xde\x22\xba\x5c\xf6\xbf\x3a\x46\xb4\x1d\x8b\x7e\xda\x8e\x7b\x7b\xad\xcb\x62\x0d\xa5\xce\x9a\xeb\x6e\xff\x0c\xde\x8e\xbd\x6b\xea\x09\x92\x2e\x15\x1c\x6d\x37\x0c\xdb\xbf\xc3\xfe\xbc\xbf\xd2\x82\x3f\xd2\xfb\x2f\x73\xcd\x1d\x36\x2b\xe9\x7d\xcf\xd6\xd8\xff\x60\x4b\x57\xeb\x25\xf4\x41\x9b\x89\x29\x1a\xe3\x6d\x20\x20\xf6\x24\x11\x61\x92\x50\x9a\x50\x0f\x28\xc6\x12\x12\xdf\x23\x22\x25\x69\x14\x09\x1a\x90\x40\xa4\xa9\x9f\xd2\xd0\xf3\x24\xc7\x0c\x12\x0f\xa2\x50\x52\x11\x12\x2a\x93\x3e\x20\xad\x7a\xfe\x89\xce\xa7\xc8\xeb\x79\x6b\x55\xf8\x8f\x76\xf1\x78\x8b\xdd\xcf\xdb\x8d\xdd\x37\x1c\x6c\xd7\x59\x49\xdd\x82\x7d\xdc\x37\x9f\x55\xd8\xd5\x14\xfd\xe7\x7f\xf5\xbc\x9d\x53\xf5\xa1\xcc\x38\x7c\x5f\x98\x39\x3d\x92\xf4\xb7\x99\x22\xe2\x61\xdc\x37\x7c\x51\x66\xf3\x2c\xb7\xe0\xc6\x61\x14\x8b\xc4\x67\x31\x4b\x44\x82\xa9\x10\x9c\x91\xc4\xa3\xb1\x27\xc2\x40\xf2\x98\xf9\x7e\x14\x48\x09\xa2\x6f\x19\x02\x96\x30\xa7\xba\x28\xa7\x56\xe6\xf4\xb4\xc8\x8b\x9c\x83\x9d\xa7\x8b\xfb\xfe\xf1\x8c\x28\x53\x3f\xe5\x47\xc7\x53\xd9\xff\x87\x29\xf2\x12\x3c\x3a\x87\x89\x2d\x7d\x6e\xdf\xb5\xc8\xc3\x83\x30\x49\x83\x34\x4d\x42\x1a\x89\x24\x62\xb1\xe7\xa7\x51\x8a\x59\x92\x78\x9e\x10\x3e\x0b\xa2\x20\xe6\x98\x88\x40\x06\x1e\x17\x20\x59\x2c\x7c\xe2\x93\x78\x7c\x7c\x86\xbf\x6c\x56\x0c\xca\x7e\x16\xa9\x9a\x7c\xca\x56\xa0\x34\x5d\xad\xa7\xc8\x0b\x89\xef\x85\x11\x89\xbd\xfe\x63\xf4\xa6\x04\x0e\xd9\x5a\xff\x9a\xc7\xe9\xc1\xd9\x78\xc5\x43\x0e\x55\xeb\x39\xe5\xb0\x7b\x79\x67\xd4\x51\xb9\xfc\x88\x54\x76\x6b\x1e\x8f\x06\x64\x72\xf3\xf1\x59\x6c\x7d\xc2\xc4\x4e\xe8\x76\xf9\xeb\xd0\xfb\x72\x0e\x71\xbf\x2f\x56\xab\x4c\x9f\xae\xbf\x64\xb9\x11\xea\x83\x0e\xb9\x7f\x9d\xf5\xdd\x3a\x36\xbf\x10\xf5\xfb\xd3\x7f\xdc\xbe\x73\x44\x75\x77\x81\x37\xbf\xec\xae\x55\x2e\xd7\xbd\xf7\x26\xd1\x59\x02\xe3\xfd\x76\x4d\x73\x01\x27\x0b\x8d\xc6\xf5\x66\x9f\xb8\xb0\xeb\x39\x41\x40\xa0\xa2\x44\xb9\x95\xb6\x6f\xcc\x9f\x63\x06\x4a\x8f\xad\x49\x65\xbc\x70\x4a\xbb\x81\x26\xe8\x56\xa2\x19\x54\x20\xee\xae\x9c\x0a\x3b\x64\x43\x7f\x5e\x2e\x5b\x97\xb1\x88\x2e\x8b\x7c\x6e\x35\xe9\x7a\x52\xbd\x80\xac\xdc\x09\x30\x85\xee\xb3\xe5\x12\x31\x40\xb0\x62\x20\x04\x08\xb4\xc9\x05\x94\x68\xd6\x1c\x66\x86\x64\x06\x4b\x81\xb2\x5c\x69\xa0\x02\x15\x12\x65\x42\xfd\x4e\xb4\x6f\x4b\xe6\xf1\x05\x1d\x6f\xd5\xa7\x72\x93\x7f\xbe\x54\x8f\x3d\x14\x72\x8f\xea\x9b\x8d\x2e\xe8\xf6\x9d\x42\x47\x7f\x47\x87\xd3\x0f\x6b\x98\x22\x5a\x96\xf4\xe1\x68\x9b\x4c\xc3\x6a\x00\xa2\xdd\x20\xee\x3a\x74\xa0\xd9\x4e\xfb\x35\x9a\x0c\x49\x02\xc6\x68\x88\x41\xc6\x71\x9c\x24\xa9\x94\x1e\xf5\xa3\x18\x04\x66\x7e\x22\x42\x08\x23\x12\xc5\x5e\x10\xc4\x31\x0f\xb0\x00\x3f\x11\xb1\xc7\x41\x88\x48\xa6\x92\x06\x71\x3c\xfe\xdd\xd2\xbc\xde\xb7\x47\xf6\x7d\x67\xbf\x3f\x2f\xe5\x07\x10\x7e\x1a\xfe\x8e\x99\x7d\x4f\xd4\x50\x0e\xb1\x56\x09\xd2\x4a\x4a\x8f\xfa\x39\xf3\x60\x9c\xbc\xd2\x8a\x7d\x12\xfa\x24\x18\x1d\x31\xda\x30\xc6\x81\x8c\x38\x4f\x12\xc6\x82\x88\x44\x34\x25\x29\x8e\x63\x2f\x81\x84\x48\x12\x86\x2c\x91\xc6\x5a\x0b\x42\x9f\xc6\x09\x24\x71\x1a\x03\x4b\x38\x50\xdf\x4f\x7d\x46\xbc\x70\x3c\xea\x37\x15\xfc\xd8\x3f\x78\xb3\xa6\x25\xe4\xfa\xf6\x5d\x73\x62\x16\xfb\x58\x30\x91\x62\x09\x02\xa7\xc2\x8b\x42\x26\x85\xf4\x7d\xce\x31\x80\x08\x62\xe0\x38\x4a\x52\x3f\x91\x11\x40\xcc\x62\xee\x11\x1a\x00\x4d\x93\x1e\xbb\x48\x37\x75\x7c\xdf\x27\x51\x9c\xf6\x18\x61\x73\xaa\x7e\xcc\x56\x99\x9e\x22\xcf\x23\xa1\x1f\xc6\xe9\x41\x13\x06\x39\xc8\x8c\x67\xf6\x8c\x1c\xe3\x2d\x0b\x70\x1a\x70\x12\xca\x24\x12\x11\x49\xa4\x10\x61\xec\x51\xc9\x03\x1c\xc7\x12\x0b\xec\xa5\x11\x95\x2c\xe8\x31\x60\xe7\x54\xfd\x5f\x05\xe2\x98\x41\xa8\x0b\x4d\x97\x3f\xf3\xa2\x34\xb6\x15\x26\x69\x9a\x1c\x5a\x94\x7a\xab\x3e\x16\x85\xb6\x80\x24\xa9\x90\x22\x95\x5c\x78\x98\xa7\x10\xfa\x22\x4a\xc2\x94\x70\x99\xb0\x30\xc0\x8c\x24\x98\xc5\x44\xf8\x89\xc7\x92\x28\x09\x89\x4f\x88\x9f\xa6\x44\xfa\x80\x53\x9a\xe0\x88\xb1\x71\xdf\xe8\x7f\x02\xaa\x37\x25\xa8\x29\x3a\x04\x50\x69\xaa\x61\x3f\x7d\xc4\x38\x8f\x04\xf1\x02\xc6\x53\x91\x08\x2c\x40\x30\xea\x61\x8f\xd0\xc8\xe7\x89\xef\xc5\xc2\x4b\x39\xa4\xb1\x8c\x30\x4f\x28\x01\x19\xf2\x30\x65\x4c\x04\x58\x04\x24\xf2\x0e\xa7\xdf\xed\xf4\x7a\x0a\x2f\x8c\x93\x18\x48\xe8\xfb\x3c\x88\x31\x24\x34\x4a\x12\x88\xb8\xf0\x62\xea\x01\x78\x44\x24\x41\x68\xa4\xae\x08\x65\x42\x04\xe1\x1e\x4e\x81\x88\x88\x90\x48\x24\x10\x06\xd0\xc7\x8e\xf3\xdc\x6c\x83\x31\xde\x52\x16\x33\x12\x4b\x9e\x42\x2c\x48\x2a\x53\x49\x20\x64\xc2\x8f\xbc\x38\x88\x69\x18\x7a\xa1\xc0\x9c\x13\xd1\x03\x67\xe6\x44\x65\x47\x4d\x3e\x55\x12\xbe\xbd\xce\xa9\x31\x42\xe8\xc6\x84\x90\xdd\xd8\xc0\xb2\xc7\x6d\x89\x3a\x3e\xad\xa1\xf1\xfd\x29\x5b\x6a\x28\xab\xd0\xb4\xe5\xbe\xc1\x11\xa5\xef\x7d\xdd\x0e\xd1\x12\xcc\xa1\x20\x36\xdc\x45\x17\xcd\x7e\xfa\xf0\xff\x7e\xfc\xe9\xdf\xec\x5d\xe3\xfb\xbf\xfe\xfb\x0b\x35\x33\xec\x02\xdc\xa2\x5f\xa0\xb1\x31\x74\x8e\x1d\x3d\xbf\x2e\x56\x14\x2c\x2e\xc6\xa3\xf3\xcf\xfa\x21\x2f\xe5\xd0\x84\x3f\x16\xf3\xbd\x1d\x6c\x39\x77\x17\x0a\xf9\x24\xe6\xed\xc6\x53\x0e\xf0\xef\xa7\x66\x53\xcb\xc2\x25\xf0\xa2\x34\x87\x69\x91\xa3\xbf\xbe\xff\x54\x0f\xd6\x0e\x87\x7b\x51\x3c\xbc\x5b\xc4\x57\x36\x6e\xa1\xe3\x5f\xc6\xc9\x26\x2e\xf7\x26\x77\xa1\xd9\x37\x6b\xa8\xad\xfd\x01\xf3\xfb\x2f\xfb\x5b\xec\x43\xe3\x9b\x17\x79\x0e\x5c\x83\x40\x76\xb0\x97\x47\xdf\xa3\x34\x1c\x42\xd9\x07\x80\xf2\x67\x4d\xb5\x72\x48\x53\xcd\x88\x65\xe7\x3f\x79\x14\x6b\x87\x51\xce\x0d\xf4\xbd\xfe\x1b\x30\x55\xf0\xcf\xa0\xbf\x69\xc4\x3b\xe7\x70\xbf\x0f\xd4\x46\x97\xc6\x31\x7d\x28\x54\xa6\x0f\xe3\x98\x7e\x33\xde\xd1\xa3\x26\xe3\x70\xb7\x9f\x98\x2a\x96\xa0\x61\xd0\xab\xda\x33\xea\xe3\x96\xe2\x90\x67\x60\x74\x89\x05\x38\x68\xfd\x9d\x60\xf3\x5f\xd7\xde\x3f\xdc\x00\x0d\x15\xee\xfa\x1b\xc0\x0e\xfe\xc8\xc9\xe8\x62\xbc\x14\xd5\x99\x92\x0f\x88\x97\x99\x86\x32\xa3\x28\xcb\x9d\xb7\xee\x20\x64\xed\x9a\xfb\x68\x9f\x80\x60\xc2\x59\x46\x0d\xaa\xe5\x53\x37\xfd\x68\x98\xf5\x8f\x10\xb0\xb5\xd4\x2a\x52\x06\x15\xd2\xe1\x03\xc1\x2a\xd3\x1a\xca\x03\x18\x34\x7e\x26\x08\x74\xb1\xce\x38\xae\x01\x38\x9c\xd8\x7b\xce\x89\xbd\x81\x89\xc9\x73\x4e\x4c\x06\x26\xf6\x9f\x73\x62\x7f\x60\xe2\xe0\x39\x27\x0e\xba\x13\x7f\xf9\x27\xc4\x51\x5b\xe1\x79\x4e\x88\xcb\xee\xda\x6a\xad\xac\xdb\x69\xd4\xfa\xb3\x23\x7a\xdb\x36\xc8\xf5\xa5\xef\x6e\xfc\xeb\x08\xe0\xe7\x91\xbb\x7a\xfb\x93\x8d\x44\x78\xa6\x5d\xe1\x7c\x2e\x4d\x11\xac\xb7\xd5\x82\x0d\x73\xd3\x2c\x77\xe1\xc8\x3b\x54\x1d\xc0\xa7\x20\x17\x8d\xc7\xcf\x76\x32\xe8\xe2\x33\xe4\xdd\xd9\x76\x40\x94\xc0\xb3\x75\xd6\x14\x27\xcf\x0c\x47\x77\xc2\x2f\x41\x8c\x3c\xc5\x5a\x7b\xa1\xd2\xa4\xc7\x5c\x01\xfa\x2c\xca\x5a\x23\x05\x61\xac\x90\x99\xe5\x24\xa1\x51\xed\xa1\xdd\xe8\xa8\x90\x0d\xbb\xe7\x8d\x0d\xb5\x67\xcb\xa2\x58\x21\x69\x3d\x06\x66\xaf\x51\x8d\xec\x92\x55\xa6\x41\xb8\xbb\x16\x2a\xa5\xb3\x3a\x2b\x3e\xdc\xc7\x47\x5f\x53\xe6\xfc\x16\x78\xf8\x3b\xa0\xfa\x69\xfc\xdb\xcf\x52\xe4\x2b\x4f\xfd\xae\x79\x8a\x3c\x9d\xa9\x84\x49\xd5\x36\x2a\x0d\xaf\x31\x3b\xe4\x55\xdd\x27\x7c\x37\xe3\x8a\x4a\xa0\x1a\x5c\x1a\x1f\xaf\xcf\xc1\x26\x02\x5b\xc1\xf3\xbb\xac\xa6\x17\xeb\x2c\xe5\x50\xfe\x64\xe1\x1e\xbb\xe6\xa3\x97\xea\x31\x75\x45\x0c\x0e\xe9\x78\x63\x32\x88\x7f\xdd\x54\xd1\x5e\xc6\xb0\xb8\x44\xd4\x26\x34\x3f\x22\x43\xcc\xe6\x77\x59\x15\xa6\x71\x03\xd4\x37\xa8\xc8\xad\xae\x67\xaf\x12\xad\xae\xb3\x80\xaa\x69\x33\xea\xe8\x25\xb2\x91\x49\xb5\xaa\x38\xe9\xcb\xe1\xa1\x2a\x15\xe6\x6d\x49\xf3\x39\x5c\x28\x11\x6a\xd7\x74\x35\x18\xb2\x83\x0d\xb3\x40\x45\xd9\x56\x65\x03\x47\xe6\xea\x2c\x78\x99\x84\xae\x52\x6a\x3e\x9a\x05\xee\x68\xfd\xe2\x48\x7d\xea\x02\xc6\xa3\xd1\xbe\x85\x19\xa6\x6a\xe4\x46\xac\xb2\xa9\xa6\xa3\xe3\x3a\x74\x55\xd2\x62\x3a\xea\xb2\xd9\xb0\x25\x53\x75\x43\x59\x8e\x36\x79\xa6\xd1\xdf\xde\xdf\xbe\x41\xeb\x12\x14\xe4\xb5\x6a\xb0\x80\xed\xe1\x28\x4d\x37\x6b\x10\x4b\xe9\xc9\x14\xfb\x24\xa6\x14\xcb\xa4\xa1\xf6\xbb\xf2\x1a\xe7\x42\xe5\x7a\x59\xa0\xb2\xfc\x42\xa0\xb8\x8c\x48\xe0\x85\x89\x08\x53\xcf\x4f\x1b\x11\x1e\x55\xcd\x8e\x43\x98\x58\x51\x2c\x81\xe6\xc7\x80\xba\x5f\x80\x5e\x40\xd9\xda\x2b\x0b\xaa\x9a\x79\x8e\x2d\x18\x5c\xe6\x87\x7d\xd3\x9c\xaf\x8f\x78\xbc\x17\x9e\xc1\xe5\x45\xd8\xfc\x17\xe0\x90\x44\x18\xe3\x04\x4b\x81\x31\xf5\x22\x13\x1d\x4f\x63\x1a\x13\x1f\x87\x09\xc1\x9c\xf8\xc2\xa7\x40\x04\x4f\x22\x2a\x3c\x1f\x87\x91\x47\x49\x42\x52\x91\xc4\x3c\xe6\x2c\x09\xfc\xd0\x8f\xc2\x20\x25\x4c\x78\x61\x90\x00\x8b\x21\x96\x1c\x4b\x3f\xf2\x09\x83\x14\x63\x92\x56\x12\xb4\xe2\xd6\xa1\x65\xd8\x1c\xbe\x33\xd7\x81\x9f\xf6\xf3\x2a\xe8\x5c\xb2\xcc\xb4\x4f\xd0\x35\x2f\x25\x8c\x7d\xb9\xab\xc8\x73\x74\x27\x55\xa9\x0f\xe7\xee\x24\xd3\x0d\x65\x02\x72\x9d\xc9\x0c\x4a\xf4\xda\x64\xaf\x2a\x9f\x7c\x73\x7c\xe5\x57\x8a\xdf\x6a\xa6\x52\x1c\x40\x9d\xe5\x1a\xe6\x2d\x8f\x8c\x2c\xca\x15\xd5\x53\xbb\xb7\x7c\x32\xbc\x1e\x17\x8a\x86\x5e\x2f\xc0\x64\xc5\xf5\x2e\xa5\x13\xa5\xd6\x49\xda\x38\x13\x9e\x28\x18\x86\x67\x93\x67\xdb\x7d\xb8\x58\x1f\x38\x8d\x00\x32\xfb\xba\x11\xd6\xdf\xcf\x1e\xdb\x5d\x2c\xd3\x57\xee\xf8\x5d\x71\x47\x3d\xf1\xf6\x7c\x72\x36\x65\xca\x9e\xa8\xa3\xe7\xba\x85\xdc\x83\xea\x9c\xbf\x4f\x01\xd7\x25\xb2\xa1\xd7\xce\xd3\x7b\x8c\xfd\x04\x0b\x30\x89\x83\x38\x66\x84\x26\x12\x02\x9e\xf8\x3c\x12\x54\x42\x2c\x93\x28\x8a\x13\xc6\x3c\x96\x50\x13\xcb\x69\x07\xa8\x3c\x70\xbd\x1b\xcc\xdd\xe1\x15\xed\xe8\x9f\xaf\x7b\xed\xeb\x5e\xfb\xba\xd7\xce\xdd\x6b\xbb\xde\xce\x8d\x73\x9b\x0b\xd8\x5e\x8f\xcd\x32\x33\x1c\x2a\x64\x35\x7a\xe5\x5d\x9c\x1b\x5d\x9c\x6a\x50\x48\x2f\x32\x65\xb6\x6e\xdf\x2a\xaa\xb3\xf6\xbb\x7d\x70\x50\xff\x8e\xce\x5f\xc8\xd6\xc8\xc4\x09\x64\xdd\x81\x50\x49\x8f\x53\xc5\xcd\xb3\x0b\x19\x1b\xa6\x7f\x35\x14\x7e\xfc\xf1\x03\x82\xdc\x58\x20\x55\x9a\x82\x1d\x1f\x65\xb9\x5b\x77\x2f\x32\x1b\x19\x02\x75\x66\xc0\xd5\xf0\xe9\x46\xac\x60\xb9\x7d\x37\x8c\xce\x2b\x24\x21\xe8\x17\x25\x21\xeb\x24\x87\x2b\x03\x33\xa7\x0a\x2d\xcd\xc0\xe8\xf5\x8a\x6e\x8d\xf7\xbb\xb8\x07\x81\x28\xe7\x1b\x5b\x88\x2b\xbb\x6b\x56\xc8\x2a\x64\x2b\xf4\xab\x77\x4b\x1d\x24\x61\x34\x93\x2f\xae\xc6\x0d\x8d\xab\xd6\x9d\xd1\xad\x0b\xa7\xb1\xef\xb2\x1f\x51\x09\xf7\xb4\x14\x47\x18\xe5\xfc\x14\x90\x5d\xea\xc7\xd5\x28\x70\x1a\x92\xfb\xe0\x6f\x27\x9f\x34\x92\x4e\xae\x06\x9b\xda\xac\x2c\x6e\x97\x4b\x64\x1c\x41\x4a\x97\x74\xe9\xd0\xaa\xc6\x48\x99\xb9\x7a\x69\xdf\x49\x79\xd9\xa5\xba\x5c\x8d\xec\x65\x51\x58\xef\xca\xa2\x8b\x25\x94\x39\xa7\xb4\xa3\xfc\x11\x9a\x5f\x2f\xdb\xa6\x99\x65\x73\x35\x91\xab\x36\xeb\x75\x51\x6a\x10\x66\x78\x24\xab\xf1\x11\xcb\xb4\x02\xdd\x7f\xbc\xee\x1e\xed\xd3\x7a\x9e\x07\xd5\xd5\x1e\x53\x6e\xa2\x63\xe8\xbd\x5a\x36\x51\x2b\x8b\xe8\xd9\x99\xa7\x2f\x3d\xb1\xb9\xae\xeb\xa5\x30\x55\xa9\x4b\x67\xae\x88\xe0\x63\x2b\x32\x1c\x5f\xe4\x46\x27\x2b\xd0\xae\xea\x9f\x51\xc7\x9a\xf7\x30\xdd\xd5\x9c\x9e\x33\xe5\x7c\x94\x56\xeb\x1b\x52\xde\x74\x71\xae\x2e\x3c\xae\xe3\x62\xf6\x7a\xe5\x1b\x57\x6c\x49\x16\x65\x6f\x0d\xc6\xda\x56\x1b\x1f\x59\x56\x88\xfd\x80\xd2\x30\xc5\x1e\x09\x59\x14\x60\xe2\x53\x4c\x22\xe2\x79\x84\xa5\x89\x88\x09\xf8\x3c\x81\x00\xc3\xf8\x6c\xb7\x64\x0b\x74\xe3\x5f\x36\xc4\xd9\xc7\xf8\xb8\x42\x8a\x75\xd6\x09\x88\xe3\xde\x70\xc1\x7c\xee\xcb\x20\x8c\xb8\xf1\x51\xee\x21\x11\x54\xd3\x73\x01\xc9\xf2\xf5\x46\xdb\x9e\x15\x6e\x8e\x99\x11\xb5\x27\x74\x88\x86\x99\x38\x7b\xfe\xbd\x1d\x5d\x5d\x14\xf5\xd7\x1a\x7a\x1e\x2b\xac\xb8\xcc\x06\xeb\xdb\x2e\xa7\x00\x7e\xbe\x29\xb6\x2f\xe8\x73\x01\x8c\x75\x67\x0b\xe9\x9a\x66\x0e\x4e\xa3\x22\x48\xe8\x95\xbe\xad\x22\x3f\xd7\x35\x04\x0c\x73\xd9\x21\x7b\xe8\xec\x62\x46\x32\xd5\xb4\x16\x7a\xf5\x82\x46\x69\xa6\xba\x00\xd4\x99\x10\x26\xc7\x00\x5c\x52\xa5\x1d\x94\x85\xb4\x76\xa9\xca\xd4\xa0\x99\xe0\xa7\xa3\x83\x7a\x53\x67\x52\x29\xb1\x13\x2a\xb4\x2e\x41\x66\xd6\x3a\x56\xc5\x0a\xce\x35\x4e\xc6\xa3\x9e\x32\x56\x57\x23\xdc\x78\x3f\x28\x2a\xa1\x52\x33\x77\x65\x72\x3f\x82\x7c\x53\xdf\xee\xb1\x6e\xf6\x48\x0d\x74\xdc\x38\x7b\x76\x95\xb4\x0e\x00\xec\x26\x6d\xf4\xa4\x6a\x0c\x56\xc5\xb4\xe3\x8e\x47\xbd\x35\xb9\xae\xc5\x24\xbc\x00\x69\x8c\x10\xc8\x35\xda\x28\x57\x1d\x97\xd3\x25\x77\xc5\x86\x0d\x5b\xcb\x2c\xa7\x4b\x33\x39\x5a\x9b\xd9\x87\xf5\xad\x39\x55\xd7\xd3\xb5\xad\xe1\xb5\x72\x5f\xc1\x90\x16\x82\xaa\xf4\x3f\x2f\x72\xb5\x59\x39\x60\xa1\x2a\xa5\x6c\xcf\xf7\x47\x24\x56\xdb\x3c\xd8\xd7\x02\xbb\x9a\x26\x75\xfb\xae\x4f\x18\x14\x79\xb3\xd0\xf0\xa6\xb4\xf6\x7a\xb3\x41\x05\x09\x2a\xf2\xc9\x6e\x89\x46\x70\x4d\x1e\x95\x68\xae\xf8\xd9\x79\x37\x88\x24\xe5\x24\x8c\xc1\x8f\x80\x46\x10\x13\x13\x06\xea\x2e\x7e\x4c\x9d\xa2\xa1\xb3\xb0\xa4\xf7\x4f\xd1\x0a\x2a\x31\x78\xc2\xa9\x22\x93\x28\x4d\x3c\x46\x13\x8c\xa9\xa0\x22\x4d\x83\x53\xae\x36\xe3\x20\x92\x09\x21\xb1\x87\x13\x8c\xbd\x84\x84\x04\x27\xe6\x2f\x8e\x59\x12\x78\x41\x9c\x12\x9e\x06\x7e\x1a\xa6\x01\x4e\x13\x9f\xf8\x29\xc6\x10\x05\x31\x8e\x03\xc2\x45\x12\xc7\xc0\x53\x99\xa6\x38\x62\x9c\xe2\x30\xf4\x30\x04\xc4\x93\x3e\xc3\x9e\x0f\x82\x10\xcf\x27\x01\xc4\x31\xa7\x1e\x16\x7e\x10\x45\xcc\x27\xcc\x4b\x30\xe6\x31\x01\x8f\xc4\x5e\xca\x88\xe7\x4b\x4f\x04\xdc\x8f\xb1\x8f\x43\x3f\x4d\x85\x20\x31\x95\x69\x44\x22\x12\x05\x46\xad\x19\xa1\x5d\xa2\xf9\x10\x9a\x2b\x0b\xfe\x92\xf3\xb1\x61\xfc\xd7\xba\xa2\xe3\xbc\x2a\x9f\xdd\x05\xa3\xbb\x1b\x86\xd7\x95\x0e\x7d\x4c\x3f\x3a\xbf\x6c\x9f\x4d\x11\xb9\x4c\x0e\x1e\x59\x61\x47\x53\xbc\x5a\xd9\xc5\x13\x15\xcb\xeb\x4e\xee\xd4\xcd\x56\x4e\x46\x3f\x07\xb8\x28\xfd\x73\x19\x60\x47\x7c\xab\x7a\x28\x2b\x4f\xac\x22\xae\xae\xa6\xbb\xd5\xd6\xc9\x93\x40\xab\x7c\x51\x8f\x40\x77\xbe\xd9\xe2\x4e\x8a\xb3\x41\xab\xcf\x97\x41\x70\x7a\x8c\x94\xe6\x6d\xf9\x10\x35\xaf\xe1\x1e\x3b\x72\x82\x19\x8d\x80\x3e\x5c\xce\x2a\x0d\x27\x61\xad\x50\x5b\x25\x60\x4e\xaf\xc7\x35\x66\xd4\xa7\x9c\x1b\x7b\x0a\x59\xf8\x5c\xac\xd3\x31\x8f\x04\xf1\x23\x90\x9c\x71\xc6\xfc\xa0\x6d\x4b\x3a\xa7\xe7\x75\x00\x19\x74\xa0\x86\x71\x04\x5e\x92\x4a\xa3\xd2\x76\x41\xb8\x03\xe3\xc6\x3a\x3b\x94\x4a\x97\x1b\x40\x2b\xa0\xcd\x64\xa2\x4a\x75\xb8\xa7\xaa\x1e\xf7\x78\x54\xd5\xee\x71\xb1\xd1\xeb\x8d\xbe\x4c\x44\x1f\xcf\x44\xd9\x9d\x35\xdf\x1e\x9e\x5c\x8f\x48\x77\x74\x3c\xca\xb2\xd9\xc0\x7d\x8b\xa1\x9e\x67\xc7\xbf\x6f\x50\x26\xab\x3a\xf8\xa5\x8b\x61\xb4\x45\xaa\xab\xfb\xb8\x4c\x21\xda\x33\x5a\x9f\x13\xa5\x15\xe6\xfd\x98\xce\x55\xbd\xbb\x83\x5c\xab\x2b\x55\x88\x38\x3b\x3f\xb1\xce\xbc\xfb\x15\x00\xd8\xe7\x35\x39\xbf\x57\xf5\x31\x89\x6b\x04\xb6\x0d\x49\xe2\x01\xff\xd1\x13\xdd\x42\x2d\x57\x5a\x23\xe2\xfb\x39\xac\x97\xea\xda\xc8\x7a\x28\x8a\x72\xff\x35\xa1\x03\xa3\xee\x6c\x6c\x99\xe4\x88\x8d\x86\x1e\xc3\xcc\x2c\xe9\xfc\x33\xc1\xf5\xaa\x8f\x86\xd7\x2b\x35\x9f\x38\x45\xe4\x9b\x51\x7b\x3b\x74\xc8\x6c\x4f\x05\xc0\x2c\x62\x3e\x8d\xa3\xa0\xc7\x83\x67\xa5\x62\x14\x85\x81\x1f\x25\x91\x17\xa5\x11\x10\x1c\x06\x51\x12\xc9\x98\x34\xb8\xca\x7d\xeb\x63\x88\xaf\x2e\x21\xbc\xf5\x6d\x59\xb1\x67\xbb\x1f\x3b\x38\xb0\x1f\x86\x11\x8d\x7d\xee\x61\xf0\x13\x29\x81\x48\x6e\x14\x10\x2c\x79\x2a\x82\x88\x0a\xec\x05\x89\xc4\x31\x90\x28\xf0\x62\xf0\xbc\x98\x09\x0f\x38\xa4\x22\x0d\x12\xd6\xb8\x6d\x3e\x14\x0c\x57\x71\x06\x74\xc4\x40\xaf\x00\xb8\xca\x44\x87\x69\x8c\x57\xbf\xdf\x73\x57\x7a\x20\x90\xd8\x18\xca\xf5\xec\x8a\xa3\x1a\xcf\x39\x47\xe8\x91\x33\xf0\x6e\xf5\xbe\x2c\x4f\xf2\x3f\xee\x19\xa4\xe2\xd2\xd6\xc7\xb2\x06\x03\x94\x7f\x3d\x97\xd0\x57\x81\x75\x54\x60\x59\xda\xdc\x81\xf8\x5b\x51\x7e\x3e\x77\x74\xbd\xad\x3a\x23\x53\xfc\xe8\xb5\xc3\x85\x86\x5c\x65\x45\x5e\x9f\x1e\xdf\x3c\x59\x13\xb7\xc8\x30\x1d\x1f\x9d\xe1\x39\x3c\xa1\x7a\xdb\x18\xf6\x51\x08\x2e\xf5\x09\xef\x82\x0e\x24\x94\x90\x73\x78\x64\x9e\x83\x53\xa6\x67\x2f\xbd\x45\xba\xb8\xd0\x4a\x3c\xf1\xdc\x3a\xed\xec\x42\xad\x8d\x88\x42\xdc\x35\xce\xec\x46\x41\x63\xaf\xe3\xaa\x1a\x77\x59\xff\x32\x7f\x4b\x83\xbb\xdd\x1c\xe3\x43\x7e\xb4\xab\xf4\x29\xc4\x09\x21\x84\x01\x15\x0c\xfb\x09\xc1\x3e\x03\xe2\x81\x08\x39\xc4\x3c\x65\x1e\x93\x32\xc2\xa4\xd7\xed\x8e\x5a\xf2\xb7\xef\x3b\x14\x38\x09\x3d\x4e\xa5\xcf\xc7\xed\x52\x3a\x9d\xcf\xf3\x4d\x47\x4d\x96\x69\x0a\xc2\x8e\x10\x3c\xf9\x9b\x60\xb6\x87\xab\x15\xe7\x12\x9a\xd4\x90\x4c\x2e\xa4\x54\x70\x52\x9c\x50\x8f\x5f\x7b\xd0\x4c\x71\x23\xa3\x2c\x47\x2b\xb3\x64\x10\x55\xed\x3d\xd4\x0c\x4f\x58\x9e\x1a\xa5\xd4\x08\x1a\x39\x6d\x7a\x3b\xb2\x33\x4b\xcd\xac\x0a\xe9\xa2\xd2\x78\x86\xf3\xd8\xd6\xd4\xba\x64\x40\x41\x23\x8b\x11\x65\x12\x3d\x14\x1b\x94\x03\x88\x2a\x03\xda\xae\xc7\xa0\x5c\xa1\x35\x9d\x83\x98\x20\x98\xcc\x27\x7b\xde\x9f\xcd\x66\xf5\xdf\xbf\xd4\x7f\x21\xf4\xca\xd5\x41\x57\xaf\xa6\xad\xc7\xe6\x85\x45\xd8\xab\x29\xc2\x6f\xda\x2f\xec\x52\x5e\x99\xa5\xb7\x6b\x9b\xfc\xf7\xe8\xf0\xaf\xe6\xb4\xd6\xf9\xc9\x8a\x3b\x70\x62\xa6\xf2\x34\xad\x5d\xd4\x90\x23\x8e\x42\xd8\x25\x77\x9b\xb6\xf6\x8d\x8b\xdb\x53\xc8\xc3\x93\x36\x4e\x2a\xb8\xd1\xcc\xd8\x7d\xb3\x1d\x46\x44\x91\x8f\xb5\xc3\x8b\x2e\x90\x80\x95\x19\x6c\x4d\xe7\xb6\x9c\x62\x83\x15\x3f\xee\xd3\x11\xfb\x19\xd1\x5c\x2d\x9d\xa2\x7d\xe4\x9b\x55\xb3\x19\x42\x6f\x0f\xe2\x17\xcc\x33\x9d\xad\x60\xd4\xc7\x3f\xdd\xc6\x03\x2c\x24\x40\x66\x79\xe5\x1d\xde\xe4\x8e\x9b\x66\xb2\x2c\x56\xd5\x87\x9e\x75\x31\x9b\xb4\x3a\xcc\xec\xe0\xb3\xca\x29\xd1\x0c\x2b\x7d\x83\x66\x06\xa2\xf6\xab\x3a\xaa\xef\x8d\x99\x8a\x6e\x96\x1a\xe9\x62\x37\x48\x7b\xe4\xfa\x1f\x66\xfa\xeb\x38\xcd\x9a\xfb\x68\x30\x3a\xe3\x92\xc1\x9d\x6c\x1f\x0d\x6f\xb5\x26\x7e\x6d\x86\x29\xd2\x45\xb5\xbb\x50\x96\xbb\x0d\xf5\xf8\x7e\xb2\x3d\x0f\x77\x93\x21\xd8\xab\x29\x7a\x65\xb1\xf9\xaa\xb3\xa3\x0c\x16\xed\x86\xea\x3c\xd7\xc5\xab\x8e\x68\x7f\x7c\x97\xed\xf6\x56\xd1\x58\x87\x19\xbf\x22\xb2\x87\xeb\x5b\x54\x3b\x72\x63\x45\x6e\x23\x29\x4d\x73\xe1\xf4\x4a\x33\x80\x34\x71\x2d\x76\x94\x1e\x0e\xb0\xf6\xce\xf7\x55\xad\xa0\x67\xb8\x2e\x79\xb4\x68\x9a\xab\x69\xf6\xe8\xb0\xb6\x99\x77\x5a\x33\x72\x5a\x33\xff\xb4\x66\xc1\x23\xcd\x8e\xb0\x62\x5d\x7f\x69\xcf\x81\xc5\x46\x3b\x24\x4c\xd0\xb7\xcb\xa5\xfb\xaa\x84\xab\x21\xfb\xf7\x22\xcb\x77\x19\xa4\x33\x9a\x8b\x19\x32\x04\xa0\xba\x28\x27\x3b\xa2\xda\xd6\xb6\x71\x36\xcf\x8b\xf2\x8c\xe3\xa1\x22\x81\x61\xdd\xe1\xbc\xc6\x20\x8c\xde\x47\x61\x4c\xa2\x38\x4e\x5b\xfc\xfd\xca\x11\xc9\x8d\x20\x84\x24\x21\xa1\xc2\x63\x40\x78\x92\xb2\x28\xe5\x84\xe1\x28\x91\xdc\x8f\x13\x41\x69\x1a\x12\x46\x63\xe9\x45\x3e\x0f\xa8\xe7\x99\xd8\xd6\x30\xa4\x81\x90\x21\xf1\x99\x0f\xf2\xd5\x23\xdc\xef\xce\x76\x55\x19\xf8\x15\xbf\xb8\x02\xd1\x78\x0b\x61\x2a\x82\x38\xa4\x0c\xa2\x34\xe4\xb1\x8c\x62\x9a\x50\xe2\x9b\x1b\x44\x9f\x26\x61\xc4\x30\x0b\x78\xec\x09\x27\x4f\x1d\x3e\x1d\xf0\x33\x04\xff\xd8\xd0\xa5\x42\xb3\xa7\x2f\xa1\x16\xa5\x07\x4a\xf4\x6e\x97\x9c\x85\xea\xee\x5e\x40\xe3\xa7\x83\x38\xee\xee\x9c\xa1\xa4\xd6\xcb\xd4\xfb\xbd\xfc\x70\x07\xf2\xf0\x9d\x76\xe3\xb0\x7e\x4c\xf9\x6c\x9c\xef\xfb\x19\x8b\xf5\x41\xb5\xcf\xc7\xc7\xa8\xd4\xd5\xf1\xc1\xae\xfc\xb9\x4f\x43\xbd\x86\xeb\x68\x27\x4a\x1b\x80\x97\x9d\x4b\xc6\x21\x0d\xd7\xb4\x45\x85\xac\x24\x86\xaa\x8f\x71\x7b\x1a\xcc\xa8\xe2\xb3\xcb\x14\x1a\xaa\x78\xe7\x89\x80\xce\xa3\xd6\xb5\xe9\x29\x27\xc2\x19\x99\x48\x4d\x17\xe0\xa9\x5b\x78\x7c\xfe\x3d\xed\xd3\xa6\x39\xe7\xda\xf5\xb2\x0b\xfc\x16\x8a\xbf\x6e\x9a\xa6\x1b\xf4\xcb\xdc\x37\xf6\x06\xe5\x44\x18\x6d\xdb\x3a\xf2\x5b\x1e\x42\x79\x07\x7a\x36\x41\xb3\xdd\xb6\x9a\x99\xb6\xfb\x2a\x8e\x0a\xe5\x85\x6e\x84\xe8\x1b\xed\x6e\xe6\x2e\x69\x2f\x5c\xdb\x1d\xe8\xce\x93\xc6\x9d\xaf\xfd\x5f\x5d\x71\x7b\x30\x77\x8e\xae\xce\x0b\x8d\xd2\x8b\xa2\xbc\xb9\xf3\x26\x78\x82\xdf\x46\x51\x82\x59\x9a\xbc\x15\x70\x77\xb3\xcc\xf2\xcd\xf6\x66\x5e\x78\x13\x0f\x4f\xfc\x46\xf2\xaa\x29\xee\x72\x72\xca\x6d\xb7\x88\x43\x12\x33\x9f\x06\x22\xe0\x42\x7a\x9c\x87\x44\x84\x11\x4b\x63\x1c\xc8\x80\x7b\x89\xc4\x04\x83\xc7\x82\x44\x30\x26\x03\x4a\x7c\xe1\x01\x04\xd2\x93\x34\x94\x32\x0d\xc6\x17\xa6\xb8\xd4\x30\x44\x49\x90\xc6\xf5\x8b\x35\x40\x79\xe6\x1a\x42\x0c\x1e\x21\x34\xc4\x21\x80\xc9\xc5\x0b\x7c\xdf\xc3\x51\x42\xb9\x14\x89\x09\x2e\x8b\xa9\x08\x13\x19\x44\x3e\xc5\x92\xb2\x94\x52\x29\x09\xf7\x20\x60\x04\x88\x20\x84\x42\xec\x09\xee\x05\x52\x50\x93\x69\x46\x45\x1c\x30\xe1\xcb\x08\x87\x69\x10\x05\x01\xa5\x7e\xc8\xc3\x24\x91\x29\xa7\x11\x03\xdf\x0f\x3c\x20\x1c\xbc\x44\x08\x1e\x78\xbe\x4f\x1a\x29\x11\x39\xd8\x7b\xe7\xb3\xa0\xf7\x48\x32\xf1\x26\x7e\x3a\xf1\x08\x9e\x7a\x1e\xf1\x1b\xf7\x37\x59\xce\x8a\x4d\xfe\x94\x0b\x06\xb1\x39\xdd\x4f\xbb\xbf\xe6\x48\x2a\x29\xfc\x1f\xb7\xef\x86\xb8\xfa\xd1\x58\x8a\x03\xd5\xef\xaa\xdf\xac\xdd\xff\x6f\x57\x9d\x6d\x08\xd8\xa2\xd3\x06\x9d\x1a\xf0\xd0\x96\x50\x59\x2e\x32\x4e\x35\xa8\x56\x4d\x99\xaa\xfc\x9f\xab\xe6\x67\x3f\x4e\xbd\xc8\x94\xbb\xe1\x65\xc0\x6d\xd4\x71\x49\x73\xbe\x68\x7e\x4c\xaa\x59\x33\xed\x1a\xb2\xa3\x47\x7a\x05\x26\xac\xae\xf3\x8c\x65\xf3\x92\xae\x3a\x0f\x5b\x37\xcf\xee\x11\xdc\xad\x44\xa6\x3a\x0f\xf3\xa2\x58\x77\x1e\x15\x6b\x1b\xb4\xd3\x79\xba\x2e\xa1\x9b\x85\x64\x1e\xeb\xb2\x6f\xf6\x4d\xde\x7d\x3a\x40\x00\x83\x8e\xea\x84\xe0\x50\x4e\xd0\xfb\xd5\x5a\x3f\xb8\xa7\x0d\x9b\xbe\x3a\x34\x0c\x9a\x36\xdc\x7e\x4b\x67\x0e\xe5\xae\x4f\x1f\xcf\xbf\x6a\x58\x18\xb4\x9c\xc3\xd9\xc1\x5b\x9d\x83\xcc\x39\xaf\x64\x06\x02\xad\xa9\x76\xd9\x4c\x76\xdc\x7d\x2c\x01\xdf\x7f\x9e\xdc\xfd\xbe\x77\xe1\xb8\xcb\x07\x53\xbe\x6d\xf9\xd0\x08\x1e\xa9\x8f\xb4\x09\xfa\x93\xf3\x02\xf5\x78\xc0\x6e\xdf\xdd\xbc\xd6\x5b\x9b\x59\xfe\x4f\xbd\xbd\x15\xdf\xdc\x34\x72\xcd\x67\xc7\xc5\xbf\xa0\x8c\x05\x22\x92\x98\x1a\xcd\x2c\xa6\x22\xe6\x02\x03\x8e\xa9\x27\x09\x66\x61\x10\x09\x86\x4d\x34\x7c\x12\xa5\x22\xe4\x9c\x61\x21\x08\xf5\x22\x88\xc3\x34\x64\x37\xf8\x06\x8f\x1b\x0c\xbd\xaf\xde\x76\x05\x9e\x3e\x99\x0d\xde\x20\x65\xfe\x4d\xad\x0f\x65\xd6\xdc\x59\xb3\xab\x71\x48\x6b\x8f\x9c\x9f\x4f\x55\x6b\xce\xfb\x88\x21\xfb\x59\xcf\x6e\x3e\x15\x2f\x81\xb6\x2e\x39\x9f\x18\xc0\x62\x3e\xb7\xd3\xa8\x10\xf6\xa4\xf8\x05\x83\x82\x76\xe4\xc2\xd7\x4b\xdf\xaf\x97\xbe\xbf\xf5\x4b\x5f\x2b\xac\x0e\xb6\xff\x63\x05\xd0\x82\x30\x82\x9d\xf7\x6a\xdc\x13\xa6\xd4\x17\x98\x44\x53\x1c\xa6\x9c\xb1\xa7\xfa\xa0\xce\x2e\xc5\x76\xf4\xee\xb9\xe7\x2e\xf9\xa2\x6b\xe2\x66\x19\xba\x46\xd5\xc7\x67\x70\xa6\xb7\x8f\x89\xc3\xe8\xe2\x63\x19\xb7\x41\x44\x62\xec\x9b\x2b\xf5\x34\x04\x16\x7b\x9c\xf8\x81\x87\xc3\x40\x50\x1a\xf9\x61\x1c\x73\x1c\x91\xa0\x59\x8b\xf0\x33\x3c\xfc\xac\x69\xa9\x7f\xdd\xa2\x79\x0d\xda\xac\xe8\xb6\x7d\x9b\xbd\x87\xc0\x5d\x7f\x3d\x72\x91\x7b\xb2\x9e\xd3\x01\x1f\x04\x48\x16\x04\x26\xff\x5e\xa6\x3c\x26\x92\x13\x96\x06\x51\x9a\x60\x90\xa1\x27\x12\x41\x70\xc2\x18\xa5\x81\xf0\xa5\xe0\x12\xf3\x30\x16\x41\x12\xc4\x94\x53\x02\x0d\x7d\xa1\xc9\x0e\x83\xca\x02\x6c\xf5\x9f\xe1\xe1\x0c\x40\xdb\x9a\x42\x2b\xed\xb2\x5d\x09\x11\x75\x2b\xba\x3e\x32\xd6\x18\x6f\x7d\x1f\x02\xe2\xa7\x09\xe6\x29\xf3\x63\x81\x83\x84\x09\x63\x98\x30\x11\x50\x42\x81\xa5\xa1\x17\x44\x29\x21\x38\x08\x03\x1c\x52\xce\x39\x91\x41\x94\x08\x0c\x32\x8d\xd2\x24\x19\xb7\x47\xb4\x7c\xd4\x7d\x84\xae\x53\x5d\xb1\x3d\xe4\x81\x1e\x71\xa5\x99\x78\xb5\x27\xbe\x03\xaa\xbf\xd6\x0f\x1a\xca\x29\xbd\x42\xfd\xa0\xaf\x25\x7b\xae\x5b\xb2\xe7\xa5\xd5\x08\xb1\xd5\xfe\xcf\x20\xee\x02\xb6\xa7\x1b\xa4\xcd\x4f\x09\x9c\xf0\x11\x81\x67\x3a\xc0\xbe\xfe\xbe\xec\x5f\x43\x03\xba\xde\x96\x39\x64\xd6\x4a\xb0\x17\xd2\x55\x83\x91\x9b\xbc\x2a\x22\x64\xb4\xf7\x26\x27\xf7\x8a\xfc\xfd\xb3\x51\x7d\x38\xd5\x21\x0f\x9d\xcf\x47\x9c\xfe\x19\x92\x23\x29\x75\xcf\x5b\xf4\xab\x57\x92\x11\xdc\x08\xf5\xb9\xad\xbe\x93\x3d\x00\x65\xd6\x6e\x82\x2e\x29\x9c\x5d\x1d\x76\xca\x7d\x5b\x00\x69\x33\xa0\x01\xa0\xfb\x85\x84\x2a\xf5\xea\x36\xff\x40\xf5\x62\x3a\x6a\x1a\x51\xed\xa2\xf4\x99\x3d\x7e\xf4\xa2\x2f\x48\xe4\xa8\xe2\xde\x5b\xb4\xbe\x5b\xc2\xbd\x57\x6a\xf6\xd7\xeb\xb9\x2c\xd1\x73\x97\xa5\x5e\x7d\xe0\xa1\xbd\xca\x92\xde\x8f\xfa\x3f\x5c\xd5\x8b\xdb\x72\x57\xf7\x9f\x9a\x9e\xcd\x8c\xba\xc9\xc1\x9a\x9b\xde\xfd\xfe\x45\xef\x08\xea\x20\x6c\x7f\x87\xa2\x03\x66\xf5\xf2\x14\x58\xab\x4a\x00\x2d\x9d\xab\x28\xd1\xed\xbb\x89\xbd\x7a\xda\xf3\x06\x55\xae\x1a\x42\x26\x51\xe1\xa2\x52\x26\xa7\xd0\xa8\x03\xed\x21\xe7\xf4\x00\x7b\x8c\x75\xfe\xd9\x76\x18\xd8\x42\x08\x65\x1d\x11\x58\x94\x68\x6c\x40\x1e\x37\x9d\x81\x4b\xaa\xeb\x55\x3c\x91\xcf\xf6\x21\x8f\xa0\xb4\x5b\xd7\x0f\x40\x45\x2f\x05\x16\x40\xc5\x29\xd8\x77\xa5\x1c\x4c\x6b\x07\xe2\xe3\x48\x3f\x19\xe7\x95\x11\xf6\x67\x78\x68\x63\x7d\x08\xc1\x46\x18\x7c\x86\x87\xd7\xeb\xea\x43\x41\xdf\x20\x5d\x98\x5d\x0a\x4a\xed\x36\xeb\xce\xd0\x1a\x42\xa6\xc3\xc1\x67\x78\xb8\x00\xb9\xd7\xab\x3e\xef\xee\xf3\x6b\x99\xd5\x43\xa5\x43\xa1\x75\x94\x50\xbd\xc9\xcc\x19\x5f\xa0\xac\x51\xed\x40\x75\xe2\xfb\xce\xd9\xdd\x17\x61\xa3\xed\x8a\x6a\x46\xaa\x9b\x90\x80\xde\x35\xdb\x60\x81\x53\x56\xfc\xcf\x76\x2c\xc2\x49\xf1\x05\x17\x2f\xf8\xf0\x96\xab\x1b\x7d\xd0\x8a\x3d\xa8\xf1\x63\xda\x54\x05\xb6\x6e\xdf\x9d\xce\xe7\x6e\xdb\x1d\xd6\x08\x19\xe0\xe6\x4c\x5c\x46\xbe\xd4\x54\xe5\x0b\x49\x44\xe3\x88\x42\x18\x61\x12\x04\xd2\x78\x0b\x70\xc8\x39\xc6\x5e\x1a\xc7\x24\x88\x38\x4b\x09\x27\x2c\x90\x1e\x10\x16\x53\x82\x03\x08\x8c\x97\x21\x05\xda\xfa\x58\x76\xe7\x03\x5e\x6d\xca\xae\x0b\x75\x1e\x5d\x29\x52\xf4\xae\x2e\x30\x7b\xfb\xce\x0a\xcc\x12\xd4\x66\xe5\x2e\x3a\x01\x35\xbf\xb1\xd6\x12\x4d\xb7\xef\x9e\x7a\x24\xbc\xdf\xae\x69\x2e\xa0\x5f\x7c\x42\xf5\xf2\xc8\x7a\xfa\xd9\xec\xe8\xf7\xd8\xf6\x8a\x4e\x09\x7a\x53\xe6\xf5\x92\x33\x55\xcf\x34\x39\xfd\xe8\xfd\x00\x36\x03\xbc\x9f\x06\xee\xdd\x55\xe1\x2e\x2a\xb0\x91\xde\xbe\xb1\x72\x06\x65\x7a\xac\x3a\x53\x0d\xc3\xfd\x3f\x03\x00\x87\x8b\xea\x31\x57\xad\x00\x00")
 
 func thorYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package evidence
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/thor"
+)
+
+type Evidence struct {
+	pool *evidence.Pool
+}
+
+func New(pool *evidence.Pool) *Evidence {
+	return &Evidence{
+		pool,
+	}
+}
+
+func (e *Evidence) handleGetDoubleSigns(w http.ResponseWriter, req *http.Request) error {
+	var signer *thor.Address
+	if s := req.URL.Query().Get("signer"); s != "" {
+		addr, err := thor.ParseAddress(s)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "signer"))
+		}
+		signer = &addr
+	}
+	list, err := e.pool.All(signer)
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		list = []*evidence.DoubleSign{}
+	}
+	return utils.WriteJSON(w, list)
+}
+
+func (e *Evidence) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/double-signs").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(e.handleGetDoubleSigns))
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package evidence_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	apievidence "github.com/vechain/thor/api/evidence"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestEvidence(t *testing.T) {
+	pool := evidence.New(muxdb.NewMem(), nil)
+	router := mux.NewRouter()
+	apievidence.New(pool).Mount(router, "/evidence")
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	key, _ := crypto.GenerateKey()
+	signer := thor.Address(crypto.PubkeyToAddress(key.PublicKey))
+	for _, ts := range []uint64{10, 20} {
+		b := new(block.Builder).Timestamp(ts).Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), key)
+		_, err := pool.Observe(b.WithSignature(sig).Header())
+		assert.Nil(t, err)
+	}
+
+	get := func(url string) (int, []*evidence.DoubleSign) {
+		res, err := http.Get(ts.URL + url)
+		assert.Nil(t, err)
+		defer res.Body.Close()
+		data, err := ioutil.ReadAll(res.Body)
+		assert.Nil(t, err)
+		var list []*evidence.DoubleSign
+		if res.StatusCode == http.StatusOK {
+			assert.Nil(t, json.Unmarshal(data, &list))
+		}
+		return res.StatusCode, list
+	}
+
+	code, list := get("/evidence/double-signs")
+	assert.Equal(t, http.StatusOK, code)
+	if assert.Equal(t, 1, len(list)) {
+		assert.Equal(t, signer, list[0].Signer)
+		assert.Nil(t, list[0].Verify())
+	}
+
+	code, list = get("/evidence/double-signs?signer=" + thor.Address{}.String())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, list)
+
+	code, _ = get("/evidence/double-signs?signer=invalid")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package liveness
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+
+	"github.com/gorilla/mux"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/liveness"
+)
+
+type Liveness struct {
+	tracker *liveness.Tracker
+}
+
+func New(tracker *liveness.Tracker) *Liveness {
+	return &Liveness{
+		tracker,
+	}
+}
+
+func (l *Liveness) handleGetMissedSlots(w http.ResponseWriter, req *http.Request) error {
+	counts, head := l.tracker.Counts()
+
+	missed := make([]*MissedCount, 0, len(counts))
+	for addr, n := range counts {
+		missed = append(missed, &MissedCount{addr, n})
+	}
+	// most missed first
+	sort.Slice(missed, func(i, j int) bool {
+		if missed[i].Count != missed[j].Count {
+			return missed[i].Count > missed[j].Count
+		}
+		return bytes.Compare(missed[i].Address[:], missed[j].Address[:]) < 0
+	})
+
+	return utils.WriteJSON(w, &MissedSlots{
+		Number: head,
+		Window: l.tracker.Window(),
+		Missed: missed,
+	})
+}
+
+func (l *Liveness) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/missed-slots").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(l.handleGetMissedSlots))
+}
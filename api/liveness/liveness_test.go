@@ -0,0 +1,49 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package liveness_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	apiliveness "github.com/vechain/thor/api/liveness"
+	"github.com/vechain/thor/liveness"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestLiveness(t *testing.T) {
+	tracker := liveness.New(muxdb.NewMem(), 10, 0, nil)
+	router := mux.NewRouter()
+	apiliveness.New(tracker).Mount(router, "/liveness")
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	a1 := thor.BytesToAddress([]byte("a1"))
+	a2 := thor.BytesToAddress([]byte("a2"))
+	assert.Nil(t, tracker.Observe(1, []thor.Address{a1}))
+	assert.Nil(t, tracker.Observe(2, []thor.Address{a2, a2}))
+
+	res, err := http.Get(ts.URL + "/liveness/missed-slots")
+	assert.Nil(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	data, err := ioutil.ReadAll(res.Body)
+	assert.Nil(t, err)
+
+	var slots apiliveness.MissedSlots
+	assert.Nil(t, json.Unmarshal(data, &slots))
+	assert.Equal(t, apiliveness.MissedSlots{
+		Number: 2,
+		Window: 10,
+		Missed: []*apiliveness.MissedCount{{a2, 2}, {a1, 1}},
+	}, slots)
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package liveness
+
+import "github.com/vechain/thor/thor"
+
+// MissedCount is the count of slots missed by a proposer.
+type MissedCount struct {
+	Address thor.Address `json:"address"`
+	Count   uint32       `json:"count"`
+}
+
+// MissedSlots is missed counts of proposers over the window ending at the block numbered Number.
+type MissedSlots struct {
+	Number uint32         `json:"number"`
+	Window uint32         `json:"window"`
+	Missed []*MissedCount `json:"missed"`
+}
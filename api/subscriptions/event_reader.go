@@ -31,6 +31,14 @@ func (er *eventReader) Read() ([]interface{}, bool, error) {
 	}
 	var msgs []interface{}
 	for _, block := range blocks {
+		// skip decoding receipts if the block bloom rules out any match
+		blockBloom, err := er.repo.GetBlockBloom(block.Header().ID())
+		if err != nil {
+			return nil, false, err
+		}
+		if blockBloom != nil && !er.filter.MayMatch(blockBloom) {
+			continue
+		}
 		receipts, err := er.repo.GetBlockReceipts(block.Header().ID())
 		if err != nil {
 			return nil, false, err
@@ -31,6 +31,14 @@ func (tr *transferReader) Read() ([]interface{}, bool, error) {
 	}
 	var msgs []interface{}
 	for _, block := range blocks {
+		// skip decoding receipts if the block bloom rules out any match
+		blockBloom, err := tr.repo.GetBlockBloom(block.Header().ID())
+		if err != nil {
+			return nil, false, err
+		}
+		if blockBloom != nil && !tr.filter.MayMatch(blockBloom) {
+			continue
+		}
 		receipts, err := tr.repo.GetBlockReceipts(block.Header().ID())
 		if err != nil {
 			return nil, false, err
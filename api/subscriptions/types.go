@@ -11,6 +11,7 @@ import (
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/thor/bloom"
 	"github.com/vechain/thor/tx"
 )
 
@@ -172,6 +173,20 @@ func (ef *EventFilter) Match(event *tx.Event) bool {
 		matchTopic(ef.Topic4, 4)
 }
 
+// MayMatch returns whether events of the block, summarized by the block bloom, may match filter.
+// False means no event of the block matches.
+func (ef *EventFilter) MayMatch(blockBloom *bloom.Filter) bool {
+	if (ef.Address != nil) && !blockBloom.Contains(ef.Address.Bytes()) {
+		return false
+	}
+	for _, topic := range []*thor.Bytes32{ef.Topic0, ef.Topic1, ef.Topic2, ef.Topic3, ef.Topic4} {
+		if (topic != nil) && !blockBloom.Contains(topic.Bytes()) {
+			return false
+		}
+	}
+	return true
+}
+
 // TransferFilter contains options for contract transfer filtering.
 type TransferFilter struct {
 	TxOrigin  *thor.Address // who send transaction
@@ -195,6 +210,18 @@ func (tf *TransferFilter) Match(transfer *tx.Transfer, origin thor.Address) bool
 	return true
 }
 
+// MayMatch returns whether transfers of the block, summarized by the block bloom, may match filter.
+// Tx origins are not in the bloom, so TxOrigin is not checked.
+func (tf *TransferFilter) MayMatch(blockBloom *bloom.Filter) bool {
+	if (tf.Sender != nil) && !blockBloom.Contains(tf.Sender.Bytes()) {
+		return false
+	}
+	if (tf.Recipient != nil) && !blockBloom.Contains(tf.Recipient.Bytes()) {
+		return false
+	}
+	return true
+}
+
 type BeatMessage struct {
 	Number      uint32       `json:"number"`
 	ID          thor.Bytes32 `json:"id"`
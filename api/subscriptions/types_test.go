@@ -0,0 +1,35 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestFilterMayMatch(t *testing.T) {
+	addr, topic, other := thor.Address{1}, thor.Bytes32{2}, thor.Address{3}
+	receipts := tx.Receipts{{
+		Outputs: []*tx.Output{{
+			Events:    tx.Events{{Address: addr, Topics: []thor.Bytes32{topic}}},
+			Transfers: tx.Transfers{{Sender: addr, Recipient: addr}},
+		}},
+	}}
+	blockBloom := receipts.Bloom()
+
+	otherTopic := thor.BytesToBytes32(other.Bytes())
+	assert.True(t, (&EventFilter{}).MayMatch(blockBloom))
+	assert.True(t, (&EventFilter{Address: &addr, Topic0: &topic}).MayMatch(blockBloom))
+	assert.False(t, (&EventFilter{Address: &other}).MayMatch(blockBloom))
+	assert.False(t, (&EventFilter{Address: &addr, Topic1: &otherTopic}).MayMatch(blockBloom))
+
+	assert.True(t, (&TransferFilter{Sender: &addr, Recipient: &addr}).MayMatch(blockBloom))
+	assert.True(t, (&TransferFilter{TxOrigin: &other}).MayMatch(blockBloom))
+	assert.False(t, (&TransferFilter{Recipient: &other}).MayMatch(blockBloom))
+}
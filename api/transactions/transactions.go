@@ -70,7 +70,7 @@ func (t *Transactions) getRawTransaction(txID thor.Bytes32, head thor.Bytes32, a
 }
 
 func (t *Transactions) getTransactionByID(txID thor.Bytes32, head thor.Bytes32, allowPending bool) (*Transaction, error) {
-	tx, meta, err := t.repo.NewChain(head).GetTransaction(txID)
+	info, err := t.repo.NewChain(head).GetTransactionInfo(txID)
 	if err != nil {
 		if t.repo.IsNotFound(err) {
 			if allowPending {
@@ -82,18 +82,13 @@ func (t *Transactions) getTransactionByID(txID thor.Bytes32, head thor.Bytes32,
 		}
 		return nil, err
 	}
-
-	summary, err := t.repo.GetBlockSummary(meta.BlockID)
-	if err != nil {
-		return nil, err
-	}
-	return convertTransaction(tx, summary.Header), nil
+	return convertTransaction(info.Tx, info.BlockHeader), nil
 }
 
 //GetTransactionReceiptByID get tx's receipt
 func (t *Transactions) getTransactionReceiptByID(txID thor.Bytes32, head thor.Bytes32) (*Receipt, error) {
 	chain := t.repo.NewChain(head)
-	tx, meta, err := chain.GetTransaction(txID)
+	info, err := chain.GetTransactionInfo(txID)
 	if err != nil {
 		if t.repo.IsNotFound(err) {
 			return nil, nil
@@ -106,12 +101,7 @@ func (t *Transactions) getTransactionReceiptByID(txID thor.Bytes32, head thor.By
 		return nil, err
 	}
 
-	summary, err := t.repo.GetBlockSummary(meta.BlockID)
-	if err != nil {
-		return nil, err
-	}
-
-	return convertReceipt(receipt, summary.Header, tx)
+	return convertReceipt(receipt, info.BlockHeader, info.Tx)
 }
 func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Request) error {
 	var rawTx *RawTx
@@ -13,8 +13,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/events"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
 )
 
 type Transfers struct {
@@ -29,6 +31,8 @@ func New(repo *chain.Repository, db *logdb.LogDB) *Transfers {
 	}
 }
 
+var energyTransferEvent, _ = builtin.Energy.ABI.EventByName("Transfer")
+
 //Filter query logs with option
 func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*FilteredTransfer, error) {
 	rng, err := events.ConvertRange(t.repo.NewBestChain(), filter.Range)
@@ -36,6 +40,14 @@ func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*Filt
 		return nil, err
 	}
 
+	switch filter.Token {
+	case "", TokenVET:
+	case TokenEnergy:
+		return t.filterEnergy(ctx, filter, rng)
+	default:
+		return nil, utils.BadRequest(errors.New("token: unsupported"))
+	}
+
 	transfers, err := t.db.FilterTransfers(ctx, &logdb.TransferFilter{
 		CriteriaSet: filter.CriteriaSet,
 		Range:       rng,
@@ -52,6 +64,48 @@ func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*Filt
 	return tLogs, nil
 }
 
+// filterEnergy queries Transfer event logs of the Energy contract, where sender and recipient are indexed.
+func (t *Transfers) filterEnergy(ctx context.Context, filter *TransferFilter, rng *logdb.Range) ([]*FilteredTransfer, error) {
+	topic0 := energyTransferEvent.ID()
+	criteriaSet := make([]*logdb.EventCriteria, 0, len(filter.CriteriaSet))
+	for _, c := range filter.CriteriaSet {
+		if c.TxOrigin != nil {
+			return nil, utils.BadRequest(errors.New("criteriaSet: txOrigin unsupported for energy"))
+		}
+		criteria := &logdb.EventCriteria{Address: &builtin.Energy.Address}
+		criteria.Topics[0] = &topic0
+		if c.Sender != nil {
+			sender := thor.BytesToBytes32(c.Sender.Bytes())
+			criteria.Topics[1] = &sender
+		}
+		if c.Recipient != nil {
+			recipient := thor.BytesToBytes32(c.Recipient.Bytes())
+			criteria.Topics[2] = &recipient
+		}
+		criteriaSet = append(criteriaSet, criteria)
+	}
+	if len(criteriaSet) == 0 {
+		criteria := &logdb.EventCriteria{Address: &builtin.Energy.Address}
+		criteria.Topics[0] = &topic0
+		criteriaSet = append(criteriaSet, criteria)
+	}
+
+	evs, err := t.db.FilterEvents(ctx, &logdb.EventFilter{
+		CriteriaSet: criteriaSet,
+		Range:       rng,
+		Options:     filter.Options,
+		Order:       filter.Order,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tLogs := make([]*FilteredTransfer, len(evs))
+	for i, ev := range evs {
+		tLogs[i] = convertEnergyTransfer(ev)
+	}
+	return tLogs, nil
+}
+
 func (t *Transfers) handleFilterTransferLogs(w http.ResponseWriter, req *http.Request) error {
 	var filter TransferFilter
 	if err := utils.ParseJSON(req.Body, &filter); err != nil {
@@ -6,6 +6,8 @@
 package transfers
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/vechain/thor/api/events"
 	"github.com/vechain/thor/logdb"
@@ -45,9 +47,34 @@ func convertTransfer(transfer *logdb.Transfer) *FilteredTransfer {
 	}
 }
 
+// tokens of transfers
+const (
+	TokenVET    = "vet"
+	TokenEnergy = "energy"
+)
+
 type TransferFilter struct {
 	CriteriaSet []*logdb.TransferCriteria
 	Range       *events.Range
 	Options     *logdb.Options
 	Order       logdb.Order //default asc
+	Token       string      //vet (default) or energy
+}
+
+// convertEnergyTransfer converts a Transfer event log of the Energy contract.
+func convertEnergyTransfer(event *logdb.Event) *FilteredTransfer {
+	amount := math.HexOrDecimal256(*new(big.Int).SetBytes(event.Data))
+	return &FilteredTransfer{
+		Sender:    thor.BytesToAddress(event.Topics[1].Bytes()),
+		Recipient: thor.BytesToAddress(event.Topics[2].Bytes()),
+		Amount:    &amount,
+		Meta: LogMeta{
+			BlockID:        event.BlockID,
+			BlockNumber:    event.BlockNumber,
+			BlockTimestamp: event.BlockTime,
+			TxID:           event.TxID,
+			TxOrigin:       event.TxOrigin,
+			ClauseIndex:    event.ClauseIndex,
+		},
+	}
 }
@@ -9,6 +9,9 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/state"
 )
 
 type httpError struct {
@@ -50,6 +53,7 @@ func Forbidden(cause error) error {
 type HandlerFunc func(http.ResponseWriter, *http.Request) error
 
 // WrapHandlerFunc convert HandlerFunc to http.HandlerFunc.
+// Errors caused by accessing pruned state are responded with http.StatusForbidden.
 func WrapHandlerFunc(f HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		err := f(w, r)
@@ -60,6 +64,8 @@ func WrapHandlerFunc(f HandlerFunc) http.HandlerFunc {
 				} else {
 					w.WriteHeader(he.status)
 				}
+			} else if state.IsMissingTrieNode(errors.Cause(err)) {
+				http.Error(w, "historical state unavailable: pruned by state retention policy of the node", http.StatusForbidden)
 			} else {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
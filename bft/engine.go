@@ -0,0 +1,177 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package bft implements the finality gadget on top of PoA.
+//
+// The chain is divided into epochs of thor.CheckpointInterval blocks, and the first block of
+// an epoch is its checkpoint. Every block signed by an authority node is a vote of its signer
+// for the checkpoint of the epoch, on the branch the block is built on.
+// A checkpoint is justified once more than 2/3 of the proposers at the checkpoint have voted for it,
+// and finalized once the checkpoint of the next epoch on the same branch is justified as well.
+package bft
+
+import (
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// Engine tracks justified checkpoints of the best chain, and advances the finalized block of the repository.
+// It's not thread-safe.
+type Engine struct {
+	repo       *chain.Repository
+	stater     *state.Stater
+	forkConfig thor.ForkConfig
+	proposers  *simplelru.LRU // checkpoint id => proposers eligible to vote
+	justified  *simplelru.LRU // id of the last block of a complete epoch => whether its checkpoint is justified
+}
+
+// NewEngine creates a finality engine.
+func NewEngine(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfig) *Engine {
+	proposers, _ := simplelru.NewLRU(16, nil)
+	justified, _ := simplelru.NewLRU(64, nil)
+	return &Engine{
+		repo:       repo,
+		stater:     stater,
+		forkConfig: forkConfig,
+		proposers:  proposers,
+		justified:  justified,
+	}
+}
+
+// Update finalizes the highest checkpoint, whose next checkpoint is also justified on the best chain.
+// It returns id of the newly finalized block, or zero id if nothing changed.
+func (e *Engine) Update() (thor.Bytes32, error) {
+	bestChain := e.repo.NewBestChain()
+	bestNum := block.Number(bestChain.HeadID())
+	finalizedNum := block.Number(e.repo.FinalizedBlockID())
+
+	if bestNum < e.forkConfig.FINALITY || bestNum < thor.CheckpointInterval {
+		return thor.Bytes32{}, nil
+	}
+
+	// the latest epoch whose next epoch has started
+	for epoch := bestNum/thor.CheckpointInterval - 1; ; epoch-- {
+		checkpointNum := epoch * thor.CheckpointInterval
+		if checkpointNum <= finalizedNum || checkpointNum < e.forkConfig.FINALITY {
+			return thor.Bytes32{}, nil
+		}
+
+		ok, err := e.IsJustified(bestChain, epoch+1)
+		if err != nil {
+			return thor.Bytes32{}, err
+		}
+		if ok {
+			if ok, err = e.IsJustified(bestChain, epoch); err != nil {
+				return thor.Bytes32{}, err
+			}
+		}
+		if ok {
+			id, err := bestChain.GetBlockID(checkpointNum)
+			if err != nil {
+				return thor.Bytes32{}, err
+			}
+			if err := e.repo.SetFinalizedBlockID(id); err != nil {
+				return thor.Bytes32{}, errors.WithMessage(err, "set finalized block")
+			}
+			return id, nil
+		}
+		if epoch == 0 {
+			return thor.Bytes32{}, nil
+		}
+	}
+}
+
+// IsJustified returns whether checkpoint of the epoch is justified on the chain.
+func (e *Engine) IsJustified(c *chain.Chain, epoch uint32) (bool, error) {
+	var (
+		headNum = block.Number(c.HeadID())
+		first   = epoch * thor.CheckpointInterval
+		last    = first + thor.CheckpointInterval - 1
+	)
+	if first > headNum || first < e.forkConfig.FINALITY {
+		return false, nil
+	}
+
+	// the result of a complete epoch never changes
+	var lastID thor.Bytes32
+	if last <= headNum {
+		id, err := c.GetBlockID(last)
+		if err != nil {
+			return false, err
+		}
+		if v, ok := e.justified.Get(id); ok {
+			return v.(bool), nil
+		}
+		lastID = id
+	} else {
+		last = headNum
+	}
+
+	checkpoint, err := c.GetBlockHeader(first)
+	if err != nil {
+		return false, err
+	}
+	proposers, err := e.getProposers(checkpoint)
+	if err != nil {
+		return false, err
+	}
+
+	voters := make(map[thor.Address]bool)
+	justified := false
+	for num := first; num <= last && !justified; num++ {
+		header := checkpoint
+		if num != first {
+			if header, err = c.GetBlockHeader(num); err != nil {
+				return false, err
+			}
+		}
+		if num == 0 {
+			// genesis has no signer
+			continue
+		}
+		signer, err := header.Signer()
+		if err != nil {
+			return false, err
+		}
+		if proposers[signer] {
+			voters[signer] = true
+			justified = len(voters)*3 > len(proposers)*2
+		}
+	}
+
+	if !lastID.IsZero() {
+		e.justified.Add(lastID, justified)
+	}
+	return justified, nil
+}
+
+// getProposers returns proposers at the checkpoint, who are eligible to vote.
+func (e *Engine) getProposers(checkpoint *block.Header) (map[thor.Address]bool, error) {
+	if v, ok := e.proposers.Get(checkpoint.ID()); ok {
+		return v.(map[thor.Address]bool), nil
+	}
+
+	st := e.stater.NewState(checkpoint.StateRoot())
+	list, err := builtin.Authority.Native(st).AllCandidates()
+	if err != nil {
+		return nil, err
+	}
+	picked, err := poa.NewCandidates(list).Pick(st)
+	if err != nil {
+		return nil, err
+	}
+	proposers := make(map[thor.Address]bool, len(picked))
+	for _, p := range picked {
+		proposers[p.Address] = true
+	}
+	e.proposers.Add(checkpoint.ID(), proposers)
+	return proposers, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package bft_test
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/bft"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func newTestRepo(t *testing.T) (*chain.Repository, *state.Stater) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, err := genesis.NewDevnet().Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, b0)
+	assert.Nil(t, err)
+	return repo, stater
+}
+
+// extend appends count blocks signed by the key to the best chain.
+func extend(t *testing.T, repo *chain.Repository, count int, key *ecdsa.PrivateKey) {
+	var blocks []*block.Block
+	var receipts []tx.Receipts
+	parent := repo.BestBlock().Header()
+	for i := 0; i < count; i++ {
+		b := new(block.Builder).
+			ParentID(parent.ID()).
+			Timestamp(parent.Timestamp() + thor.BlockInterval).
+			StateRoot(parent.StateRoot()).
+			Build()
+		sig, err := crypto.Sign(b.Header().SigningHash().Bytes(), key)
+		assert.Nil(t, err)
+		b = b.WithSignature(sig)
+		blocks = append(blocks, b)
+		receipts = append(receipts, nil)
+		parent = b.Header()
+	}
+	assert.Nil(t, repo.AddBlocks(blocks, receipts, true))
+}
+
+func TestEngine(t *testing.T) {
+	repo, stater := newTestRepo(t)
+	engine := bft.NewEngine(repo, stater, thor.ForkConfig{})
+
+	// the only authority node of devnet
+	authority := genesis.DevAccounts()[0].PrivateKey
+	outsider, _ := crypto.GenerateKey()
+
+	// epoch 0 and 1 voted by the authority
+	extend(t, repo, int(thor.CheckpointInterval)*2-1, authority)
+	assert.Equal(t, M(true, nil), M(engine.IsJustified(repo.NewBestChain(), 1)))
+	// checkpoint of genesis is never finalized by votes
+	assert.Equal(t, M(thor.Bytes32{}, nil), M(engine.Update()))
+	assert.Equal(t, repo.GenesisBlock().Header().ID(), repo.FinalizedBlockID())
+
+	// epoch 2 voted by outsider only
+	extend(t, repo, int(thor.CheckpointInterval), outsider)
+	assert.Equal(t, M(false, nil), M(engine.IsJustified(repo.NewBestChain(), 2)))
+	assert.Equal(t, M(thor.Bytes32{}, nil), M(engine.Update()))
+
+	// epoch 3 voted by the authority, which justifies checkpoint 2 but not finalizes it
+	extend(t, repo, int(thor.CheckpointInterval), authority)
+	assert.Equal(t, M(false, nil), M(engine.IsJustified(repo.NewBestChain(), 2)))
+
+	// vote in epoch 4 finalizes checkpoint 3
+	extend(t, repo, 1, authority)
+	best := repo.NewBestChain()
+	cp3, err := best.GetBlockID(thor.CheckpointInterval * 3)
+	assert.Nil(t, err)
+	assert.Equal(t, M(cp3, nil), M(engine.Update()))
+	assert.Equal(t, cp3, repo.FinalizedBlockID())
+	// nothing changed
+	assert.Equal(t, M(thor.Bytes32{}, nil), M(engine.Update()))
+}
+
+func TestEngineBeforeFork(t *testing.T) {
+	repo, stater := newTestRepo(t)
+	forkConfig := thor.NoFork
+	forkConfig.FINALITY = thor.CheckpointInterval * 2
+	engine := bft.NewEngine(repo, stater, forkConfig)
+
+	authority := genesis.DevAccounts()[0].PrivateKey
+	extend(t, repo, int(thor.CheckpointInterval)*3-1, authority)
+
+	// checkpoint 1 is before fork
+	assert.Equal(t, M(false, nil), M(engine.IsJustified(repo.NewBestChain(), 1)))
+	assert.Equal(t, M(thor.Bytes32{}, nil), M(engine.Update()))
+
+	extend(t, repo, 1, authority)
+	cp2, err := repo.NewBestChain().GetBlockID(thor.CheckpointInterval * 2)
+	assert.Nil(t, err)
+	assert.Equal(t, M(cp2, nil), M(engine.Update()))
+}
+
+func M(a ...interface{}) []interface{} {
+	return a
+}
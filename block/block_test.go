@@ -6,6 +6,7 @@
 package block_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -103,3 +104,73 @@ func TestBlock(t *testing.T) {
 	assert.Equal(t, block.Header().ID(), bx.Header().ID())
 	assert.Equal(t, block.Header().TxsFeatures(), bx.Header().TxsFeatures())
 }
+
+func TestBlockJSON(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	trx := new(tx.Builder).Clause(tx.NewClause(&thor.Address{})).Nonce(1).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+	trx = trx.WithSignature(sig)
+
+	blk := new(Builder).
+		ParentID(thor.Bytes32{0, 0, 0, 1}).
+		Timestamp(10).
+		GasLimit(100).
+		GasUsed(50).
+		TotalScore(3).
+		Transaction(trx).
+		Build()
+	sig, _ = crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+	blk = blk.WithSignature(sig)
+
+	data, err := json.Marshal(blk)
+	assert.Nil(t, err)
+
+	var obj map[string]map[string]interface{}
+	json.Unmarshal(data, &obj)
+	assert.Equal(t, "0x2", obj["header"]["number"])
+	assert.Equal(t, blk.Header().ID().String(), obj["header"]["id"])
+	assert.Equal(t, "0x64", obj["header"]["gasLimit"])
+
+	var decoded Block
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, blk.Header().ID(), decoded.Header().ID())
+	assert.Equal(t, blk.Transactions().RootHash(), decoded.Transactions().RootHash())
+	enc1, _ := rlp.EncodeToBytes(blk)
+	enc2, _ := rlp.EncodeToBytes(&decoded)
+	assert.Equal(t, enc1, enc2)
+
+	obj["header"]["timestamp"] = "0xb"
+	data, _ = json.Marshal(obj)
+	assert.EqualError(t, json.Unmarshal(data, &decoded), "id mismatch")
+}
+
+func TestBlockProto(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	trx := new(tx.Builder).Clause(tx.NewClause(&thor.Address{})).Nonce(1).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+	trx = trx.WithSignature(sig)
+
+	blk := new(Builder).
+		ParentID(thor.Bytes32{0, 0, 0, 1}).
+		Timestamp(10).
+		GasLimit(100).
+		GasUsed(50).
+		TotalScore(3).
+		Beneficiary(thor.Address{1}).
+		Transaction(trx).
+		Build()
+	sig, _ = crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+	blk = blk.WithSignature(sig)
+
+	data, err := blk.MarshalProto()
+	assert.Nil(t, err)
+
+	var decoded Block
+	assert.Nil(t, decoded.UnmarshalProto(data))
+	assert.Equal(t, blk.Header().ID(), decoded.Header().ID())
+	enc1, _ := rlp.EncodeToBytes(blk)
+	enc2, _ := rlp.EncodeToBytes(&decoded)
+	assert.Equal(t, enc1, enc2)
+
+	assert.EqualError(t, decoded.UnmarshalProto(nil), "header missing")
+}
@@ -6,6 +6,9 @@
 package block
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
@@ -76,6 +79,50 @@ func (b *Builder) TransactionFeatures(features tx.Features) *Builder {
 	return b
 }
 
+// Endorsements set endorsements of the parent block, which should be aggregated by AggregateEndorsements.
+func (b *Builder) Endorsements(sigs [][]byte) *Builder {
+	b.headerBody.Endorsements = nil
+	for _, sig := range sigs {
+		b.headerBody.Endorsements = append(b.headerBody.Endorsements, append([]byte(nil), sig...))
+	}
+	return b
+}
+
+// Validate checks consistency of fields and transactions set so far.
+func (b *Builder) Validate() error {
+	if b.headerBody.ParentID.IsZero() {
+		return errors.New("parent id not set")
+	}
+	if b.headerBody.GasUsed > b.headerBody.GasLimit {
+		return fmt.Errorf("gas used exceeds limit: used %v, limit %v", b.headerBody.GasUsed, b.headerBody.GasLimit)
+	}
+
+	features := b.headerBody.TxsRootFeatures.Features
+	seen := make(map[thor.Bytes32]bool, len(b.txs))
+	for i, tx := range b.txs {
+		// id of unsigned tx is zero
+		if id := tx.ID(); !id.IsZero() {
+			if seen[id] {
+				return fmt.Errorf("tx #%v: duplicated", i)
+			}
+			seen[id] = true
+		}
+
+		if tx.Features().IsDelegated() && !features.IsDelegated() {
+			return fmt.Errorf("tx #%v: unsupported features", i)
+		}
+	}
+	return nil
+}
+
+// BuildValidated validates and builds a block object.
+func (b *Builder) BuildValidated() (*Block, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b.Build(), nil
+}
+
 // Build build a block object.
 func (b *Builder) Build() *Block {
 	header := Header{body: b.headerBody}
@@ -0,0 +1,46 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	. "github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestBuilderValidate(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	tx1 := new(tx.Builder).Clause(tx.NewClause(&thor.Address{})).Build()
+	sig, _ := crypto.Sign(tx1.SigningHash().Bytes(), key)
+	tx1 = tx1.WithSignature(sig)
+	var features tx.Features
+	features.SetDelegated(true)
+	tx2 := new(tx.Builder).Clause(tx.NewClause(nil)).Features(features).Build()
+
+	newBuilder := func() *Builder {
+		return new(Builder).
+			ParentID(thor.Bytes32{0, 0, 0, 1}).
+			GasLimit(100).
+			GasUsed(50).
+			Transaction(tx1)
+	}
+
+	blk, err := newBuilder().BuildValidated()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), blk.Header().Number())
+	assert.Equal(t, tx.Transactions{tx1}.RootHash(), blk.Header().TxsRoot())
+
+	assert.Nil(t, newBuilder().TransactionFeatures(features).Transaction(tx2).Validate())
+
+	assert.EqualError(t, newBuilder().ParentID(thor.Bytes32{}).Validate(), "parent id not set")
+	assert.EqualError(t, newBuilder().GasUsed(101).Validate(), "gas used exceeds limit: used 101, limit 100")
+	assert.EqualError(t, newBuilder().Transaction(tx1).Validate(), "tx #1: duplicated")
+	assert.EqualError(t, newBuilder().Transaction(tx2).Validate(), "tx #1: unsupported features")
+}
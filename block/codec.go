@@ -0,0 +1,83 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Codec compresses rlp encoded block data for storage.
+// Canonical rlp is always used for hashing, so codecs never affect consensus rules.
+type Codec interface {
+	// Format returns the tag of the codec, which is stored along with the encoded data.
+	// It should be unique among codecs, and not greater than MaxFormat to be told apart from plain rlp.
+	Format() byte
+	Encode(data []byte) ([]byte, error)
+	Decode(data []byte) ([]byte, error)
+}
+
+// codec formats
+const (
+	SnappyFormat = byte(1)
+	ZstdFormat   = byte(2)
+
+	// MaxFormat is the upper bound of codec formats.
+	// Encoded txs of any type and receipts always start with a byte >= 0x80 (see tx.MinTxType),
+	// so [0x00, 0x7f] is reserved for codecs.
+	MaxFormat = byte(0x7f)
+)
+
+type snappyCodec struct{}
+
+// SnappyCodec compresses with snappy.
+var SnappyCodec Codec = snappyCodec{}
+
+func (snappyCodec) Format() byte { return SnappyFormat }
+
+func (snappyCodec) Encode(data []byte) ([]byte, error) {
+	return snappy.Encode(nil, data), nil
+}
+
+func (snappyCodec) Decode(data []byte) ([]byte, error) {
+	return snappy.Decode(nil, data)
+}
+
+type zstdCodec struct {
+	enc *zstd.Encoder
+	dec *zstd.Decoder
+}
+
+// NewZstdCodec create a codec compresses with zstd, at the level as of the zstd command line tool.
+// The dict is optional, which is usually trained offline with samples of txs and receipts, e.g. by 'zstd --train'.
+// Data encoded with a dict can only be decoded with the same dict.
+func NewZstdCodec(dict []byte, level int) (Codec, error) {
+	eopts := []zstd.EOption{zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level))}
+	var dopts []zstd.DOption
+	if len(dict) > 0 {
+		eopts = append(eopts, zstd.WithEncoderDict(dict))
+		dopts = append(dopts, zstd.WithDecoderDicts(dict))
+	}
+	enc, err := zstd.NewWriter(nil, eopts...)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := zstd.NewReader(nil, dopts...)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdCodec{enc, dec}, nil
+}
+
+func (c *zstdCodec) Format() byte { return ZstdFormat }
+
+func (c *zstdCodec) Encode(data []byte) ([]byte, error) {
+	return c.enc.EncodeAll(data, nil), nil
+}
+
+func (c *zstdCodec) Decode(data []byte) ([]byte, error) {
+	return c.dec.DecodeAll(data, nil)
+}
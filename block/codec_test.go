@@ -0,0 +1,60 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/vechain/thor/block"
+)
+
+func TestCodec(t *testing.T) {
+	data := bytes.Repeat([]byte("thor"), 100)
+
+	// trained by 'zstd --train'
+	dict, err := ioutil.ReadFile("testdata/zstd.dict")
+	assert.Nil(t, err)
+
+	zstdCodec, err := NewZstdCodec(nil, 3)
+	assert.Nil(t, err)
+	zstdDictCodec, err := NewZstdCodec(dict, 3)
+	assert.Nil(t, err)
+
+	for _, codec := range []Codec{
+		SnappyCodec,
+		zstdCodec,
+		zstdDictCodec,
+	} {
+		enc, err := codec.Encode(data)
+		assert.Nil(t, err)
+		assert.True(t, len(enc) < len(data))
+		dec, err := codec.Decode(enc)
+		assert.Nil(t, err)
+		assert.Equal(t, data, dec)
+	}
+}
+
+func TestZstdDict(t *testing.T) {
+	dict, _ := ioutil.ReadFile("testdata/zstd.dict")
+	withDict, _ := NewZstdCodec(dict, 3)
+	withoutDict, _ := NewZstdCodec(nil, 3)
+
+	data := []byte("vechain42 thor7 energy13 transfer99 clause3 gas51 nonce8 block0 receipt77")
+	enc, err := withDict.Encode(data)
+	assert.Nil(t, err)
+	plain, _ := withoutDict.Encode(data)
+	assert.True(t, len(enc) < len(plain))
+
+	// requires the same dict
+	_, err = withoutDict.Decode(enc)
+	assert.NotNil(t, err)
+
+	_, err = NewZstdCodec([]byte("not a dict"), 3)
+	assert.NotNil(t, err)
+}
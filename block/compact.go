@@ -0,0 +1,94 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Compact is the compact form of a block, which contains header and tx ids only.
+// It's used to announce blocks, so that peers can fetch missing txs only.
+type Compact struct {
+	header *Header
+	txIDs  []thor.Bytes32
+}
+
+// ToCompact returns the compact form of the block.
+func (b *Block) ToCompact() *Compact {
+	ids := make([]thor.Bytes32, 0, len(b.txs))
+	for _, tx := range b.txs {
+		ids = append(ids, tx.ID())
+	}
+	return &Compact{b.header, ids}
+}
+
+// Header returns the block header.
+func (c *Compact) Header() *Header {
+	return c.header
+}
+
+// TxIDs returns a copy of tx ids.
+func (c *Compact) TxIDs() []thor.Bytes32 {
+	return append([]thor.Bytes32(nil), c.txIDs...)
+}
+
+// Fill recovers the full block with the given txs, which can be in any order and contain irrelevant ones.
+// The txs root is verified.
+func (c *Compact) Fill(txs tx.Transactions) (*Block, error) {
+	byID := make(map[thor.Bytes32]*tx.Transaction, len(txs))
+	for _, tx := range txs {
+		byID[tx.ID()] = tx
+	}
+
+	filled := make(tx.Transactions, 0, len(c.txIDs))
+	missing := 0
+	for _, id := range c.txIDs {
+		if tx, ok := byID[id]; ok {
+			filled = append(filled, tx)
+		} else {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return nil, fmt.Errorf("%v txs missing", missing)
+	}
+	if filled.RootHash() != c.header.TxsRoot() {
+		return nil, errors.New("txs root mismatch")
+	}
+	return &Block{header: c.header, txs: filled}, nil
+}
+
+// EncodeRLP implements rlp.Encoder.
+func (c *Compact) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{
+		c.header,
+		c.txIDs,
+	})
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (c *Compact) DecodeRLP(s *rlp.Stream) error {
+	payload := struct {
+		Header Header
+		TxIDs  []thor.Bytes32
+	}{}
+
+	if err := s.Decode(&payload); err != nil {
+		return err
+	}
+
+	*c = Compact{
+		header: &payload.Header,
+		txIDs:  payload.TxIDs,
+	}
+	return nil
+}
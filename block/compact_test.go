@@ -0,0 +1,44 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	. "github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestCompact(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	newTx := func(nonce uint64) *tx.Transaction {
+		trx := new(tx.Builder).Clause(tx.NewClause(&thor.Address{})).Nonce(nonce).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+		return trx.WithSignature(sig)
+	}
+	tx1, tx2, tx3 := newTx(1), newTx(2), newTx(3)
+
+	blk := new(Builder).ParentID(thor.Bytes32{0, 0, 0, 1}).Transaction(tx1).Transaction(tx2).Build()
+
+	data, err := rlp.EncodeToBytes(blk.ToCompact())
+	assert.Nil(t, err)
+	var compact Compact
+	assert.Nil(t, rlp.DecodeBytes(data, &compact))
+	assert.Equal(t, blk.Header().ID(), compact.Header().ID())
+	assert.Equal(t, []thor.Bytes32{tx1.ID(), tx2.ID()}, compact.TxIDs())
+
+	filled, err := compact.Fill(tx.Transactions{tx3, tx2, tx1})
+	assert.Nil(t, err)
+	assert.Equal(t, blk.Header().ID(), filled.Header().ID())
+	assert.Equal(t, blk.Transactions().RootHash(), filled.Transactions().RootHash())
+
+	_, err = compact.Fill(tx.Transactions{tx1})
+	assert.EqualError(t, err, "1 txs missing")
+}
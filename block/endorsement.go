@@ -0,0 +1,91 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vechain/thor/thor"
+)
+
+// Endorsements are extra signatures on a block made by nodes other than the proposer,
+// as evidence that they have accepted the block.
+// They are carried by the child block, and covered by its signing hash, so that they
+// are committed by the child block ID, and can't be stripped or reordered.
+
+// EndorsementHash computes the hash that endorsers sign to endorse the block.
+// It's derived from block ID, so the proposer is included.
+func (h *Header) EndorsementHash() thor.Bytes32 {
+	return endorsementHash(h.ID())
+}
+
+func endorsementHash(blockID thor.Bytes32) thor.Bytes32 {
+	return thor.Blake2b([]byte("endorsement"), blockID[:])
+}
+
+// Endorsements returns a copy of endorsement signatures of the parent block.
+func (h *Header) Endorsements() [][]byte {
+	sigs := make([][]byte, 0, len(h.body.Endorsements))
+	for _, sig := range h.body.Endorsements {
+		sigs = append(sigs, append([]byte(nil), sig...))
+	}
+	return sigs
+}
+
+// Endorsers recovers addresses of endorsers of the parent block, in order of endorsements.
+func (h *Header) Endorsers() ([]thor.Address, error) {
+	hash := endorsementHash(h.body.ParentID)
+	endorsers := make([]thor.Address, 0, len(h.body.Endorsements))
+	for i, sig := range h.body.Endorsements {
+		pub, err := crypto.SigToPub(hash[:], sig)
+		if err != nil {
+			return nil, fmt.Errorf("endorsement #%v: %v", i, err)
+		}
+		endorsers = append(endorsers, thor.Address(crypto.PubkeyToAddress(*pub)))
+	}
+	return endorsers, nil
+}
+
+// AggregateEndorsements aggregates endorsement signatures of the block with given ID,
+// to be put into its child block by Builder.Endorsements.
+// Invalid signatures result in error, and signatures of the same endorser are deduplicated.
+// Endorsements are sorted by endorser address to be canonical.
+func AggregateEndorsements(blockID thor.Bytes32, sigs ...[]byte) ([][]byte, error) {
+	hash := endorsementHash(blockID)
+
+	type endorsement struct {
+		endorser thor.Address
+		sig      []byte
+	}
+	var (
+		all  []endorsement
+		seen = make(map[thor.Address]bool)
+	)
+	for i, sig := range sigs {
+		pub, err := crypto.SigToPub(hash[:], sig)
+		if err != nil {
+			return nil, fmt.Errorf("endorsement #%v: %v", i, err)
+		}
+		endorser := thor.Address(crypto.PubkeyToAddress(*pub))
+		if seen[endorser] {
+			continue
+		}
+		seen[endorser] = true
+		all = append(all, endorsement{endorser, append([]byte(nil), sig...)})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return bytes.Compare(all[i].endorser[:], all[j].endorser[:]) < 0
+	})
+
+	aggregated := make([][]byte, 0, len(all))
+	for _, e := range all {
+		aggregated = append(aggregated, e.sig)
+	}
+	return aggregated, nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	. "github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+)
+
+func TestEndorsements(t *testing.T) {
+	proposer, _ := crypto.GenerateKey()
+	sign := func(blk *Block) *Block {
+		sig, _ := crypto.Sign(blk.Header().SigningHash().Bytes(), proposer)
+		return blk.WithSignature(sig)
+	}
+	parent := sign(new(Builder).ParentID(thor.Bytes32{0, 0, 0, 1}).Timestamp(10).Build())
+
+	var (
+		keys []*ecdsa.PrivateKey
+		sigs [][]byte
+	)
+	for i := 0; i < 3; i++ {
+		key, _ := crypto.GenerateKey()
+		sig, _ := crypto.Sign(parent.Header().EndorsementHash().Bytes(), key)
+		keys = append(keys, key)
+		sigs = append(sigs, sig)
+	}
+
+	// with duplicated one
+	aggregated, err := AggregateEndorsements(parent.Header().ID(), sigs[0], sigs[1], sigs[1], sigs[2])
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(aggregated))
+
+	_, err = AggregateEndorsements(parent.Header().ID(), []byte{1})
+	assert.NotNil(t, err)
+
+	build := func(sigs [][]byte) *Block {
+		return sign(new(Builder).ParentID(parent.Header().ID()).Timestamp(20).Endorsements(sigs).Build())
+	}
+	blk := build(nil)
+	legacy, _ := rlp.EncodeToBytes(blk.Header())
+	endorsed := build(aggregated)
+	assert.Equal(t, aggregated, endorsed.Header().Endorsements())
+
+	endorsers, err := endorsed.Header().Endorsers()
+	assert.Nil(t, err)
+	for i := 1; i < len(endorsers); i++ {
+		assert.True(t, bytes.Compare(endorsers[i-1][:], endorsers[i][:]) < 0)
+	}
+	for _, key := range keys {
+		assert.Contains(t, endorsers, thor.Address(crypto.PubkeyToAddress(key.PublicKey)))
+	}
+
+	// endorsements are covered by the signing hash
+	assert.NotEqual(t, blk.Header().SigningHash(), endorsed.Header().SigningHash())
+	stripped := build(aggregated[:2]).Header()
+	assert.NotEqual(t, endorsed.Header().ID(), stripped.ID())
+
+	// encoding without endorsements is unchanged
+	var h Header
+	assert.Nil(t, rlp.DecodeBytes(legacy, &h))
+	assert.Equal(t, blk.Header().ID(), h.ID())
+	assert.Equal(t, 0, len(h.Endorsements()))
+
+	data, _ := rlp.EncodeToBytes(endorsed.Header())
+	assert.Nil(t, rlp.DecodeBytes(data, &h))
+	assert.Equal(t, endorsed.Header().ID(), h.ID())
+	assert.Equal(t, endorsed.Header().Endorsements(), h.Endorsements())
+}
@@ -45,6 +45,10 @@ type headerBody struct {
 	ReceiptsRoot    thor.Bytes32
 
 	Signature []byte
+
+	// Endorsements signatures of endorsers of the parent block, see endorsement.go.
+	// Tail of the list, so headers without endorsements are encoded as before.
+	Endorsements [][]byte `rlp:"tail"`
 }
 
 // ParentID returns id of parent block.
@@ -135,8 +139,7 @@ func (h *Header) SigningHash() (hash thor.Bytes32) {
 	}
 	defer func() { h.cache.signingHash.Store(hash) }()
 
-	hw := thor.NewBlake2b()
-	rlp.Encode(hw, []interface{}{
+	fields := []interface{}{
 		h.body.ParentID,
 		h.body.Timestamp,
 		h.body.GasLimit,
@@ -148,7 +151,14 @@ func (h *Header) SigningHash() (hash thor.Bytes32) {
 		&h.body.TxsRootFeatures,
 		h.body.StateRoot,
 		h.body.ReceiptsRoot,
-	})
+	}
+	// appended only if present, so the hash of headers without endorsements is unchanged
+	if len(h.body.Endorsements) > 0 {
+		fields = append(fields, h.body.Endorsements)
+	}
+
+	hw := thor.NewBlake2b()
+	rlp.Encode(hw, fields)
 	hw.Sum(hash[:0])
 	return
 }
@@ -190,6 +200,55 @@ func (h *Header) Signer() (signer thor.Address, err error) {
 	return
 }
 
+// VerifySignature checks whether the header is signed by the given signer.
+func (h *Header) VerifySignature(signer thor.Address) error {
+	actual, err := h.Signer()
+	if err != nil {
+		return fmt.Errorf("block signer unavailable: %v", err)
+	}
+	if actual != signer {
+		return fmt.Errorf("block signer mismatch: want %v, got %v", signer, actual)
+	}
+	return nil
+}
+
+// ValidateAgainstParent checks number, timestamp, gas and score of the header against its parent.
+func (h *Header) ValidateAgainstParent(parent *Header) error {
+	return h.ValidateAgainstParentWithInterval(parent, thor.BlockInterval)
+}
+
+// ValidateAgainstParentWithInterval is like ValidateAgainstParent, but with the given block interval.
+func (h *Header) ValidateAgainstParentWithInterval(parent *Header, interval uint64) error {
+	if h.ParentID() != parent.ID() {
+		return fmt.Errorf("block parent mismatch: parent %v, current parent id %v", parent.ID(), h.ParentID())
+	}
+
+	if h.Number() != parent.Number()+1 {
+		return fmt.Errorf("block number invalid: parent %v, current %v", parent.Number(), h.Number())
+	}
+
+	if h.Timestamp() <= parent.Timestamp() {
+		return fmt.Errorf("block timestamp behind parents: parent %v, current %v", parent.Timestamp(), h.Timestamp())
+	}
+
+	if (h.Timestamp()-parent.Timestamp())%interval != 0 {
+		return fmt.Errorf("block interval not rounded: parent %v, current %v", parent.Timestamp(), h.Timestamp())
+	}
+
+	if !GasLimit(h.GasLimit()).IsValid(parent.GasLimit()) {
+		return fmt.Errorf("block gas limit invalid: parent %v, current %v", parent.GasLimit(), h.GasLimit())
+	}
+
+	if h.GasUsed() > h.GasLimit() {
+		return fmt.Errorf("block gas used exceeds limit: limit %v, used %v", h.GasLimit(), h.GasUsed())
+	}
+
+	if h.TotalScore() <= parent.TotalScore() {
+		return fmt.Errorf("block total score invalid: parent %v, current %v", parent.TotalScore(), h.TotalScore())
+	}
+	return nil
+}
+
 // EncodeRLP implements rlp.Encoder
 func (h *Header) EncodeRLP(w io.Writer) error {
 	return rlp.Encode(w, &h.body)
@@ -227,9 +286,11 @@ func (h *Header) String() string {
 	TxsFeatures:    %v
 	StateRoot:      %v
 	ReceiptsRoot:   %v
-	Signature:      0x%x`, h.ID(), h.Number(), h.body.ParentID, h.body.Timestamp, signerStr,
+	Signature:      0x%x
+	Endorsements:   %v`, h.ID(), h.Number(), h.body.ParentID, h.body.Timestamp, signerStr,
 		h.body.Beneficiary, h.body.GasLimit, h.body.GasUsed, h.body.TotalScore,
-		h.body.TxsRootFeatures.Root, h.body.TxsRootFeatures.Features, h.body.StateRoot, h.body.ReceiptsRoot, h.body.Signature)
+		h.body.TxsRootFeatures.Root, h.body.TxsRootFeatures.Features, h.body.StateRoot, h.body.ReceiptsRoot, h.body.Signature,
+		len(h.body.Endorsements))
 }
 
 // BetterThan return if this block is better than other one.
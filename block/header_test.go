@@ -9,6 +9,8 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/thor"
 )
 
@@ -55,3 +57,50 @@ func TestHeader_BetterThan(t *testing.T) {
 		})
 	}
 }
+
+func TestHeader_VerifySignature(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer := thor.Address(crypto.PubkeyToAddress(key.PublicKey))
+
+	blk := new(Builder).ParentID(thor.Bytes32{0, 0, 0, 1}).Build()
+	assert.NotNil(t, blk.Header().VerifySignature(signer))
+
+	sig, _ := crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+	h := blk.WithSignature(sig).Header()
+	assert.Nil(t, h.VerifySignature(signer))
+	assert.NotNil(t, h.VerifySignature(thor.Address{}))
+}
+
+func TestHeader_ValidateAgainstParent(t *testing.T) {
+	parent := new(Builder).
+		ParentID(thor.Bytes32{0, 0, 0, 1}).
+		Timestamp(1000).
+		GasLimit(thor.InitialGasLimit).
+		TotalScore(10).
+		Build().Header()
+
+	newBuilder := func() *Builder {
+		return new(Builder).
+			ParentID(parent.ID()).
+			Timestamp(1000 + thor.BlockInterval).
+			GasLimit(thor.InitialGasLimit).
+			TotalScore(11)
+	}
+
+	assert.Nil(t, newBuilder().Build().Header().ValidateAgainstParent(parent))
+
+	tests := []struct {
+		name    string
+		builder *Builder
+	}{
+		{"parent", newBuilder().ParentID(thor.Bytes32{0, 0, 0, 2, 1})},
+		{"timestamp behind", newBuilder().Timestamp(1000)},
+		{"interval not rounded", newBuilder().Timestamp(1001)},
+		{"gas limit", newBuilder().GasLimit(thor.InitialGasLimit * 2)},
+		{"gas used", newBuilder().GasUsed(thor.InitialGasLimit + 1)},
+		{"total score", newBuilder().TotalScore(10)},
+	}
+	for _, tt := range tests {
+		assert.NotNil(t, tt.builder.Build().Header().ValidateAgainstParent(parent), tt.name)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// headerJSON is the canonical json form of header.
+// The number and id fields are derived, and verified when unmarshaling.
+type headerJSON struct {
+	Number       hexutil.Uint64  `json:"number"`
+	ID           thor.Bytes32    `json:"id"`
+	ParentID     thor.Bytes32    `json:"parentID"`
+	Timestamp    hexutil.Uint64  `json:"timestamp"`
+	GasLimit     hexutil.Uint64  `json:"gasLimit"`
+	Beneficiary  thor.Address    `json:"beneficiary"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	TotalScore   hexutil.Uint64  `json:"totalScore"`
+	TxsRoot      thor.Bytes32    `json:"txsRoot"`
+	TxsFeatures  hexutil.Uint64  `json:"txsFeatures"`
+	StateRoot    thor.Bytes32    `json:"stateRoot"`
+	ReceiptsRoot thor.Bytes32    `json:"receiptsRoot"`
+	Signature    hexutil.Bytes   `json:"signature"`
+	Endorsements []hexutil.Bytes `json:"endorsements,omitempty"`
+}
+
+type blockJSON struct {
+	Header       *Header         `json:"header"`
+	Transactions tx.Transactions `json:"transactions"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (h *Header) MarshalJSON() ([]byte, error) {
+	var endorsements []hexutil.Bytes
+	for _, sig := range h.body.Endorsements {
+		endorsements = append(endorsements, sig)
+	}
+	return json.Marshal(&headerJSON{
+		Number:       hexutil.Uint64(h.Number()),
+		ID:           h.ID(),
+		ParentID:     h.body.ParentID,
+		Timestamp:    hexutil.Uint64(h.body.Timestamp),
+		GasLimit:     hexutil.Uint64(h.body.GasLimit),
+		Beneficiary:  h.body.Beneficiary,
+		GasUsed:      hexutil.Uint64(h.body.GasUsed),
+		TotalScore:   hexutil.Uint64(h.body.TotalScore),
+		TxsRoot:      h.body.TxsRootFeatures.Root,
+		TxsFeatures:  hexutil.Uint64(h.body.TxsRootFeatures.Features),
+		StateRoot:    h.body.StateRoot,
+		ReceiptsRoot: h.body.ReceiptsRoot,
+		Signature:    h.body.Signature,
+		Endorsements: endorsements,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (h *Header) UnmarshalJSON(data []byte) error {
+	var obj headerJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if obj.TxsFeatures > math.MaxUint32 {
+		return errors.New("txsFeatures overflow")
+	}
+
+	header := Header{body: headerBody{
+		ParentID:    obj.ParentID,
+		Timestamp:   uint64(obj.Timestamp),
+		GasLimit:    uint64(obj.GasLimit),
+		Beneficiary: obj.Beneficiary,
+		GasUsed:     uint64(obj.GasUsed),
+		TotalScore:  uint64(obj.TotalScore),
+		TxsRootFeatures: txsRootFeatures{
+			obj.TxsRoot,
+			tx.Features(obj.TxsFeatures),
+		},
+		StateRoot:    obj.StateRoot,
+		ReceiptsRoot: obj.ReceiptsRoot,
+		Signature:    obj.Signature,
+	}}
+	for _, sig := range obj.Endorsements {
+		header.body.Endorsements = append(header.body.Endorsements, sig)
+	}
+	if uint64(header.Number()) != uint64(obj.Number) {
+		return errors.New("number mismatch")
+	}
+	if header.ID() != obj.ID {
+		return errors.New("id mismatch")
+	}
+	*h = Header{body: header.body}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *Block) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&blockJSON{b.header, b.txs})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The txs root is not verified, same as decoding RLP.
+func (b *Block) UnmarshalJSON(data []byte) error {
+	var obj blockJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if obj.Header == nil {
+		return errors.New("header missing")
+	}
+	*b = Block{
+		header: obj.Header,
+		txs:    obj.Transactions,
+	}
+	return nil
+}
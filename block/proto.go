@@ -0,0 +1,155 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"errors"
+	"math"
+
+	"github.com/vechain/thor/internal/protoenc"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// field numbers defined in block.proto
+const (
+	pbHeaderParentID     = 1
+	pbHeaderTimestamp    = 2
+	pbHeaderGasLimit     = 3
+	pbHeaderBeneficiary  = 4
+	pbHeaderGasUsed      = 5
+	pbHeaderTotalScore   = 6
+	pbHeaderTxsRoot      = 7
+	pbHeaderTxsFeatures  = 8
+	pbHeaderStateRoot    = 9
+	pbHeaderReceiptsRoot = 10
+	pbHeaderSignature    = 11
+	pbHeaderEndorsements = 12
+
+	pbBlockHeader       = 1
+	pbBlockTransactions = 2
+)
+
+// MarshalProto encodes the header in protobuf format, as defined in block.proto.
+func (h *Header) MarshalProto() ([]byte, error) {
+	var b []byte
+	b = protoenc.AppendBytesField(b, pbHeaderParentID, h.body.ParentID.Bytes())
+	b = protoenc.AppendVarintField(b, pbHeaderTimestamp, h.body.Timestamp)
+	b = protoenc.AppendVarintField(b, pbHeaderGasLimit, h.body.GasLimit)
+	b = protoenc.AppendBytesField(b, pbHeaderBeneficiary, h.body.Beneficiary.Bytes())
+	b = protoenc.AppendVarintField(b, pbHeaderGasUsed, h.body.GasUsed)
+	b = protoenc.AppendVarintField(b, pbHeaderTotalScore, h.body.TotalScore)
+	b = protoenc.AppendBytesField(b, pbHeaderTxsRoot, h.body.TxsRootFeatures.Root.Bytes())
+	b = protoenc.AppendVarintField(b, pbHeaderTxsFeatures, uint64(h.body.TxsRootFeatures.Features))
+	b = protoenc.AppendBytesField(b, pbHeaderStateRoot, h.body.StateRoot.Bytes())
+	b = protoenc.AppendBytesField(b, pbHeaderReceiptsRoot, h.body.ReceiptsRoot.Bytes())
+	b = protoenc.AppendBytesField(b, pbHeaderSignature, h.body.Signature)
+	for _, sig := range h.body.Endorsements {
+		b = protoenc.AppendRepeatedBytesField(b, pbHeaderEndorsements, sig)
+	}
+	return b, nil
+}
+
+// UnmarshalProto decodes the header from protobuf format.
+func (h *Header) UnmarshalProto(data []byte) error {
+	var body headerBody
+	bytes32 := func(dst *thor.Bytes32, v []byte) error {
+		if len(v) != 32 {
+			return errors.New("hash should be 32 bytes")
+		}
+		*dst = thor.BytesToBytes32(v)
+		return nil
+	}
+	if err := protoenc.ConsumeFields(data, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case pbHeaderParentID:
+			return bytes32(&body.ParentID, data)
+		case pbHeaderTimestamp:
+			body.Timestamp = v
+		case pbHeaderGasLimit:
+			body.GasLimit = v
+		case pbHeaderBeneficiary:
+			if len(data) != 20 {
+				return errors.New("beneficiary should be 20 bytes")
+			}
+			body.Beneficiary = thor.BytesToAddress(data)
+		case pbHeaderGasUsed:
+			body.GasUsed = v
+		case pbHeaderTotalScore:
+			body.TotalScore = v
+		case pbHeaderTxsRoot:
+			return bytes32(&body.TxsRootFeatures.Root, data)
+		case pbHeaderTxsFeatures:
+			if v > math.MaxUint32 {
+				return errors.New("txs features overflow")
+			}
+			body.TxsRootFeatures.Features = tx.Features(v)
+		case pbHeaderStateRoot:
+			return bytes32(&body.StateRoot, data)
+		case pbHeaderReceiptsRoot:
+			return bytes32(&body.ReceiptsRoot, data)
+		case pbHeaderSignature:
+			body.Signature = append([]byte(nil), data...)
+		case pbHeaderEndorsements:
+			body.Endorsements = append(body.Endorsements, append([]byte(nil), data...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	*h = Header{body: body}
+	return nil
+}
+
+// MarshalProto encodes the block in protobuf format, as defined in block.proto.
+func (b *Block) MarshalProto() ([]byte, error) {
+	header, err := b.header.MarshalProto()
+	if err != nil {
+		return nil, err
+	}
+	// header is always encoded, even if empty
+	enc := protowire.AppendTag(nil, pbBlockHeader, protowire.BytesType)
+	enc = protowire.AppendBytes(enc, header)
+	for _, tx := range b.txs {
+		data, err := tx.MarshalProto()
+		if err != nil {
+			return nil, err
+		}
+		enc = protoenc.AppendRepeatedBytesField(enc, pbBlockTransactions, data)
+	}
+	return enc, nil
+}
+
+// UnmarshalProto decodes the block from protobuf format.
+// The txs root is not verified, same as decoding RLP.
+func (b *Block) UnmarshalProto(data []byte) error {
+	var (
+		header *Header
+		txs    tx.Transactions
+	)
+	if err := protoenc.ConsumeFields(data, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case pbBlockHeader:
+			header = &Header{}
+			return header.UnmarshalProto(data)
+		case pbBlockTransactions:
+			var trx tx.Transaction
+			if err := trx.UnmarshalProto(data); err != nil {
+				return err
+			}
+			txs = append(txs, &trx)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if header == nil {
+		return errors.New("header missing")
+	}
+	*b = Block{header: header, txs: txs}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block_test
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	. "github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+var (
+	protoMessageRe = regexp.MustCompile(`^message (\w+) {$`)
+	protoFieldRe   = regexp.MustCompile(`^(repeated )?(\w+) (\w+) = (\d+);$`)
+	protoImportRe  = regexp.MustCompile(`^import "(.+)";$`)
+)
+
+// loadProtoFile parses the proto file into descriptor. Only the subset used by block.proto and tx.proto is supported.
+func loadProtoFile(t *testing.T, name string) *descriptorpb.FileDescriptorProto {
+	f, err := os.Open("../" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	fd := &descriptorpb.FileDescriptorProto{
+		Name:   proto.String(name),
+		Syntax: proto.String("proto3"),
+	}
+	var msg *descriptorpb.DescriptorProto
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if m := protoImportRe.FindStringSubmatch(line); m != nil {
+			fd.Dependency = append(fd.Dependency, m[1])
+		} else if strings.HasPrefix(line, "package ") {
+			fd.Package = proto.String(strings.TrimSuffix(strings.TrimPrefix(line, "package "), ";"))
+		} else if m := protoMessageRe.FindStringSubmatch(line); m != nil {
+			msg = &descriptorpb.DescriptorProto{Name: proto.String(m[1])}
+			fd.MessageType = append(fd.MessageType, msg)
+		} else if m := protoFieldRe.FindStringSubmatch(line); m != nil {
+			if msg == nil {
+				t.Fatalf("field outside message: %s", line)
+			}
+			num, _ := strconv.Atoi(m[4])
+			field := &descriptorpb.FieldDescriptorProto{
+				Name:     proto.String(m[3]),
+				JsonName: proto.String(m[3]),
+				Number:   proto.Int32(int32(num)),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}
+			if m[1] != "" {
+				field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			}
+			if typ, ok := descriptorpb.FieldDescriptorProto_Type_value["TYPE_"+strings.ToUpper(m[2])]; ok {
+				field.Type = descriptorpb.FieldDescriptorProto_Type(typ).Enum()
+			} else {
+				field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+				field.TypeName = proto.String("." + fd.GetPackage() + "." + m[2])
+			}
+			msg.Field = append(msg.Field, field)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+func loadBlockSchema(t *testing.T) protoreflect.MessageDescriptor {
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			loadProtoFile(t, "tx/tx.proto"),
+			loadProtoFile(t, "block/block.proto"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc, err := files.FindDescriptorByName("thor.Block")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return desc.(protoreflect.MessageDescriptor)
+}
+
+// TestBlockProtoSchema round-trips a block through the generic protobuf codec,
+// with the schema loaded from block.proto and tx.proto.
+func TestBlockProtoSchema(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	dep := thor.Bytes32{1}
+	trx := new(tx.Builder).
+		ChainTag(1).
+		BlockRef(tx.NewBlockRef(2)).
+		Expiration(3).
+		Clause(tx.NewClause(&thor.Address{1}).WithData([]byte{1, 2})).
+		Clause(tx.NewClause(nil)).
+		GasPriceCoef(4).
+		Gas(5).
+		DependsOn(&dep).
+		Nonce(6).
+		Features(tx.DelegationFeature).
+		Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+	trx = trx.WithSignature(sig)
+
+	blk := new(Builder).
+		ParentID(thor.Bytes32{0, 0, 0, 1}).
+		Timestamp(10).
+		GasLimit(100).
+		GasUsed(50).
+		TotalScore(3).
+		Beneficiary(thor.Address{1}).
+		StateRoot(thor.Bytes32{2}).
+		ReceiptsRoot(thor.Bytes32{3}).
+		TransactionFeatures(1).
+		Transaction(trx).
+		Build()
+	sig, _ = crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+	blk = blk.WithSignature(sig)
+
+	data, err := blk.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := dynamicpb.NewMessage(loadBlockSchema(t))
+	if err := proto.Unmarshal(data, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	// fields decoded by the generic codec
+	fields := msg.Descriptor().Fields()
+	header := msg.Get(fields.ByName("header")).Message()
+	headerFields := header.Descriptor().Fields()
+	assert.Equal(t, blk.Header().ParentID().Bytes(), header.Get(headerFields.ByName("parent_id")).Bytes())
+	assert.Equal(t, blk.Header().Timestamp(), header.Get(headerFields.ByName("timestamp")).Uint())
+	assert.Equal(t, blk.Header().Beneficiary().Bytes(), header.Get(headerFields.ByName("beneficiary")).Bytes())
+	assert.Equal(t, uint64(blk.Header().TxsFeatures()), header.Get(headerFields.ByName("txs_features")).Uint())
+	assert.Equal(t, blk.Header().Signature(), header.Get(headerFields.ByName("signature")).Bytes())
+
+	txs := msg.Get(fields.ByName("transactions")).List()
+	assert.Equal(t, 1, txs.Len())
+	txMsg := txs.Get(0).Message()
+	txFields := txMsg.Descriptor().Fields()
+	assert.Equal(t, uint64(trx.ChainTag()), txMsg.Get(txFields.ByName("chain_tag")).Uint())
+	assert.Equal(t, uint64(trx.Expiration()), txMsg.Get(txFields.ByName("expiration")).Uint())
+	assert.Equal(t, trx.Nonce(), txMsg.Get(txFields.ByName("nonce")).Uint())
+	assert.Equal(t, dep.Bytes(), txMsg.Get(txFields.ByName("depends_on")).Bytes())
+	assert.Equal(t, uint64(trx.Features()), txMsg.Get(txFields.ByName("features")).Uint())
+
+	clauses := txMsg.Get(txFields.ByName("clauses")).List()
+	assert.Equal(t, 2, clauses.Len())
+	clauseFields := clauses.Get(0).Message().Descriptor().Fields()
+	assert.Equal(t, trx.Clauses()[0].To().Bytes(), clauses.Get(0).Message().Get(clauseFields.ByName("to")).Bytes())
+	assert.Equal(t, []byte{1, 2}, clauses.Get(0).Message().Get(clauseFields.ByName("data")).Bytes())
+	assert.False(t, clauses.Get(1).Message().Has(clauseFields.ByName("to")))
+
+	// encoded by the generic codec, and decoded back
+	enc, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, data, enc)
+
+	var decoded Block
+	if err := decoded.UnmarshalProto(enc); err != nil {
+		t.Fatal(err)
+	}
+	enc1, _ := rlp.EncodeToBytes(blk)
+	enc2, _ := rlp.EncodeToBytes(&decoded)
+	assert.Equal(t, enc1, enc2)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vechain/thor/thor"
+)
+
+// Sealer signs block headers.
+// It's to delegate block signing to HSMs or remote signers, instead of holding the private key in-process.
+type Sealer interface {
+	// Seal signs the signing hash of a header, and returns the 65 bytes [R || S || V] signature.
+	Seal(sigHash thor.Bytes32) ([]byte, error)
+}
+
+// SealerFunc implements Sealer with a function.
+type SealerFunc func(sigHash thor.Bytes32) ([]byte, error)
+
+// Seal implements Sealer.
+func (f SealerFunc) Seal(sigHash thor.Bytes32) ([]byte, error) {
+	return f(sigHash)
+}
+
+// NewKeySealer create a sealer signs with the in-process private key.
+func NewKeySealer(privateKey *ecdsa.PrivateKey) Sealer {
+	return SealerFunc(func(sigHash thor.Bytes32) ([]byte, error) {
+		return crypto.Sign(sigHash.Bytes(), privateKey)
+	})
+}
+
+// Seal create a new block object signed by the sealer.
+func (b *Block) Seal(sealer Sealer) (*Block, error) {
+	sig, err := sealer.Seal(b.header.SigningHash())
+	if err != nil {
+		return nil, err
+	}
+	return b.WithSignature(sig), nil
+}
@@ -16,6 +16,13 @@ import (
 var (
 	initialSupplyKey = thor.Blake2b([]byte("initial-supply"))
 	totalAddSubKey   = thor.Blake2b([]byte("total-add-sub"))
+
+	// TotalAddSubKey is the storage key of the accumulated amounts of energy added and subtracted.
+	// Add and Sub only accumulate them, so changes made by txs executed apart can be merged.
+	TotalAddSubKey = totalAddSubKey
+	// TotalsReadKey is never written, but read whenever the totals are read for their values,
+	// to tell such reads from accumulation.
+	TotalsReadKey = thor.Blake2b([]byte("total-add-sub-read"))
 )
 
 // Energy implements energy operations.
@@ -68,6 +75,15 @@ func (e *Energy) SetInitialSupply(token *big.Int, energy *big.Int) error {
 	})
 }
 
+// AddInitialSupply adds token and energy to the initial supply.
+func (e *Energy) AddInitialSupply(token *big.Int, energy *big.Int) error {
+	init, err := e.getInitialSupply()
+	if err != nil {
+		return err
+	}
+	return e.SetInitialSupply(new(big.Int).Add(init.Token, token), new(big.Int).Add(init.Energy, energy))
+}
+
 // TokenTotalSupply returns total supply of VET.
 func (e *Energy) TokenTotalSupply() (*big.Int, error) {
 	init, err := e.getInitialSupply()
@@ -94,6 +110,9 @@ func (e *Energy) TotalSupply() (*big.Int, error) {
 
 // TotalBurned returns energy totally burned.
 func (e *Energy) TotalBurned() (*big.Int, error) {
+	if _, err := e.state.GetStorage(e.addr, TotalsReadKey); err != nil {
+		return nil, err
+	}
 	total, err := e.getTotalAddSub()
 	if err != nil {
 		return nil, err
@@ -101,6 +120,26 @@ func (e *Energy) TotalBurned() (*big.Int, error) {
 	return new(big.Int).Sub(total.TotalSub, total.TotalAdd), nil
 }
 
+// TotalAddSub returns the accumulated amounts of energy added and subtracted.
+func (e *Energy) TotalAddSub() (add *big.Int, sub *big.Int, err error) {
+	total, err := e.getTotalAddSub()
+	if err != nil {
+		return nil, nil, err
+	}
+	return total.TotalAdd, total.TotalSub, nil
+}
+
+// AccumulateTotalAddSub adds amounts to the accumulated amounts of energy added and subtracted.
+func (e *Energy) AccumulateTotalAddSub(add *big.Int, sub *big.Int) error {
+	total, err := e.getTotalAddSub()
+	if err != nil {
+		return err
+	}
+	total.TotalAdd = new(big.Int).Add(total.TotalAdd, add)
+	total.TotalSub = new(big.Int).Add(total.TotalSub, sub)
+	return e.setTotalAddSub(total)
+}
+
 // Get returns energy of an account at given block time.
 func (e *Energy) Get(addr thor.Address) (*big.Int, error) {
 	return e.state.GetEnergy(addr, e.blockTime)
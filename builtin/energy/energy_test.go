@@ -65,3 +65,15 @@ func TestEnergyGrowth(t *testing.T) {
 	assert.Equal(t, x, bal1)
 
 }
+
+func TestInitialSupply(t *testing.T) {
+	db := muxdb.NewMem()
+	st := state.New(db, thor.Bytes32{})
+
+	eng := New(thor.BytesToAddress([]byte("eng")), st, 10)
+	assert.Nil(t, eng.SetInitialSupply(big.NewInt(100), big.NewInt(10)))
+	assert.Nil(t, eng.AddInitialSupply(big.NewInt(5), big.NewInt(1)))
+
+	assert.Equal(t, M(big.NewInt(105), nil), M(eng.TokenTotalSupply()))
+	assert.Equal(t, M(big.NewInt(11), nil), M(eng.TotalSupply()))
+}
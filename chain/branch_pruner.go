@@ -0,0 +1,112 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	// max count of block summaries visited by each batch.
+	pruneBatchSize = 1024
+)
+
+// PruneBranches deletes blocks which are not on the best chain and have number less than beforeNum.
+// Headers, txs and receipts of these blocks are removed. It returns count of pruned blocks.
+//
+// Branches with any block number < beforeNum will be broken, so beforeNum should be
+// far enough (confirmation depth) below the best block.
+func (r *Repository) PruneBranches(ctx context.Context, beforeNum uint32) (count int, err error) {
+	if r.readOnly {
+		return 0, errReadOnly
+	}
+	if beforeNum == 0 {
+		return 0, nil
+	}
+	if bestNum := r.BestBlock().Header().Number(); beforeNum > bestNum {
+		beforeNum = bestNum
+	}
+
+	bestChain := r.NewBestChain()
+	err = r.data.Batch(func(putter kv.PutFlusher) error {
+		var summaries []*BlockSummary
+		flush := func() error {
+			for _, summary := range summaries {
+				if err := r.deleteBlock(putter, summary); err != nil {
+					return err
+				}
+				// deleteBlock is shared with the freezer, which keeps blooms
+				if err := deleteBlockBloom(putter, summary.Header.ID()); err != nil {
+					return err
+				}
+			}
+			count += len(summaries)
+			summaries = summaries[:0]
+			return putter.Flush()
+		}
+
+		it := kv.NewIterator(blockSummaryBucket.ProxyStore(r.data), nil, nil)
+		for i := 1; it.Next(); i++ {
+			id := thor.BytesToBytes32(it.Key())
+			if block.Number(id) >= beforeNum {
+				break
+			}
+			has, err := bestChain.HasBlock(id)
+			if err != nil {
+				return err
+			}
+			if !has {
+				var summary BlockSummary
+				if err := rlp.DecodeBytes(it.Value(), &summary); err != nil {
+					return err
+				}
+				summaries = append(summaries, &summary)
+			}
+
+			if i%pruneBatchSize == 0 {
+				if err := flush(); err != nil {
+					return err
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+			}
+		}
+		if err := it.Error(); err != nil {
+			return err
+		}
+		return flush()
+	})
+	return
+}
+
+func (r *Repository) deleteBlock(putter kv.Putter, summary *BlockSummary) error {
+	id := summary.Header.ID()
+	key := makeTxKey(id)
+	for i := range summary.Txs {
+		key.SetIndex(uint64(i))
+		if err := deleteTransaction(putter, key); err != nil {
+			return err
+		}
+		if err := deleteReceipt(putter, key); err != nil {
+			return err
+		}
+		r.caches.txs.Remove(key)
+		r.caches.receipts.Remove(key)
+	}
+	if err := deleteBlockSummary(putter, id); err != nil {
+		return err
+	}
+	r.caches.summaries.Remove(id)
+	return nil
+}
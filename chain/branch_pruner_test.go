@@ -0,0 +1,45 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/tx"
+)
+
+func TestPruneBranches(t *testing.T) {
+	repo := newTestRepo()
+
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	b2 := newBlock(b1, 20)
+	b3 := newBlock(b2, 30)
+	b4 := newBlock(b3, 40)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2, b3, b4}, []tx.Receipts{nil, nil, nil, nil}, true))
+
+	tx1 := newTx()
+	b2x := newBlock(b1, 20, tx1)
+	b3x := newBlock(b2x, 30)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b2x, b3x}, []tx.Receipts{{&tx.Receipt{}}, nil}, false))
+
+	// nothing to prune below b2
+	assert.Equal(t, M(0, nil), M(repo.PruneBranches(context.Background(), 2)))
+
+	assert.Equal(t, M(1, nil), M(repo.PruneBranches(context.Background(), 3)))
+	_, err := repo.GetBlockSummary(b2x.Header().ID())
+	assert.True(t, repo.IsNotFound(err))
+	_, err = repo.GetBlockSummary(b3x.Header().ID())
+	assert.Nil(t, err)
+
+	assert.Equal(t, M(1, nil), M(repo.PruneBranches(context.Background(), 4)))
+	for _, b := range []*block.Block{b1, b2, b3, b4} {
+		_, err := repo.GetBlock(b.Header().ID())
+		assert.Nil(t, err)
+	}
+}
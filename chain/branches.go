@@ -0,0 +1,55 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+)
+
+// Branch describes a branch of blocks, identified by its head block.
+type Branch struct {
+	HeadID thor.Bytes32
+	// Length is count of blocks not on the best chain. It's 0 for the best chain.
+	Length uint32
+}
+
+// Leaves returns all branches stored in repository, i.e. blocks without child.
+// The result is sorted by head block number in descending order.
+func (r *Repository) Leaves() ([]*Branch, error) {
+	leaves := make(map[thor.Bytes32]bool)
+
+	// block summaries are iterated in ascending order of block number, so parent always comes before child.
+	it := kv.NewIterator(blockSummaryBucket.ProxyStore(r.data), nil, nil)
+	for it.Next() {
+		var summary BlockSummary
+		if err := rlp.DecodeBytes(it.Value(), &summary); err != nil {
+			return nil, err
+		}
+		delete(leaves, summary.Header.ParentID())
+		leaves[summary.Header.ID()] = true
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	bestChain := r.NewBestChain()
+	branches := make([]*Branch, 0, len(leaves))
+	for id := range leaves {
+		ids, err := r.NewChain(id).Exclude(bestChain)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, &Branch{id, uint32(len(ids))})
+	}
+	sort.Slice(branches, func(i, j int) bool {
+		return string(branches[i].HeadID[:]) > string(branches[j].HeadID[:])
+	})
+	return branches, nil
+}
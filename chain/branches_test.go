@@ -0,0 +1,32 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/tx"
+)
+
+func TestLeaves(t *testing.T) {
+	repo := newTestRepo()
+
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	b2 := newBlock(b1, 20)
+	b3 := newBlock(b2, 30)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2, b3}, []tx.Receipts{nil, nil, nil}, true))
+
+	b2x := newBlock(b1, 20)
+	assert.Nil(t, repo.AddBlock(b2x, nil))
+
+	assert.Equal(t, M([]*chain.Branch{
+		{HeadID: b3.Header().ID(), Length: 0},
+		{HeadID: b2x.Header().ID(), Length: 1},
+	}, nil), M(repo.Leaves()))
+}
@@ -6,26 +6,60 @@
 package chain
 
 import (
+	"sync/atomic"
+	"time"
+
 	lru "github.com/hashicorp/golang-lru"
 )
 
+// CacheStats contains statistics of a cache.
+type CacheStats struct {
+	Hit      uint64
+	Miss     uint64
+	LoadTime time.Duration // total time spent on loading missed values from kv store
+}
+
+// HitRate returns the ratio of hit count to total lookups.
+func (cs *CacheStats) HitRate() float64 {
+	if total := cs.Hit + cs.Miss; total > 0 {
+		return float64(cs.Hit) / float64(total)
+	}
+	return 0
+}
+
 type cache struct {
 	*lru.ARCCache
+	hit      uint64
+	miss     uint64
+	loadTime int64
 }
 
 func newCache(maxSize int) *cache {
 	c, _ := lru.NewARC(maxSize)
-	return &cache{c}
+	return &cache{ARCCache: c}
 }
 
 func (c *cache) GetOrLoad(key interface{}, load func() (interface{}, error)) (interface{}, error) {
 	if value, ok := c.Get(key); ok {
+		atomic.AddUint64(&c.hit, 1)
 		return value, nil
 	}
+	atomic.AddUint64(&c.miss, 1)
+
+	startTime := time.Now()
 	value, err := load()
+	atomic.AddInt64(&c.loadTime, int64(time.Since(startTime)))
 	if err != nil {
 		return nil, err
 	}
 	c.Add(key, value)
 	return value, nil
 }
+
+func (c *cache) Stats() CacheStats {
+	return CacheStats{
+		Hit:      atomic.LoadUint64(&c.hit),
+		Miss:     atomic.LoadUint64(&c.miss),
+		LoadTime: time.Duration(atomic.LoadInt64(&c.loadTime)),
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
 	"github.com/vechain/thor/tx"
 )
 
@@ -123,6 +124,47 @@ func (c *Chain) GetBlockHeader(num uint32) (*block.Header, error) {
 	return summary.Header, nil
 }
 
+// GetBlockHeaders returns at most count block headers in ascending order, starting from fromNum.
+// Block ids are read by a single pass of index trie iteration.
+func (c *Chain) GetBlockHeaders(fromNum, count uint32) ([]*block.Header, error) {
+	headNum := block.Number(c.headID)
+	if count == 0 || fromNum > headNum {
+		return nil, nil
+	}
+	if n := headNum - fromNum + 1; count > n {
+		count = n
+	}
+
+	indexTrie, err := c.lazyInit()
+	if err != nil {
+		return nil, err
+	}
+
+	var start [4]byte
+	binary.BigEndian.PutUint32(start[:], fromNum)
+
+	headers := make([]*block.Header, 0, count)
+	it := trie.NewIterator(indexTrie.NodeIterator(start[:]))
+	for uint32(len(headers)) < count && it.Next() {
+		// keys of tx metas are 32 bytes long
+		if len(it.Key) != 4 {
+			continue
+		}
+		summary, err := c.repo.GetBlockSummary(thor.BytesToBytes32(it.Value))
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, summary.Header)
+	}
+	if it.Err != nil {
+		return nil, it.Err
+	}
+	if uint32(len(headers)) != count {
+		return nil, errNotFound
+	}
+	return headers, nil
+}
+
 // GetBlock returns block by given block number.
 func (c *Chain) GetBlock(num uint32) (*block.Block, error) {
 	id, err := c.GetBlockID(num)
@@ -139,7 +181,7 @@ func (c *Chain) GetTransaction(id thor.Bytes32) (*tx.Transaction, *TxMeta, error
 		return nil, nil, err
 	}
 
-	key := makeTxKey(txMeta.BlockID, txInfix)
+	key := makeTxKey(txMeta.BlockID)
 	key.SetIndex(txMeta.Index)
 	tx, err := c.repo.getTransaction(key)
 	if err != nil {
@@ -148,6 +190,33 @@ func (c *Chain) GetTransaction(id thor.Bytes32) (*tx.Transaction, *TxMeta, error
 	return tx, txMeta, nil
 }
 
+// TxInfo contains tx along with its meta and location.
+type TxInfo struct {
+	Tx   *tx.Transaction
+	Meta *TxMeta
+	// BlockHeader is header of the block which contains the tx.
+	BlockHeader *block.Header
+	// OnBestChain indicates whether the block is on the best chain.
+	OnBestChain bool
+}
+
+// GetTransactionInfo returns tx along with meta, block header by given tx id.
+func (c *Chain) GetTransactionInfo(id thor.Bytes32) (*TxInfo, error) {
+	tx, meta, err := c.GetTransaction(id)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := c.repo.GetBlockSummary(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	onBest, err := c.repo.NewBestChain().HasBlock(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	return &TxInfo{tx, meta, summary.Header, onBest}, nil
+}
+
 // GetTransactionReceipt returns tx receipt by given tx id.
 func (c *Chain) GetTransactionReceipt(txID thor.Bytes32) (*tx.Receipt, error) {
 	txMeta, err := c.GetTransactionMeta(txID)
@@ -155,7 +224,7 @@ func (c *Chain) GetTransactionReceipt(txID thor.Bytes32) (*tx.Receipt, error) {
 		return nil, err
 	}
 
-	key := makeTxKey(txMeta.BlockID, receiptInfix)
+	key := makeTxKey(txMeta.BlockID)
 	key.SetIndex(txMeta.Index)
 	receipt, err := c.repo.getReceipt(key)
 	if err != nil {
@@ -176,30 +245,80 @@ func (c *Chain) HasBlock(id thor.Bytes32) (bool, error) {
 	return id == foundID, nil
 }
 
+// HasTransaction check if the tx with given id belongs to the chain.
+// It's cheaper than GetTransactionMeta, since tx meta is not decoded.
+func (c *Chain) HasTransaction(txID thor.Bytes32) (bool, error) {
+	trie, err := c.lazyInit()
+	if err != nil {
+		return false, err
+	}
+	enc, err := trie.Get(txID[:])
+	if err != nil {
+		return false, err
+	}
+	return len(enc) > 0, nil
+}
+
+// sharedLength returns count of blocks shared by this chain and other.
+//
+// Since chains sharing a block also share all its ancestors, it's done by
+// binary search over block numbers, which costs O(log n) index lookups.
+func (c *Chain) sharedLength(other *Chain) (uint32, error) {
+	n := block.Number(c.headID)
+	if otherNum := block.Number(other.headID); otherNum < n {
+		n = otherNum
+	}
+
+	var err error
+	i := sort.Search(int(n)+1, func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var id, otherID thor.Bytes32
+		if id, err = c.GetBlockID(uint32(i)); err != nil {
+			return true
+		}
+		if otherID, err = other.GetBlockID(uint32(i)); err != nil {
+			return true
+		}
+		return id != otherID
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint32(i), nil
+}
+
+// FindCommonAncestor returns id of the highest block belongs to both this chain and other.
+func (c *Chain) FindCommonAncestor(other *Chain) (thor.Bytes32, error) {
+	n, err := c.sharedLength(other)
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	if n == 0 {
+		return thor.Bytes32{}, errNotFound
+	}
+	return c.GetBlockID(n - 1)
+}
+
 // Exclude returns ids of blocks belongs to this chain, but not belongs to other.
 //
 // The returned ids are in ascending order.
 func (c *Chain) Exclude(other *Chain) ([]thor.Bytes32, error) {
+	n, err := c.sharedLength(other)
+	if err != nil {
+		return nil, err
+	}
+
 	var ids []thor.Bytes32
 	// use int64 to prevent infinite loop
-	for i := int64(block.Number(c.headID)); i >= 0; i-- {
+	for i := int64(n); i <= int64(block.Number(c.headID)); i++ {
 		id, err := c.GetBlockID(uint32(i))
 		if err != nil {
 			return nil, err
 		}
-		has, err := other.HasBlock(id)
-		if err != nil {
-			return nil, err
-		}
-		if has {
-			break
-		}
 		ids = append(ids, id)
 	}
-	// reverse
-	for i, j := 0, len(ids)-1; i < j; i, j = i+1, j-1 {
-		ids[i], ids[j] = ids[j], ids[i]
-	}
 	return ids, nil
 }
 
@@ -248,6 +367,63 @@ func (c *Chain) FindBlockHeaderByTimestamp(ts uint64, flag int) (header *block.H
 	return c.GetBlockHeader(n)
 }
 
+// GetBlockByTimestamp returns the highest block whose timestamp <= ts.
+// Since the lookup is performed on the chain view, it's consistent across reorgs.
+//
+// The search range is narrowed down to about a span of blocks by the timestamp index.
+// It falls back to the whole chain if spans are not indexed, e.g. blocks indexed before the timestamp index.
+func (c *Chain) GetBlockByTimestamp(ts uint64) (*block.Block, error) {
+	lo, hi := uint32(0), block.Number(c.headID)
+
+	// the last block of the span of ts is the upper bound
+	if num, ok, err := c.getTimestampIndex(ts); err != nil {
+		return nil, err
+	} else if ok && num < hi {
+		hi = num
+	}
+	// and the last block of the previous span is the lower bound
+	if ts >= timestampIndexSpan {
+		if num, ok, err := c.getTimestampIndex(ts - timestampIndexSpan); err != nil {
+			return nil, err
+		} else if ok && num <= hi {
+			lo = num
+		}
+	}
+
+	var searchErr error
+	n := lo + uint32(sort.Search(int(hi-lo)+1, func(i int) bool {
+		h, err := c.GetBlockHeader(lo + uint32(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return h.Timestamp() > ts
+	}))
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if n == lo {
+		return nil, errNotFound
+	}
+	return c.GetBlock(n - 1)
+}
+
+// getTimestampIndex returns the number of the last block in the span of ts.
+func (c *Chain) getTimestampIndex(ts uint64) (uint32, bool, error) {
+	trie, err := c.lazyInit()
+	if err != nil {
+		return 0, false, err
+	}
+	data, err := trie.Get(timestampIndexKey(ts))
+	if err != nil {
+		return 0, false, err
+	}
+	if len(data) == 0 {
+		return 0, false, nil
+	}
+	return binary.BigEndian.Uint32(data), true, nil
+}
+
 // NewBestChain create a chain with best block as head.
 func (r *Repository) NewBestChain() *Chain {
 	return newChain(r, r.BestBlock().Header().ID())
@@ -272,6 +448,11 @@ func (r *Repository) indexBlock(parentIndexRoot thor.Bytes32, block *block.Block
 		return thor.Bytes32{}, err
 	}
 
+	// map timestamp span to the last block in it
+	if err := trie.Update(timestampIndexKey(block.Header().Timestamp()), id[:4]); err != nil {
+		return thor.Bytes32{}, err
+	}
+
 	// map tx id to tx meta
 	for i, tx := range block.Transactions() {
 		enc, err := rlp.EncodeToBytes(&TxMeta{
@@ -288,3 +469,14 @@ func (r *Repository) indexBlock(parentIndexRoot thor.Bytes32, block *block.Block
 	}
 	return trie.Commit()
 }
+
+// timestampIndexSpan is the span in seconds of timestamps indexed by one key.
+const timestampIndexSpan = 3600
+
+// timestampIndexKey returns the index trie key of the span containing ts.
+// It's 8 bytes long, to be told apart from keys of block numbers and tx ids.
+func timestampIndexKey(ts uint64) []byte {
+	var key [8]byte
+	binary.BigEndian.PutUint64(key[:], ts/timestampIndexSpan)
+	return key[:]
+}
@@ -49,12 +49,21 @@ func TestChain(t *testing.T) {
 	assert.Equal(t, M(b3.Header(), nil), M(c.GetBlockHeader(3)))
 	assert.Equal(t, M(block.Compose(b3.Header(), b3.Transactions()), nil), M(c.GetBlock(3)))
 
+	assert.Equal(t, M([]*block.Header{b1.Header(), b2.Header()}, nil), M(c.GetBlockHeaders(1, 2)))
+	assert.Equal(t, M([]*block.Header{b2.Header(), b3.Header()}, nil), M(c.GetBlockHeaders(2, 10)))
+	assert.Equal(t, M([]*block.Header(nil), nil), M(c.GetBlockHeaders(4, 1)))
+
 	_, err := c.GetBlockID(4)
 	assert.True(t, c.IsNotFound(err))
 
 	assert.Equal(t, M(tx1Meta, nil), M(c.GetTransactionMeta(tx1.ID())))
 	assert.Equal(t, M(tx1, tx1Meta, nil), M(c.GetTransaction(tx1.ID())))
 	assert.Equal(t, M(tx1Receipt, nil), M(c.GetTransactionReceipt(tx1.ID())))
+	assert.Equal(t, M(&chain.TxInfo{Tx: tx1, Meta: tx1Meta, BlockHeader: b1.Header(), OnBestChain: false}, nil),
+		M(c.GetTransactionInfo(tx1.ID())))
+
+	assert.Equal(t, M(true, nil), M(c.HasTransaction(tx1.ID())))
+	assert.Equal(t, M(false, nil), M(c.HasTransaction(thor.Bytes32{})))
 
 	assert.Equal(t, M(true, nil), M(c.HasBlock(b1.Header().ID())))
 	assert.Equal(t, M(false, nil), M(c.HasBlock(b3x.Header().ID())))
@@ -64,8 +73,59 @@ func TestChain(t *testing.T) {
 	_, err = c.FindBlockHeaderByTimestamp(25, 0)
 	assert.True(t, c.IsNotFound(err))
 
+	assert.Equal(t, M(block.Compose(b2.Header(), b2.Transactions()), nil), M(c.GetBlockByTimestamp(25)))
+	assert.Equal(t, M(block.Compose(b3.Header(), b3.Transactions()), nil), M(c.GetBlockByTimestamp(30)))
+	assert.Equal(t, M(block.Compose(b3.Header(), b3.Transactions()), nil), M(c.GetBlockByTimestamp(100)))
+	_, err = c.GetBlockByTimestamp(0)
+	assert.True(t, c.IsNotFound(err))
+
 	c1, c2 := repo.NewChain(b3.Header().ID()), repo.NewChain(b3x.Header().ID())
 
+	assert.Equal(t, M(b2.Header().ID(), nil), M(c1.FindCommonAncestor(c2)))
+	assert.Equal(t, M(b2.Header().ID(), nil), M(c1.FindCommonAncestor(repo.NewChain(b2.Header().ID()))))
 	assert.Equal(t, M([]thor.Bytes32{b3.Header().ID()}, nil), M(c1.Exclude(c2)))
+	assert.Equal(t, M([]thor.Bytes32(nil), nil), M(c1.Exclude(c1)))
 	assert.Equal(t, M([]thor.Bytes32{b3x.Header().ID()}, nil), M(c2.Exclude(c1)))
 }
+
+func TestGetBlockByTimestamp(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+	launchTime := b0.Header().Timestamp()
+
+	// blocks span hours, with a gap of several hours in the middle
+	blocks := []*block.Block{b0}
+	for i := 1; i <= 30; i++ {
+		offset := uint64(i) * 1000
+		if i > 15 {
+			offset += 5 * 3600
+		}
+		b := newBlock(blocks[len(blocks)-1], launchTime+offset)
+		assert.Nil(t, repo.AddBlock(b, nil))
+		blocks = append(blocks, b)
+	}
+	// a fork with later blocks
+	fork := newBlock(blocks[20], launchTime+100000)
+	assert.Nil(t, repo.AddBlock(fork, nil))
+
+	c := repo.NewChain(blocks[len(blocks)-1].Header().ID())
+	for ts := launchTime - 10; ts < launchTime+60000; ts += 300 {
+		var expected *block.Block
+		for _, b := range blocks {
+			if b.Header().Timestamp() <= ts {
+				expected = b
+			}
+		}
+		b, err := c.GetBlockByTimestamp(ts)
+		if expected == nil {
+			assert.True(t, c.IsNotFound(err))
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, expected.Header().ID(), b.Header().ID(), "ts %v", ts)
+	}
+
+	c = repo.NewChain(fork.Header().ID())
+	assert.Equal(t, M(block.Compose(fork.Header(), fork.Transactions()), nil), M(c.GetBlockByTimestamp(launchTime+100000)))
+	assert.Equal(t, M(block.Compose(blocks[20].Header(), blocks[20].Transactions()), nil), M(c.GetBlockByTimestamp(launchTime+99999)))
+}
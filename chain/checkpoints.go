@@ -0,0 +1,49 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+)
+
+// checkpoints trusted block ids indexed by block number.
+type checkpoints struct {
+	ids    map[uint32]thor.Bytes32
+	latest uint32
+}
+
+func newCheckpoints(ids map[uint32]thor.Bytes32) checkpoints {
+	cps := checkpoints{ids: make(map[uint32]thor.Bytes32, len(ids))}
+	for num, id := range ids {
+		cps.ids[num] = id
+		if num > cps.latest {
+			cps.latest = num
+		}
+	}
+	return cps
+}
+
+// Check returns error if the block contradicts the checkpoint at its number.
+//
+// Any branch that contradicts a checkpoint must contain a block at the checkpoint number,
+// so checking blocks one by one is enough to reject the whole branch.
+func (cps *checkpoints) Check(header *block.Header) error {
+	if id, ok := cps.ids[header.Number()]; ok && id != header.ID() {
+		return errors.Errorf("block %v contradicts checkpoint %v", header.ID(), id)
+	}
+	return nil
+}
+
+// LatestCheckpoint returns the highest checkpoint. ok is false if no checkpoint configured.
+// Blocks on the branch up to the latest checkpoint are trusted, so verification can be relaxed.
+func (r *Repository) LatestCheckpoint() (num uint32, id thor.Bytes32, ok bool) {
+	if len(r.checkpoints.ids) == 0 {
+		return 0, thor.Bytes32{}, false
+	}
+	return r.checkpoints.latest, r.checkpoints.ids[r.checkpoints.latest], true
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"io"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/tx"
+)
+
+const (
+	importBatchSize = 256
+)
+
+// exportedBlock is the item of exported stream.
+type exportedBlock struct {
+	Block    *block.Block
+	Receipts tx.Receipts
+}
+
+// Export writes blocks of the best chain in range [from, to], along with their receipts, into w.
+// The output is a stream of rlp encoded items, which can be read back by Import.
+func (r *Repository) Export(w io.Writer, from, to uint32) error {
+	if from > to {
+		return errors.New("invalid range")
+	}
+	bestChain := r.NewBestChain()
+	for num := from; ; num++ {
+		b, err := bestChain.GetBlock(num)
+		if err != nil {
+			return errors.Wrapf(err, "get block %v", num)
+		}
+		receipts, err := r.GetBlockReceipts(b.Header().ID())
+		if err != nil {
+			return errors.Wrapf(err, "get receipts of block %v", num)
+		}
+		if err := rlp.Encode(w, &exportedBlock{b, receipts}); err != nil {
+			return err
+		}
+		// prevent overflow when to == max uint32
+		if num == to {
+			return nil
+		}
+	}
+}
+
+// Import reads the stream produced by Export, and adds blocks into repository.
+// Blocks already in repository are skipped, and the parent of the first new block must exist.
+// It returns count of imported blocks.
+//
+// Blocks are not verified, so the stream should come from a trusted source.
+// The last imported block becomes the best block, if it extends the best chain or has higher total score.
+func (r *Repository) Import(rd io.Reader) (count int, err error) {
+	var (
+		stream   = rlp.NewStream(rd, 0)
+		blocks   []*block.Block
+		receipts []tx.Receipts
+	)
+
+	flush := func() error {
+		if len(blocks) == 0 {
+			return nil
+		}
+		var (
+			best   = r.BestBlock().Header()
+			last   = blocks[len(blocks)-1].Header()
+			asBest = last.TotalScore() > best.TotalScore()
+		)
+		if !asBest {
+			// extends the best chain
+			has, err := r.NewChain(blocks[0].Header().ParentID()).HasBlock(best.ID())
+			if err != nil {
+				return err
+			}
+			asBest = has
+		}
+		if err := r.AddBlocks(blocks, receipts, asBest); err != nil {
+			return err
+		}
+		count += len(blocks)
+		blocks, receipts = blocks[:0], receipts[:0]
+		return nil
+	}
+
+	for {
+		var item exportedBlock
+		if err := stream.Decode(&item); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return count, err
+		}
+
+		if len(blocks) == 0 {
+			if _, err := r.GetBlockSummary(item.Block.Header().ID()); err == nil {
+				// already exists
+				continue
+			} else if !r.IsNotFound(err) {
+				return count, err
+			}
+		}
+
+		blocks = append(blocks, item.Block)
+		receipts = append(receipts, item.Receipts)
+		if len(blocks) >= importBatchSize {
+			if err := flush(); err != nil {
+				return count, err
+			}
+		}
+	}
+	return count, flush()
+}
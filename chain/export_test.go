@@ -0,0 +1,43 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/tx"
+)
+
+func TestExportImport(t *testing.T) {
+	repo := newTestRepo()
+
+	tx1 := newTx()
+	b1 := newBlock(repo.GenesisBlock(), 10, tx1)
+	b2 := newBlock(b1, 20)
+	b3 := newBlock(b2, 30)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2, b3}, []tx.Receipts{{&tx.Receipt{Reverted: true}}, nil, nil}, true))
+
+	var buf bytes.Buffer
+	assert.Nil(t, repo.Export(&buf, 0, 3))
+
+	repo2 := newTestRepo()
+	assert.Equal(t, M(3, nil), M(repo2.Import(&buf)))
+	assert.Equal(t, b3.Header().ID(), repo2.BestBlock().Header().ID())
+
+	_, meta, err := repo2.NewBestChain().GetTransaction(tx1.ID())
+	assert.Nil(t, err)
+	assert.True(t, meta.Reverted)
+
+	buf.Reset()
+	assert.Nil(t, repo.Export(&buf, 2, 3))
+	// all blocks exist
+	assert.Equal(t, M(0, nil), M(repo2.Import(&buf)))
+
+	assert.Error(t, repo.Export(&buf, 3, 4))
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/pkg/errors"
+)
+
+// feedQueueSize is the max count of events queued for a subscriber.
+const feedQueueSize = 128
+
+var errSubscriberTooSlow = errors.New("subscriber too slow")
+
+// feed dispatches events to subscribers without blocking the sender.
+// Each subscriber has its own queue, drained into its channel by a goroutine.
+// A subscriber whose queue is full is unsubscribed, with errSubscriberTooSlow sent to its Err channel.
+type feed struct {
+	lock sync.Mutex
+	subs map[*feedSub]struct{}
+}
+
+type feedSub struct {
+	feed  *feed
+	queue chan interface{}
+	quit  chan struct{}
+	err   chan error
+	once  sync.Once
+}
+
+// subscribe adds a subscriber. deliver should send the event to the subscriber's channel, until quit closed.
+func (f *feed) subscribe(deliver func(ev interface{}, quit <-chan struct{})) event.Subscription {
+	sub := &feedSub{
+		feed:  f,
+		queue: make(chan interface{}, feedQueueSize),
+		quit:  make(chan struct{}),
+		err:   make(chan error, 1),
+	}
+
+	f.lock.Lock()
+	if f.subs == nil {
+		f.subs = make(map[*feedSub]struct{})
+	}
+	f.subs[sub] = struct{}{}
+	f.lock.Unlock()
+
+	go func() {
+		for {
+			select {
+			case ev := <-sub.queue:
+				deliver(ev, sub.quit)
+			case <-sub.quit:
+				return
+			}
+		}
+	}()
+	return sub
+}
+
+// hasSubscribers returns whether there's any subscriber, so that building events can be skipped.
+func (f *feed) hasSubscribers() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return len(f.subs) > 0
+}
+
+// send queues the event to all subscribers. It never blocks.
+func (f *feed) send(ev interface{}) {
+	var slow []*feedSub
+
+	f.lock.Lock()
+	for sub := range f.subs {
+		select {
+		case sub.queue <- ev:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	f.lock.Unlock()
+
+	for _, sub := range slow {
+		sub.unsubscribe(errSubscriberTooSlow)
+	}
+}
+
+func (s *feedSub) unsubscribe(err error) {
+	s.once.Do(func() {
+		s.feed.lock.Lock()
+		delete(s.feed.subs, s)
+		s.feed.lock.Unlock()
+
+		close(s.quit)
+		if err != nil {
+			s.err <- err
+		}
+		close(s.err)
+	})
+}
+
+// Unsubscribe implements event.Subscription.
+func (s *feedSub) Unsubscribe() {
+	s.unsubscribe(nil)
+}
+
+// Err implements event.Subscription.
+func (s *feedSub) Err() <-chan error {
+	return s.err
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+const (
+	freezerDataFileName  = "blocks.dat"
+	freezerIndexFileName = "blocks.idx"
+	freezerBatchSize     = 1024
+)
+
+// frozenBlock is the item stored in freezer.
+type frozenBlock struct {
+	Summary  *BlockSummary
+	Txs      tx.Transactions
+	Receipts tx.Receipts
+}
+
+// freezer stores old blocks of the canonical chain in append-only flat files.
+// Items are indexed by block number, and numbers are contiguous from 1, since the genesis block
+// is always kept in kv store.
+//
+// The data file is the concatenation of rlp encoded items, and the index file
+// is the sequence of 8 bytes end offsets of items in data file.
+type freezer struct {
+	lock  sync.RWMutex
+	data  *os.File
+	index *os.File
+	count uint32
+	size  uint64 // size of data file
+}
+
+func openFreezer(dir string) (*freezer, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	data, err := os.OpenFile(filepath.Join(dir, freezerDataFileName), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	index, err := os.OpenFile(filepath.Join(dir, freezerIndexFileName), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	f := &freezer{data: data, index: index}
+	if err := f.repair(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// repair truncates partially written items, which may be caused by crash.
+func (f *freezer) repair() error {
+	stat, err := f.index.Stat()
+	if err != nil {
+		return err
+	}
+	count := uint32(stat.Size() / 8)
+	if err := f.index.Truncate(int64(count) * 8); err != nil {
+		return err
+	}
+
+	var size uint64
+	if count > 0 {
+		if size, err = f.endOffset(count - 1); err != nil {
+			return err
+		}
+	}
+	if err := f.data.Truncate(int64(size)); err != nil {
+		return err
+	}
+	f.count = count
+	f.size = size
+	return nil
+}
+
+func (f *freezer) endOffset(num uint32) (uint64, error) {
+	var buf [8]byte
+	if _, err := f.index.ReadAt(buf[:], int64(num)*8); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+// Count returns count of frozen blocks, which is also the number of the last frozen block.
+func (f *freezer) Count() uint32 {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.count
+}
+
+// Append appends the next block into freezer.
+func (f *freezer) Append(item *frozenBlock) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if num := item.Summary.Header.Number(); num != f.count+1 {
+		return errors.Errorf("freezer: expected block %v, got %v", f.count+1, num)
+	}
+
+	data, err := rlp.EncodeToBytes(item)
+	if err != nil {
+		return err
+	}
+	if _, err := f.data.WriteAt(data, int64(f.size)); err != nil {
+		return err
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], f.size+uint64(len(data)))
+	if _, err := f.index.WriteAt(buf[:], int64(f.count)*8); err != nil {
+		return err
+	}
+	f.size += uint64(len(data))
+	f.count++
+	return nil
+}
+
+// Sync flushes written data to disk.
+func (f *freezer) Sync() error {
+	if err := f.data.Sync(); err != nil {
+		return err
+	}
+	return f.index.Sync()
+}
+
+// Get returns the frozen block with given id. errNotFound returned if not frozen.
+func (f *freezer) Get(id thor.Bytes32) (*frozenBlock, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	num := block.Number(id)
+	if num == 0 || num > f.count {
+		return nil, errNotFound
+	}
+
+	// item of block n is at position n-1
+	var start uint64
+	if num > 1 {
+		var err error
+		if start, err = f.endOffset(num - 2); err != nil {
+			return nil, err
+		}
+	}
+	end, err := f.endOffset(num - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, end-start)
+	if _, err := f.data.ReadAt(data, int64(start)); err != nil {
+		return nil, err
+	}
+	var item frozenBlock
+	if err := rlp.DecodeBytes(data, &item); err != nil {
+		return nil, err
+	}
+	// it might be a block of side chain
+	if item.Summary.Header.ID() != id {
+		return nil, errNotFound
+	}
+	return &item, nil
+}
+
+// Close closes underlying files.
+func (f *freezer) Close() error {
+	err := f.data.Close()
+	if err1 := f.index.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+// Freeze moves blocks of the best chain with number less than beforeNum, from kv store into freezer.
+// It returns count of frozen blocks. Neither the genesis block nor the best block is frozen.
+//
+// Since frozen blocks can't be reorganized, the finalized block is advanced to the last frozen block if lower.
+func (r *Repository) Freeze(ctx context.Context, beforeNum uint32) (count int, err error) {
+	if r.readOnly {
+		return 0, errReadOnly
+	}
+	if r.freezer == nil {
+		return 0, errors.New("freezer not enabled")
+	}
+	bestChain := r.NewBestChain()
+	if bestNum := block.Number(bestChain.HeadID()); beforeNum > bestNum {
+		beforeNum = bestNum
+	}
+
+	err = r.data.Batch(func(putter kv.PutFlusher) error {
+		var summaries []*BlockSummary
+
+		// sync freezer before deleting blocks from kv store
+		flush := func() error {
+			if len(summaries) == 0 {
+				return nil
+			}
+			if err := r.freezer.Sync(); err != nil {
+				return err
+			}
+			for _, summary := range summaries {
+				if err := r.deleteBlock(putter, summary); err != nil {
+					return err
+				}
+			}
+			if err := putter.Flush(); err != nil {
+				return err
+			}
+			last := summaries[len(summaries)-1].Header
+			if last.Number() > block.Number(r.FinalizedBlockID()) {
+				if err := r.SetFinalizedBlockID(last.ID()); err != nil {
+					return err
+				}
+			}
+			count += len(summaries)
+			summaries = summaries[:0]
+			return nil
+		}
+
+		for num := r.freezer.Count() + 1; num < beforeNum; num++ {
+			id, err := bestChain.GetBlockID(num)
+			if err != nil {
+				return err
+			}
+			summary, err := r.GetBlockSummary(id)
+			if err != nil {
+				return err
+			}
+			txs, err := r.GetBlockTransactions(id)
+			if err != nil {
+				return err
+			}
+			receipts, err := r.GetBlockReceipts(id)
+			if err != nil {
+				return err
+			}
+			if err := r.freezer.Append(&frozenBlock{summary, txs, receipts}); err != nil {
+				return err
+			}
+			summaries = append(summaries, summary)
+
+			if len(summaries) >= freezerBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+			}
+		}
+		return flush()
+	})
+	return
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	. "github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/tx"
+)
+
+func TestFreezer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "freezer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+	repo, err := NewRepositoryWithOptions(db, b0, Options{FreezerDir: dir})
+	assert.Nil(t, err)
+
+	tx1 := newTx()
+	b1 := newBlock(b0, 10, tx1)
+	b2 := newBlock(b1, 20)
+	b3 := newBlock(b2, 30)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2, b3}, []tx.Receipts{{&tx.Receipt{Reverted: true}}, nil, nil}, true))
+
+	// genesis block never frozen
+	assert.Equal(t, M(1, nil), M(repo.Freeze(context.Background(), 2)))
+	assert.Equal(t, b1.Header().ID(), repo.FinalizedBlockID())
+	assert.Nil(t, repo.Close())
+
+	repo, err = NewRepositoryWithOptions(db, b0, Options{FreezerDir: dir})
+	assert.Nil(t, err)
+	defer repo.Close()
+
+	assert.Equal(t, M(true, nil), M(repo.HasBlock(b1.Header().ID())))
+	_, err = repo.GetBlock(b0.Header().ID())
+	assert.Nil(t, err)
+	s1, err := repo.GetBlockSummary(b1.Header().ID())
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), s1.Header.ID())
+
+	c := repo.NewBestChain()
+	gotTx, meta, err := c.GetTransaction(tx1.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, tx1.ID(), gotTx.ID())
+	assert.Equal(t, &TxMeta{BlockID: b1.Header().ID(), Index: 0, Reverted: true}, meta)
+	receipt, err := c.GetTransactionReceipt(tx1.ID())
+	assert.Nil(t, err)
+	assert.True(t, receipt.Reverted)
+
+	// best block never frozen
+	assert.Equal(t, M(1, nil), M(repo.Freeze(context.Background(), 10)))
+	_, err = repo.GetBlock(b3.Header().ID())
+	assert.Nil(t, err)
+}
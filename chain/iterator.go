@@ -0,0 +1,101 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/tx"
+)
+
+const (
+	iteratorPrefetchSize = 64
+)
+
+// Iterator walks blocks of the chain in ascending order.
+// Blocks are prefetched in batch to reduce overhead.
+type Iterator struct {
+	chain   *Chain
+	nextNum uint32
+	end     bool
+	buf     []*block.Block
+	current *block.Block
+	err     error
+}
+
+// NewIterator create an iterator which starts from the block with number fromNum.
+func (c *Chain) NewIterator(fromNum uint32) *Iterator {
+	return &Iterator{
+		chain:   c,
+		nextNum: fromNum,
+	}
+}
+
+// Next moves the iterator to the next block. It returns false when no more block or error occurred.
+func (it *Iterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if len(it.buf) == 0 {
+		if it.end {
+			it.current = nil
+			return false
+		}
+		if it.err = it.prefetch(); it.err != nil || len(it.buf) == 0 {
+			it.current = nil
+			return false
+		}
+	}
+	it.current, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Block returns the block on which the iterator is positioned.
+func (it *Iterator) Block() *block.Block {
+	return it.current
+}
+
+// Error returns the error occurred during iteration.
+func (it *Iterator) Error() error {
+	return it.err
+}
+
+func (it *Iterator) prefetch() error {
+	headers, err := it.chain.GetBlockHeaders(it.nextNum, iteratorPrefetchSize)
+	if err != nil {
+		return err
+	}
+	if len(headers) < iteratorPrefetchSize {
+		it.end = true
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+
+	var (
+		txs  = make([]tx.Transactions, len(headers))
+		errs = make([]error, len(headers))
+	)
+	<-co.Parallel(func(queue chan<- func()) {
+		for i, header := range headers {
+			i, header := i, header
+			queue <- func() {
+				txs[i], errs[i] = it.chain.repo.GetBlockTransactions(header.ID())
+			}
+		}
+	})
+
+	blocks := make([]*block.Block, 0, len(headers))
+	for i, header := range headers {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		blocks = append(blocks, block.Compose(header, txs[i]))
+	}
+	it.buf = blocks
+	it.nextNum += uint32(len(headers))
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestIterator(t *testing.T) {
+	repo := newTestRepo()
+
+	var (
+		blocks   []*block.Block
+		receipts []tx.Receipts
+		parent   = repo.GenesisBlock()
+	)
+	for i := 0; i < 100; i++ {
+		b := newBlock(parent, uint64(i+1)*10, newTx())
+		blocks = append(blocks, b)
+		receipts = append(receipts, tx.Receipts{&tx.Receipt{}})
+		parent = b
+	}
+	assert.Nil(t, repo.AddBlocks(blocks, receipts, true))
+
+	var ids []thor.Bytes32
+	it := repo.NewBestChain().NewIterator(1)
+	for it.Next() {
+		ids = append(ids, it.Block().Header().ID())
+		assert.Equal(t, 1, len(it.Block().Transactions()))
+	}
+	assert.Nil(t, it.Error())
+	assert.Equal(t, blockIDs(blocks), ids)
+	assert.False(t, it.Next())
+
+	it = repo.NewBestChain().NewIterator(101)
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Error())
+}
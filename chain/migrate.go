@@ -0,0 +1,84 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/kv"
+)
+
+// legacyDataStoreName is the store of chain data in legacy layout, where all families share the key space.
+// Keys are ( block id ) for block summaries, and ( block id | infix | index ) for txs and receipts.
+const legacyDataStoreName = "chain.data"
+
+const (
+	legacyTxInfix      = byte(0)
+	legacyReceiptInfix = byte(1)
+)
+
+// max count of pairs moved by each migration round.
+const migrationBatchSize = 1024
+
+// migrateLegacyData moves data in legacy layout into buckets of the data store.
+// Moved pairs are deleted from the legacy store only after written, so an interrupted
+// migration is resumed on next open.
+func (r *Repository) migrateLegacyData() error {
+	legacy := r.db.NewStore(legacyDataStoreName)
+	for {
+		var pairs [][2][]byte
+		it := kv.NewIterator(legacy, nil, nil)
+		for len(pairs) < migrationBatchSize && it.Next() {
+			pairs = append(pairs, [2][]byte{it.Key(), it.Value()})
+		}
+		if err := it.Error(); err != nil {
+			return err
+		}
+		if len(pairs) == 0 {
+			return nil
+		}
+		if r.readOnly {
+			return errors.New("legacy data requires migration")
+		}
+
+		if err := r.data.Batch(func(putter kv.PutFlusher) error {
+			for _, pair := range pairs {
+				if err := putMigrated(putter, pair[0], pair[1]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := legacy.Batch(func(putter kv.PutFlusher) error {
+			for _, pair := range pairs {
+				if err := putter.Delete(pair[0]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// putMigrated puts the legacy pair into its bucket. Values are kept as is, since encodings are compatible.
+func putMigrated(w kv.Putter, key, val []byte) error {
+	switch {
+	case len(key) == 32:
+		return blockSummaryBucket.ProxyPutter(w).Put(key, val)
+	case len(key) == 32+1+8:
+		newKey := append(append(make([]byte, 0, 32+8), key[:32]...), key[33:]...)
+		switch key[32] {
+		case legacyTxInfix:
+			return txBucket.ProxyPutter(w).Put(newKey, val)
+		case legacyReceiptInfix:
+			return receiptBucket.ProxyPutter(w).Put(newKey, val)
+		}
+	}
+	return errors.Errorf("unexpected legacy key %x", key)
+}
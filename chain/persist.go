@@ -9,37 +9,51 @@ import (
 	"encoding/binary"
 
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/kv"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/thor/bloom"
 	"github.com/vechain/thor/tx"
 )
 
-const (
-	txInfix      = byte(0)
-	receiptInfix = byte(1)
+// buckets of data store, each holds a family of data.
+var (
+	blockSummaryBucket = kv.Bucket("s")
+	txBucket           = kv.Bucket("t")
+	receiptBucket      = kv.Bucket("r")
+	bloomBucket        = kv.Bucket("b")
 )
 
 // BlockSummary presents block summary.
+// It's cheap to load, since neither txs nor receipts are decoded.
+// Receipts are always stored along with txs, so they are present as long as the summary is.
 type BlockSummary struct {
 	Header    *block.Header
 	IndexRoot thor.Bytes32
-	Txs       []thor.Bytes32
-	Size      uint64
+	Txs       []thor.Bytes32 // ids of txs
+	Size      uint64         // rlp encoded size of the block
 }
 
 // the key for tx/receipt.
-// it consists of: ( block id | infix | index )
-type txKey [32 + 1 + 8]byte
+// it consists of: ( block id | index )
+type txKey [32 + 8]byte
 
-func makeTxKey(blockID thor.Bytes32, infix byte) (k txKey) {
+func makeTxKey(blockID thor.Bytes32) (k txKey) {
 	copy(k[:], blockID[:])
-	k[32] = infix
 	return
 }
 
 func (k *txKey) SetIndex(i uint64) {
-	binary.BigEndian.PutUint64(k[33:], i)
+	binary.BigEndian.PutUint64(k[32:], i)
+}
+
+func (k *txKey) BlockID() thor.Bytes32 {
+	return thor.BytesToBytes32(k[:32])
+}
+
+func (k *txKey) Index() uint64 {
+	return binary.BigEndian.Uint64(k[32:])
 }
 
 func saveRLP(w kv.Putter, key []byte, val interface{}) error {
@@ -58,38 +72,109 @@ func loadRLP(r kv.Getter, key []byte, val interface{}) error {
 	return rlp.DecodeBytes(data, val)
 }
 
+// saveCompressedRLP saves rlp encoded val, compressed by codec if not nil.
+// The codec format byte is prefixed to compressed data. Plain rlp encoded txs and receipts
+// always start with a byte above block.MaxFormat, so can be told apart.
+func saveCompressedRLP(w kv.Putter, key []byte, val interface{}, codec block.Codec) error {
+	data, err := rlp.EncodeToBytes(val)
+	if err != nil {
+		return err
+	}
+	if codec != nil {
+		enc, err := codec.Encode(data)
+		if err != nil {
+			return err
+		}
+		data = append([]byte{codec.Format()}, enc...)
+	}
+	return w.Put(key, data)
+}
+
+// loadCompressedRLP loads val saved by saveCompressedRLP, either compressed or not.
+// Data compressed by snappy can always be decoded, and others require the codec.
+func loadCompressedRLP(r kv.Getter, key []byte, val interface{}, codec block.Codec) error {
+	data, err := r.Get(key)
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 && data[0] <= block.MaxFormat {
+		switch {
+		case data[0] == block.SnappyFormat:
+			codec = block.SnappyCodec
+		case codec == nil || data[0] != codec.Format():
+			return errors.New("unknown data format")
+		}
+		if data, err = codec.Decode(data[1:]); err != nil {
+			return err
+		}
+	}
+	return rlp.DecodeBytes(data, val)
+}
+
 func saveBlockSummary(w kv.Putter, summary *BlockSummary) error {
-	return saveRLP(w, summary.Header.ID().Bytes(), summary)
+	return saveRLP(blockSummaryBucket.ProxyPutter(w), summary.Header.ID().Bytes(), summary)
 }
 
 func loadBlockSummary(r kv.Getter, id thor.Bytes32) (*BlockSummary, error) {
 	var summary BlockSummary
-	if err := loadRLP(r, id[:], &summary); err != nil {
+	if err := loadRLP(blockSummaryBucket.ProxyGetter(r), id[:], &summary); err != nil {
 		return nil, err
 	}
 	return &summary, nil
 }
 
-func saveTransaction(w kv.Putter, key txKey, tx *tx.Transaction) error {
-	return saveRLP(w, key[:], tx)
+func hasBlockSummary(r kv.Getter, id thor.Bytes32) (bool, error) {
+	return blockSummaryBucket.ProxyGetter(r).Has(id[:])
 }
 
-func loadTransaction(r kv.Getter, key txKey) (*tx.Transaction, error) {
+func deleteBlockSummary(w kv.Putter, id thor.Bytes32) error {
+	return blockSummaryBucket.ProxyPutter(w).Delete(id[:])
+}
+
+func saveTransaction(w kv.Putter, key txKey, tx *tx.Transaction, codec block.Codec) error {
+	return saveCompressedRLP(txBucket.ProxyPutter(w), key[:], tx, codec)
+}
+
+func loadTransaction(r kv.Getter, key txKey, codec block.Codec) (*tx.Transaction, error) {
 	var tx tx.Transaction
-	if err := loadRLP(r, key[:], &tx); err != nil {
+	if err := loadCompressedRLP(txBucket.ProxyGetter(r), key[:], &tx, codec); err != nil {
 		return nil, err
 	}
 	return &tx, nil
 }
 
-func saveReceipt(w kv.Putter, key txKey, receipt *tx.Receipt) error {
-	return saveRLP(w, key[:], receipt)
+func deleteTransaction(w kv.Putter, key txKey) error {
+	return txBucket.ProxyPutter(w).Delete(key[:])
+}
+
+func saveReceipt(w kv.Putter, key txKey, receipt *tx.Receipt, codec block.Codec) error {
+	return saveCompressedRLP(receiptBucket.ProxyPutter(w), key[:], receipt, codec)
 }
 
-func loadReceipt(r kv.Getter, key txKey) (*tx.Receipt, error) {
+func loadReceipt(r kv.Getter, key txKey, codec block.Codec) (*tx.Receipt, error) {
 	var receipt tx.Receipt
-	if err := loadRLP(r, key[:], &receipt); err != nil {
+	if err := loadCompressedRLP(receiptBucket.ProxyGetter(r), key[:], &receipt, codec); err != nil {
 		return nil, err
 	}
 	return &receipt, nil
 }
+
+func deleteReceipt(w kv.Putter, key txKey) error {
+	return receiptBucket.ProxyPutter(w).Delete(key[:])
+}
+
+func saveBlockBloom(w kv.Putter, id thor.Bytes32, filter *bloom.Filter) error {
+	return saveRLP(bloomBucket.ProxyPutter(w), id[:], filter)
+}
+
+func loadBlockBloom(r kv.Getter, id thor.Bytes32) (*bloom.Filter, error) {
+	var filter bloom.Filter
+	if err := loadRLP(bloomBucket.ProxyGetter(r), id[:], &filter); err != nil {
+		return nil, err
+	}
+	return &filter, nil
+}
+
+func deleteBlockBloom(w kv.Putter, id thor.Bytes32) error {
+	return bloomBucket.ProxyPutter(w).Delete(id[:])
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"context"
+
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	reindexBatchSize = 1024
+)
+
+// ReindexTransactions rebuilds the index trie (block number to block id, and tx id to tx meta)
+// for blocks on the best chain, and updates index roots of their summaries.
+//
+// The existing index is not trusted, so block ids are collected by tracing back parent ids from the best block.
+func (r *Repository) ReindexTransactions(ctx context.Context) error {
+	if r.readOnly {
+		return errReadOnly
+	}
+	best := r.BestBlock().Header()
+	ids := make([]thor.Bytes32, best.Number()+1)
+	ids[best.Number()] = best.ID()
+	for i := int(best.Number()); i > 0; i-- {
+		summary, err := r.GetBlockSummary(ids[i])
+		if err != nil {
+			return err
+		}
+		ids[i-1] = summary.Header.ParentID()
+
+		if i%reindexBatchSize == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+		}
+	}
+
+	return r.data.Batch(func(putter kv.PutFlusher) error {
+		var indexRoot thor.Bytes32
+		for i, id := range ids {
+			b, err := r.GetBlock(id)
+			if err != nil {
+				return err
+			}
+			receipts, err := r.GetBlockReceipts(id)
+			if err != nil {
+				return err
+			}
+			if indexRoot, err = r.indexBlock(indexRoot, b, receipts); err != nil {
+				return err
+			}
+
+			summary, err := r.GetBlockSummary(id)
+			if err != nil {
+				return err
+			}
+			newSummary := *summary
+			newSummary.IndexRoot = indexRoot
+			if err := saveBlockSummary(putter, &newSummary); err != nil {
+				return err
+			}
+			r.caches.summaries.Add(id, &newSummary)
+
+			if (i+1)%reindexBatchSize == 0 {
+				if err := putter.Flush(); err != nil {
+					return err
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+)
+
+// ReorgEvent will be posted when the best block is switched to another branch.
+type ReorgEvent struct {
+	// Ancestor the common ancestor of the old and new branch.
+	Ancestor *block.Header
+	// Detached blocks removed from the canonical chain, in ascending order.
+	Detached []*block.Block
+	// Attached blocks added to the canonical chain, in ascending order.
+	Attached []*block.Block
+}
+
+// newReorgEvent builds the reorg event for switching best block from oldBest to newBest.
+// nil is returned if newBest is a descendant of oldBest.
+func (r *Repository) newReorgEvent(oldBest, newBest *block.Block) (*ReorgEvent, error) {
+	if newBest.Header().ParentID() == oldBest.Header().ID() ||
+		newBest.Header().ID() == oldBest.Header().ID() {
+		return nil, nil
+	}
+
+	var (
+		oldChain = r.NewChain(oldBest.Header().ID())
+		newChain = r.NewChain(newBest.Header().ID())
+	)
+
+	detachedIDs, err := oldChain.Exclude(newChain)
+	if err != nil {
+		return nil, err
+	}
+	if len(detachedIDs) == 0 {
+		return nil, nil
+	}
+	attachedIDs, err := newChain.Exclude(oldChain)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestorID, err := oldChain.FindCommonAncestor(newChain)
+	if err != nil {
+		return nil, err
+	}
+	ancestor, err := r.GetBlockSummary(ancestorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ev := &ReorgEvent{Ancestor: ancestor.Header}
+	if ev.Detached, err = r.getBlocks(detachedIDs); err != nil {
+		return nil, err
+	}
+	if ev.Attached, err = r.getBlocks(attachedIDs); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+func (r *Repository) getBlocks(ids []thor.Bytes32) ([]*block.Block, error) {
+	blocks := make([]*block.Block, 0, len(ids))
+	for _, id := range ids {
+		b, err := r.GetBlock(id)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, nil
+}
@@ -8,37 +8,53 @@ package chain
 import (
 	"sync/atomic"
 
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/kv"
 	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/thor/bloom"
 	"github.com/vechain/thor/tx"
 )
 
 const (
-	dataStoreName = "chain.data"
+	dataStoreName = "chain.blockdata"
 	propStoreName = "chain.props"
 )
 
 var (
-	errNotFound    = errors.New("not found")
-	bestBlockIDKey = []byte("best-block-id")
+	errNotFound         = errors.New("not found")
+	errReadOnly         = errors.New("read-only repository")
+	bestBlockIDKey      = []byte("best-block-id")
+	finalizedBlockIDKey = []byte("finalized-block-id")
 )
 
 // Repository stores block headers, txs and receipts.
 //
-// It's thread-safe.
+// It's thread-safe. Reads never contend with writes, since the best block is held
+// atomically and each Chain is an immutable view of the index trie at its head.
 type Repository struct {
 	db    *muxdb.MuxDB
 	data  kv.Store
 	props kv.Store
 
-	genesis *block.Block
-	best    atomic.Value
-	tag     byte
-	tick    co.Signal
+	genesis   *block.Block
+	best      atomic.Value
+	finalized atomic.Value
+	tag       byte
+	tick      co.Signal
+
+	reorgFeed   feed
+	headFeed    feed
+	freezer     *freezer
+	checkpoints checkpoints
+	compress    bool
+	codec       block.Codec
+	readOnly    bool
+	snap        *state.Snapshot
 
 	caches struct {
 		summaries *cache
@@ -47,8 +63,48 @@ type Repository struct {
 	}
 }
 
-// NewRepository create an instance of repository.
+// Options optional parameters for Repository.
+type Options struct {
+	// SummaryCacheSize is max count of cached block summaries.
+	SummaryCacheSize int
+	// TxCacheSize is max count of cached transactions.
+	TxCacheSize int
+	// ReceiptCacheSize is max count of cached receipts.
+	ReceiptCacheSize int
+	// FreezerDir is the directory to store frozen blocks. Freezer is disabled if empty.
+	FreezerDir string
+	// Checkpoints trusted block ids indexed by block number.
+	// Blocks contradict any checkpoint are rejected.
+	Checkpoints map[uint32]thor.Bytes32
+	// CompressBlocks enables compression of txs and receipts written.
+	// Data written either compressed or not can always be read.
+	CompressBlocks bool
+	// BlockCodec is the codec to compress txs and receipts, which defaults to snappy.
+	// Data compressed by a codec other than snappy can only be read with the same codec.
+	BlockCodec block.Codec
+	// ReadOnly if set to true, repository never writes, and all methods to write fail.
+	// It's to safely query data of an existing database.
+	ReadOnly bool
+	// StateSnapshot if set, is moved to the state of the best block whenever the best block changes.
+	StateSnapshot *state.Snapshot
+}
+
+// DefaultOptions default options for Repository.
+var DefaultOptions = Options{
+	SummaryCacheSize: 512,
+	TxCacheSize:      2048,
+	ReceiptCacheSize: 2048,
+	CompressBlocks:   true,
+}
+
+// NewRepository create an instance of repository with default options.
 func NewRepository(db *muxdb.MuxDB, genesis *block.Block) (*Repository, error) {
+	return NewRepositoryWithOptions(db, genesis, DefaultOptions)
+}
+
+// NewRepositoryWithOptions create an instance of repository.
+// Zero cache sizes in options fall back to default values.
+func NewRepositoryWithOptions(db *muxdb.MuxDB, genesis *block.Block, options Options) (*Repository, error) {
 	if genesis.Header().Number() != 0 {
 		return nil, errors.New("genesis number != 0")
 	}
@@ -58,21 +114,55 @@ func NewRepository(db *muxdb.MuxDB, genesis *block.Block) (*Repository, error) {
 
 	genesisID := genesis.Header().ID()
 	repo := &Repository{
-		db:      db,
-		data:    db.NewStore(dataStoreName),
-		props:   db.NewStore(propStoreName),
-		genesis: genesis,
-		tag:     genesisID[31],
+		db:       db,
+		data:     db.NewStore(dataStoreName),
+		props:    db.NewStore(propStoreName),
+		genesis:  genesis,
+		tag:      genesisID[31],
+		compress: options.CompressBlocks,
+		codec:    options.BlockCodec,
+		readOnly: options.ReadOnly,
+		snap:     options.StateSnapshot,
+	}
+
+	if repo.codec == nil {
+		repo.codec = block.SnappyCodec
+	}
+
+	orDefault := func(size, def int) int {
+		if size > 0 {
+			return size
+		}
+		return def
+	}
+	repo.caches.summaries = newCache(orDefault(options.SummaryCacheSize, DefaultOptions.SummaryCacheSize))
+	repo.caches.txs = newCache(orDefault(options.TxCacheSize, DefaultOptions.TxCacheSize))
+	repo.caches.receipts = newCache(orDefault(options.ReceiptCacheSize, DefaultOptions.ReceiptCacheSize))
+
+	repo.checkpoints = newCheckpoints(options.Checkpoints)
+	if err := repo.checkpoints.Check(genesis.Header()); err != nil {
+		return nil, err
+	}
+
+	if options.FreezerDir != "" {
+		f, err := openFreezer(options.FreezerDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "open freezer")
+		}
+		repo.freezer = f
 	}
 
-	repo.caches.summaries = newCache(512)
-	repo.caches.txs = newCache(2048)
-	repo.caches.receipts = newCache(2048)
+	if err := repo.migrateLegacyData(); err != nil {
+		return nil, errors.Wrap(err, "migrate legacy data")
+	}
 
 	if val, err := repo.props.Get(bestBlockIDKey); err != nil {
 		if !repo.props.IsNotFound(err) {
 			return nil, err
 		}
+		if repo.readOnly {
+			return nil, errors.New("read-only repository not initialized")
+		}
 
 		indexRoot, err := repo.indexBlock(thor.Bytes32{}, genesis, nil)
 		if err != nil {
@@ -101,9 +191,26 @@ func NewRepository(db *muxdb.MuxDB, genesis *block.Block) (*Repository, error) {
 		repo.best.Store(b)
 	}
 
+	if val, err := repo.props.Get(finalizedBlockIDKey); err != nil {
+		if !repo.props.IsNotFound(err) {
+			return nil, err
+		}
+		repo.finalized.Store(genesisID)
+	} else {
+		repo.finalized.Store(thor.BytesToBytes32(val))
+	}
+
 	return repo, nil
 }
 
+// Close releases resources held by repository. The underlying db is not closed.
+func (r *Repository) Close() error {
+	if r.freezer != nil {
+		return r.freezer.Close()
+	}
+	return nil
+}
+
 // ChainTag returns chain tag, which is the last byte of genesis id.
 func (r *Repository) ChainTag() byte {
 	return r.tag
@@ -120,17 +227,77 @@ func (r *Repository) BestBlock() *block.Block {
 }
 
 // SetBestBlockID set the given block id as best block id.
-func (r *Repository) SetBestBlockID(id thor.Bytes32) (err error) {
-	defer func() {
-		if err == nil {
-			r.tick.Broadcast()
-		}
-	}()
+func (r *Repository) SetBestBlockID(id thor.Bytes32) error {
+	if r.readOnly {
+		return errReadOnly
+	}
 	b, err := r.GetBlock(id)
 	if err != nil {
 		return err
 	}
-	return r.setBestBlock(b)
+	return r.switchBestBlock(b)
+}
+
+// FinalizedBlockID returns id of the finalized block, which will never be reorganized.
+// It's genesis id if never set.
+func (r *Repository) FinalizedBlockID() thor.Bytes32 {
+	return r.finalized.Load().(thor.Bytes32)
+}
+
+// SetFinalizedBlockID marks the given block as finalized.
+// The block must be on the best chain, and not lower than the current finalized block.
+func (r *Repository) SetFinalizedBlockID(id thor.Bytes32) error {
+	if r.readOnly {
+		return errReadOnly
+	}
+	if block.Number(id) < block.Number(r.FinalizedBlockID()) {
+		return errors.New("finalized block can not be rolled back")
+	}
+	has, err := r.NewBestChain().HasBlock(id)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errors.New("block not on best chain")
+	}
+	if err := r.props.Put(finalizedBlockIDKey, id.Bytes()); err != nil {
+		return err
+	}
+	r.finalized.Store(id)
+	return nil
+}
+
+// switchBestBlock sets the new best block, and notifies subscribers.
+func (r *Repository) switchBestBlock(b *block.Block) error {
+	has, err := r.NewChain(b.Header().ID()).HasBlock(r.FinalizedBlockID())
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errors.New("reorg below finalized block")
+	}
+
+	var ev *ReorgEvent
+	// build the event only when subscribed
+	if r.reorgFeed.hasSubscribers() {
+		if ev, err = r.newReorgEvent(r.BestBlock(), b); err != nil {
+			return err
+		}
+	}
+	if err := r.setBestBlock(b); err != nil {
+		return err
+	}
+	if r.snap != nil {
+		if err := r.snap.MoveTo(b.Header().StateRoot()); err != nil {
+			return errors.Wrap(err, "move state snapshot")
+		}
+	}
+	r.tick.Broadcast()
+	if ev != nil {
+		r.reorgFeed.send(ev)
+	}
+	r.headFeed.send(b.Header())
+	return nil
 }
 
 func (r *Repository) setBestBlock(b *block.Block) error {
@@ -143,42 +310,67 @@ func (r *Repository) setBestBlock(b *block.Block) error {
 
 func (r *Repository) saveBlock(block *block.Block, receipts tx.Receipts, indexRoot thor.Bytes32) error {
 	return r.data.Batch(func(putter kv.PutFlusher) error {
-		var (
-			header  = block.Header()
-			id      = header.ID()
-			txs     = block.Transactions()
-			summary = BlockSummary{header, indexRoot, []thor.Bytes32{}, uint64(block.Size())}
-		)
-
-		if n := len(txs); n > 0 {
-			key := makeTxKey(id, txInfix)
-			for i, tx := range txs {
-				key.SetIndex(uint64(i))
-				if err := saveTransaction(putter, key, tx); err != nil {
-					return err
-				}
-				r.caches.txs.Add(key, tx)
-				summary.Txs = append(summary.Txs, tx.ID())
+		return r.writeBlock(putter, block, receipts, indexRoot)
+	})
+}
+
+// writeCodec returns the codec to compress data written, or nil if compression disabled.
+func (r *Repository) writeCodec() block.Codec {
+	if r.compress {
+		return r.codec
+	}
+	return nil
+}
+
+func (r *Repository) writeBlock(putter kv.Putter, block *block.Block, receipts tx.Receipts, indexRoot thor.Bytes32) error {
+	codec := r.writeCodec()
+
+	var (
+		header  = block.Header()
+		id      = header.ID()
+		txs     = block.Transactions()
+		summary = BlockSummary{header, indexRoot, []thor.Bytes32{}, uint64(block.Size())}
+	)
+
+	if n := len(txs); n > 0 {
+		key := makeTxKey(id)
+		for i, tx := range txs {
+			key.SetIndex(uint64(i))
+			if err := saveTransaction(putter, key, tx, codec); err != nil {
+				return err
 			}
-			key = makeTxKey(id, receiptInfix)
-			for i, receipt := range receipts {
-				key.SetIndex(uint64(i))
-				if err := saveReceipt(putter, key, receipt); err != nil {
-					return err
-				}
-				r.caches.receipts.Add(key, receipt)
+			r.caches.txs.Add(key, tx)
+			summary.Txs = append(summary.Txs, tx.ID())
+		}
+		for i, receipt := range receipts {
+			key.SetIndex(uint64(i))
+			if err := saveReceipt(putter, key, receipt, codec); err != nil {
+				return err
 			}
+			r.caches.receipts.Add(key, receipt)
 		}
-		if err := saveBlockSummary(putter, &summary); err != nil {
+	}
+	if len(receipts) > 0 {
+		if err := saveBlockBloom(putter, id, receipts.Bloom()); err != nil {
 			return err
 		}
-		r.caches.summaries.Add(id, &summary)
-		return nil
-	})
+	}
+	if err := saveBlockSummary(putter, &summary); err != nil {
+		return err
+	}
+	r.caches.summaries.Add(id, &summary)
+	return nil
 }
 
 // AddBlock add a new block with its receipts into repository.
+// The block summary, txs and receipts are written in a single batch, so a block is never stored without its receipts.
 func (r *Repository) AddBlock(newBlock *block.Block, receipts tx.Receipts) error {
+	if r.readOnly {
+		return errReadOnly
+	}
+	if err := r.checkpoints.Check(newBlock.Header()); err != nil {
+		return err
+	}
 	parentSummary, err := r.GetBlockSummary(newBlock.Header().ParentID())
 	if err != nil {
 		if r.IsNotFound(err) {
@@ -197,11 +389,97 @@ func (r *Repository) AddBlock(newBlock *block.Block, receipts tx.Receipts) error
 	return nil
 }
 
+// AddBlocks add a batch of linked blocks with their receipts into repository.
+// All blocks are written in a single batch, which is much faster than adding them one by one.
+// The parent of the first block must already exist, and if asBest is true, the last block
+// becomes the best block.
+func (r *Repository) AddBlocks(blocks []*block.Block, receipts []tx.Receipts, asBest bool) error {
+	if r.readOnly {
+		return errReadOnly
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+	if len(blocks) != len(receipts) {
+		return errors.New("blocks count != receipts count")
+	}
+	for i, b := range blocks {
+		if i > 0 && b.Header().ParentID() != blocks[i-1].Header().ID() {
+			return errors.New("blocks not linked")
+		}
+		if err := r.checkpoints.Check(b.Header()); err != nil {
+			return err
+		}
+	}
+
+	parentSummary, err := r.GetBlockSummary(blocks[0].Header().ParentID())
+	if err != nil {
+		if r.IsNotFound(err) {
+			return errors.New("parent missing")
+		}
+		return err
+	}
+
+	var (
+		indexRoots = make([]thor.Bytes32, len(blocks))
+		indexRoot  = parentSummary.IndexRoot
+	)
+	for i, b := range blocks {
+		if indexRoot, err = r.indexBlock(indexRoot, b, receipts[i]); err != nil {
+			return err
+		}
+		indexRoots[i] = indexRoot
+	}
+
+	if err := r.data.Batch(func(putter kv.PutFlusher) error {
+		for i, b := range blocks {
+			if err := r.writeBlock(putter, b, receipts[i], indexRoots[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if asBest {
+		return r.switchBestBlock(blocks[len(blocks)-1])
+	}
+	return nil
+}
+
+// HasBlock check if the block with given id exists in repository.
+// The block is neither decoded nor cached.
+func (r *Repository) HasBlock(id thor.Bytes32) (bool, error) {
+	if r.caches.summaries.Contains(id) {
+		return true, nil
+	}
+	has, err := hasBlockSummary(r.data, id)
+	if err != nil || has || r.freezer == nil {
+		return has, err
+	}
+	if _, err := r.freezer.Get(id); err != nil {
+		if err == errNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // GetBlockSummary get block summary by block id.
 func (r *Repository) GetBlockSummary(id thor.Bytes32) (summary *BlockSummary, err error) {
 	var cached interface{}
 	if cached, err = r.caches.summaries.GetOrLoad(id, func() (interface{}, error) {
-		return loadBlockSummary(r.data, id)
+		summary, err := loadBlockSummary(r.data, id)
+		if err != nil && r.freezer != nil && r.data.IsNotFound(err) {
+			item, err := r.freezer.Get(id)
+			if err != nil {
+				return nil, err
+			}
+			return item.Summary, nil
+		}
+		return summary, err
 	}); err != nil {
 		return
 	}
@@ -210,7 +488,18 @@ func (r *Repository) GetBlockSummary(id thor.Bytes32) (summary *BlockSummary, er
 
 func (r *Repository) getTransaction(key txKey) (*tx.Transaction, error) {
 	cached, err := r.caches.txs.GetOrLoad(key, func() (interface{}, error) {
-		return loadTransaction(r.data, key)
+		tx, err := loadTransaction(r.data, key, r.codec)
+		if err != nil && r.freezer != nil && r.data.IsNotFound(err) {
+			item, err := r.freezer.Get(key.BlockID())
+			if err != nil {
+				return nil, err
+			}
+			if i := key.Index(); i < uint64(len(item.Txs)) {
+				return item.Txs[i], nil
+			}
+			return nil, errNotFound
+		}
+		return tx, err
 	})
 	if err != nil {
 		return nil, err
@@ -227,7 +516,7 @@ func (r *Repository) GetBlockTransactions(id thor.Bytes32) (tx.Transactions, err
 
 	if n := len(summary.Txs); n > 0 {
 		txs := make(tx.Transactions, n)
-		key := makeTxKey(id, txInfix)
+		key := makeTxKey(id)
 		for i := range summary.Txs {
 			key.SetIndex(uint64(i))
 			txs[i], err = r.getTransaction(key)
@@ -255,7 +544,18 @@ func (r *Repository) GetBlock(id thor.Bytes32) (*block.Block, error) {
 
 func (r *Repository) getReceipt(key txKey) (*tx.Receipt, error) {
 	cached, err := r.caches.receipts.GetOrLoad(key, func() (interface{}, error) {
-		return loadReceipt(r.data, key)
+		receipt, err := loadReceipt(r.data, key, r.codec)
+		if err != nil && r.freezer != nil && r.data.IsNotFound(err) {
+			item, err := r.freezer.Get(key.BlockID())
+			if err != nil {
+				return nil, err
+			}
+			if i := key.Index(); i < uint64(len(item.Receipts)) {
+				return item.Receipts[i], nil
+			}
+			return nil, errNotFound
+		}
+		return receipt, err
 	})
 	if err != nil {
 		return nil, err
@@ -272,7 +572,7 @@ func (r *Repository) GetBlockReceipts(id thor.Bytes32) (tx.Receipts, error) {
 
 	if n := len(summary.Txs); n > 0 {
 		receipts := make(tx.Receipts, n)
-		key := makeTxKey(id, receiptInfix)
+		key := makeTxKey(id)
 		for i := range summary.Txs {
 			key.SetIndex(uint64(i))
 			receipts[i], err = r.getReceipt(key)
@@ -285,12 +585,64 @@ func (r *Repository) GetBlockReceipts(id thor.Bytes32) (tx.Receipts, error) {
 	return nil, nil
 }
 
+// GetBlockBloom returns the bloom filter aggregated from receipts of the block, see tx.Receipts.Bloom.
+// It's nil for blocks without txs, or blocks stored before blooms were introduced.
+func (r *Repository) GetBlockBloom(id thor.Bytes32) (*bloom.Filter, error) {
+	filter, err := loadBlockBloom(r.data, id)
+	if err != nil {
+		if r.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return filter, nil
+}
+
 // IsNotFound returns if the given error means not found.
 func (r *Repository) IsNotFound(err error) bool {
 	return err == errNotFound || r.db.IsNotFound(err)
 }
 
+// Stats contains statistics of repository.
+type Stats struct {
+	Summaries CacheStats
+	Txs       CacheStats
+	Receipts  CacheStats
+}
+
+// Stats returns statistics of caches, including time spent on kv store reads.
+func (r *Repository) Stats() *Stats {
+	return &Stats{
+		Summaries: r.caches.summaries.Stats(),
+		Txs:       r.caches.txs.Stats(),
+		Receipts:  r.caches.receipts.Stats(),
+	}
+}
+
 // NewTicker create a signal Waiter to receive event that the best block changed.
 func (r *Repository) NewTicker() co.Waiter {
 	return r.tick.NewWaiter()
 }
+
+// SubscribeNewHead subscribe the event that the best block changed.
+// Like SubscribeReorg, events are queued without blocking the repository.
+func (r *Repository) SubscribeNewHead(ch chan *block.Header) event.Subscription {
+	return r.headFeed.subscribe(func(ev interface{}, quit <-chan struct{}) {
+		select {
+		case ch <- ev.(*block.Header):
+		case <-quit:
+		}
+	})
+}
+
+// SubscribeReorg subscribe the event that the best block switched to another branch.
+// Events are queued for the subscriber without blocking the repository, and the subscription
+// fails if too many events queued.
+func (r *Repository) SubscribeReorg(ch chan *ReorgEvent) event.Subscription {
+	return r.reorgFeed.subscribe(func(ev interface{}, quit <-chan struct{}) {
+		select {
+		case ch <- ev.(*ReorgEvent):
+		case <-quit:
+		}
+	})
+}
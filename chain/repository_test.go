@@ -6,15 +6,21 @@
 package chain_test
 
 import (
+	"context"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/block"
 	. "github.com/vechain/thor/chain"
 	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/kv"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
 
@@ -81,6 +87,10 @@ func TestRepository(t *testing.T) {
 		assert.Equal(t, b1.Header().ID(), s.Header.ID())
 		assert.Equal(t, 1, len(s.Txs))
 		assert.Equal(t, tx1.ID(), s.Txs[0])
+		assert.Equal(t, uint64(b1.Size()), s.Size)
+
+		assert.Equal(t, M(true, nil), M(repo.HasBlock(b1.Header().ID())))
+		assert.Equal(t, M(false, nil), M(repo.HasBlock(thor.Bytes32{})))
 
 		gotb, _ := repo.GetBlock(b1.Header().ID())
 		assert.Equal(t, b1.Transactions().RootHash(), gotb.Transactions().RootHash())
@@ -90,3 +100,466 @@ func TestRepository(t *testing.T) {
 		assert.Equal(t, tx.Receipts{receipt1}.RootHash(), gotReceipts.RootHash())
 	}
 }
+
+func TestAddBlocks(t *testing.T) {
+	repo := newTestRepo()
+
+	tx1 := new(tx.Builder).Build()
+	b1 := newBlock(repo.GenesisBlock(), 10, tx1)
+	b2 := newBlock(b1, 20)
+	b3 := newBlock(b2, 30)
+
+	// not linked
+	assert.Error(t, repo.AddBlocks([]*block.Block{b1, b3}, []tx.Receipts{{&tx.Receipt{}}, nil}, true))
+	// receipts count mismatch
+	assert.Error(t, repo.AddBlocks([]*block.Block{b1, b2}, nil, true))
+
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2, b3}, []tx.Receipts{{&tx.Receipt{}}, nil, nil}, true))
+	assert.Equal(t, b3.Header().ID(), repo.BestBlock().Header().ID())
+
+	c := repo.NewBestChain()
+	for _, b := range []*block.Block{b1, b2, b3} {
+		assert.Equal(t, M(b.Header().ID(), nil), M(c.GetBlockID(b.Header().Number())))
+	}
+	_, meta, err := c.GetTransaction(tx1.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), meta.BlockID)
+}
+
+func TestSubscribe(t *testing.T) {
+	repo := newTestRepo()
+	ch := make(chan *ReorgEvent, 1)
+	sub := repo.SubscribeReorg(ch)
+	defer sub.Unsubscribe()
+
+	headCh := make(chan *block.Header, 2)
+	headSub := repo.SubscribeNewHead(headCh)
+	defer headSub.Unsubscribe()
+
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	b2 := newBlock(b1, 20)
+	b2x := newBlock(b1, 20)
+	b3x := newBlock(b2x, 30)
+
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2}, []tx.Receipts{nil, nil}, true))
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b2x, b3x}, []tx.Receipts{nil, nil}, false))
+	assert.Equal(t, 0, len(ch))
+
+	assert.Equal(t, b2.Header().ID(), (<-headCh).ID())
+
+	assert.Nil(t, repo.SetBestBlockID(b3x.Header().ID()))
+	assert.Equal(t, b3x.Header().ID(), (<-headCh).ID())
+	ev := <-ch
+	assert.Equal(t, b1.Header().ID(), ev.Ancestor.ID())
+	assert.Equal(t, []thor.Bytes32{b2.Header().ID()}, blockIDs(ev.Detached))
+	assert.Equal(t, []thor.Bytes32{b2x.Header().ID(), b3x.Header().ID()}, blockIDs(ev.Attached))
+}
+
+func TestSubscribeSlow(t *testing.T) {
+	repo := newTestRepo()
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	b1x := newBlock(repo.GenesisBlock(), 10)
+	assert.Nil(t, repo.AddBlock(b1, nil))
+	assert.Nil(t, repo.AddBlock(b1x, nil))
+
+	// never received
+	sub := repo.SubscribeReorg(make(chan *ReorgEvent))
+	defer sub.Unsubscribe()
+	headSub := repo.SubscribeNewHead(make(chan *block.Header))
+	defer headSub.Unsubscribe()
+
+	// switching best block never blocks
+	for i := 0; i < 300; i++ {
+		best := b1
+		if i%2 == 1 {
+			best = b1x
+		}
+		assert.Nil(t, repo.SetBestBlockID(best.Header().ID()))
+	}
+
+	for _, sub := range []event.Subscription{sub, headSub} {
+		select {
+		case err := <-sub.Err():
+			assert.NotNil(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("slow subscriber should be unsubscribed")
+		}
+	}
+}
+
+func blockIDs(blocks []*block.Block) (ids []thor.Bytes32) {
+	for _, b := range blocks {
+		ids = append(ids, b.Header().ID())
+	}
+	return
+}
+
+func TestFinalizedBlock(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+	repo, _ := NewRepository(db, b0)
+
+	assert.Equal(t, b0.Header().ID(), repo.FinalizedBlockID())
+
+	b1 := newBlock(b0, 10)
+	b2 := newBlock(b1, 20)
+	b2x := newBlock(b1, 20)
+	b3x := newBlock(b2x, 30)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2}, []tx.Receipts{nil, nil}, true))
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b2x, b3x}, []tx.Receipts{nil, nil}, false))
+
+	assert.Error(t, repo.SetFinalizedBlockID(b2x.Header().ID()))
+	assert.Nil(t, repo.SetFinalizedBlockID(b2.Header().ID()))
+	assert.Error(t, repo.SetFinalizedBlockID(b1.Header().ID()))
+
+	// reorg below finalized block
+	assert.Error(t, repo.SetBestBlockID(b3x.Header().ID()))
+	assert.Equal(t, b2.Header().ID(), repo.BestBlock().Header().ID())
+
+	repo2, _ := NewRepository(db, b0)
+	assert.Equal(t, b2.Header().ID(), repo2.FinalizedBlockID())
+}
+
+func TestReindexTransactions(t *testing.T) {
+	repo := newTestRepo()
+
+	tx1 := new(tx.Builder).Build()
+	b1 := newBlock(repo.GenesisBlock(), 10, tx1)
+	b2 := newBlock(b1, 20)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2}, []tx.Receipts{{&tx.Receipt{}}, nil}, true))
+
+	s2, _ := repo.GetBlockSummary(b2.Header().ID())
+	assert.Nil(t, repo.ReindexTransactions(context.Background()))
+
+	newS2, _ := repo.GetBlockSummary(b2.Header().ID())
+	assert.Equal(t, s2.IndexRoot, newS2.IndexRoot)
+
+	_, meta, err := repo.NewBestChain().GetTransaction(tx1.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), meta.BlockID)
+}
+
+func TestAddBlockAtomic(t *testing.T) {
+	repo := newTestRepo()
+
+	b1 := newBlock(repo.GenesisBlock(), 10, new(tx.Builder).Build())
+	// receipts missing
+	assert.Error(t, repo.AddBlock(b1, nil))
+	assert.Equal(t, M(false, nil), M(repo.HasBlock(b1.Header().ID())))
+
+	assert.Nil(t, repo.AddBlock(b1, tx.Receipts{&tx.Receipt{}}))
+	receipts, err := repo.GetBlockReceipts(b1.Header().ID())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(receipts))
+}
+
+func TestConcurrentReadWrite(t *testing.T) {
+	repo := newTestRepo()
+
+	var blocks []*block.Block
+	parent := repo.GenesisBlock()
+	for i := 0; i < 100; i++ {
+		b := newBlock(parent, uint64(i+1)*10)
+		blocks = append(blocks, b)
+		parent = b
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, b := range blocks {
+			assert.Nil(t, repo.AddBlock(b, nil))
+			assert.Nil(t, repo.SetBestBlockID(b.Header().ID()))
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			assert.Equal(t, parent.Header().ID(), repo.BestBlock().Header().ID())
+			return
+		default:
+		}
+		c := repo.NewBestChain()
+		num := block.Number(c.HeadID())
+		id, err := c.GetBlockID(num)
+		assert.Nil(t, err)
+		assert.Equal(t, c.HeadID(), id)
+		b, err := c.GetBlock(num)
+		assert.Nil(t, err)
+		assert.Equal(t, id, b.Header().ID())
+	}
+}
+
+func TestStats(t *testing.T) {
+	repo := newTestRepo()
+	b1 := newBlock(repo.GenesisBlock(), 10)
+	repo.AddBlock(b1, nil)
+
+	repo.GetBlockSummary(b1.Header().ID())
+	repo.GetBlockSummary(thor.Bytes32{})
+
+	stats := repo.Stats()
+	// parent summary is also hit when adding block
+	assert.Equal(t, uint64(2), stats.Summaries.Hit)
+	assert.Equal(t, uint64(1), stats.Summaries.Miss)
+	assert.Equal(t, 2.0/3, stats.Summaries.HitRate())
+	assert.Equal(t, 0.0, stats.Txs.HitRate())
+}
+
+func TestRepositoryOptions(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+	repo, err := NewRepositoryWithOptions(db, b0, Options{SummaryCacheSize: 1})
+	assert.Nil(t, err)
+
+	b1 := newBlock(b0, 10)
+	b2 := newBlock(b1, 20)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2}, []tx.Receipts{nil, nil}, true))
+
+	// b1 is evicted by later summaries
+	miss := repo.Stats().Summaries.Miss
+	repo.GetBlockSummary(b2.Header().ID())
+	repo.GetBlockSummary(b1.Header().ID())
+	assert.Equal(t, miss+1, repo.Stats().Summaries.Miss)
+}
+
+func TestCheckpoints(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+	b1 := newBlock(b0, 10)
+	b2 := newBlock(b1, 20)
+	b2x := newBlock(b1, 20)
+	b3x := newBlock(b2x, 30)
+
+	_, err := NewRepositoryWithOptions(db, b0, Options{Checkpoints: map[uint32]thor.Bytes32{0: {}}})
+	assert.Error(t, err)
+
+	repo, err := NewRepositoryWithOptions(db, b0, Options{Checkpoints: map[uint32]thor.Bytes32{2: b2.Header().ID()}})
+	assert.Nil(t, err)
+	assert.Equal(t, M(uint32(2), b2.Header().ID(), true), M(repo.LatestCheckpoint()))
+
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2}, []tx.Receipts{nil, nil}, true))
+	assert.Error(t, repo.AddBlock(b2x, nil))
+	assert.Error(t, repo.AddBlocks([]*block.Block{b2x, b3x}, []tx.Receipts{nil, nil}, true))
+	assert.Equal(t, M(false, nil), M(repo.HasBlock(b2x.Header().ID())))
+}
+
+func TestCompressBlocks(t *testing.T) {
+	db := muxdb.NewMem()
+	g := genesis.NewDevnet()
+	b0, _, _, _ := g.Build(state.NewStater(db))
+
+	plain, _ := NewRepositoryWithOptions(db, b0, Options{})
+	compressed, _ := NewRepositoryWithOptions(db, b0, Options{CompressBlocks: true})
+
+	tx1 := new(tx.Builder).Nonce(1).Build()
+	tx2 := new(tx.Builder).Nonce(2).Build()
+	b1 := newBlock(b0, 10, tx1)
+	b2 := newBlock(b0, 20, tx2)
+	assert.Nil(t, plain.AddBlock(b1, tx.Receipts{&tx.Receipt{GasUsed: 1}}))
+	assert.Nil(t, compressed.AddBlock(b2, tx.Receipts{&tx.Receipt{GasUsed: 2}}))
+
+	// data written either compressed or not is readable
+	for _, b := range []*block.Block{b1, b2} {
+		repo, _ := NewRepository(db, b0)
+		got, err := repo.GetBlock(b.Header().ID())
+		assert.Nil(t, err)
+		assert.Equal(t, b.Header().ID(), got.Header().ID())
+		assert.Equal(t, b.Transactions()[0].ID(), got.Transactions()[0].ID())
+
+		receipts, err := repo.GetBlockReceipts(b.Header().ID())
+		assert.Nil(t, err)
+		assert.Equal(t, b.Header().Timestamp()/10, receipts[0].GasUsed)
+	}
+}
+
+func TestBlockCodec(t *testing.T) {
+	db := muxdb.NewMem()
+	g := genesis.NewDevnet()
+	b0, _, _, _ := g.Build(state.NewStater(db))
+
+	codec, err := block.NewZstdCodec(nil, 3)
+	assert.Nil(t, err)
+	repo, _ := NewRepositoryWithOptions(db, b0, Options{CompressBlocks: true, BlockCodec: codec})
+
+	tx1 := new(tx.Builder).Nonce(1).Build()
+	b1 := newBlock(b0, 10, tx1)
+	assert.Nil(t, repo.AddBlock(b1, tx.Receipts{&tx.Receipt{GasUsed: 1}}))
+
+	// readable only with the same codec
+	for _, r := range []*Repository{
+		repo,
+		func() *Repository { r, _ := NewRepositoryWithOptions(db, b0, Options{BlockCodec: codec}); return r }(),
+	} {
+		got, err := r.GetBlock(b1.Header().ID())
+		assert.Nil(t, err)
+		assert.Equal(t, tx1.Hash(), got.Transactions()[0].Hash())
+		receipts, err := r.GetBlockReceipts(b1.Header().ID())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), receipts[0].GasUsed)
+	}
+
+	other, _ := NewRepository(db, b0)
+	_, err = other.GetBlock(b1.Header().ID())
+	assert.NotNil(t, err)
+}
+
+func TestReadOnly(t *testing.T) {
+	db := muxdb.NewMem()
+	g := genesis.NewDevnet()
+	b0, _, _, _ := g.Build(state.NewStater(db))
+
+	_, err := NewRepositoryWithOptions(db, b0, Options{ReadOnly: true})
+	assert.NotNil(t, err, "should fail on uninitialized db")
+
+	repo, _ := NewRepository(db, b0)
+	b1 := newBlock(b0, 10)
+	assert.Nil(t, repo.AddBlock(b1, nil))
+	assert.Nil(t, repo.SetBestBlockID(b1.Header().ID()))
+
+	ro, err := NewRepositoryWithOptions(db, b0, Options{ReadOnly: true})
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), ro.BestBlock().Header().ID())
+	assert.Equal(t, M(b1.Header().ID(), nil), M(ro.NewBestChain().GetBlockID(1)))
+
+	b2 := newBlock(b1, 20)
+	assert.NotNil(t, ro.AddBlock(b2, nil))
+	assert.NotNil(t, ro.AddBlocks([]*block.Block{b2}, []tx.Receipts{nil}, true))
+	assert.NotNil(t, ro.SetBestBlockID(b0.Header().ID()))
+	assert.NotNil(t, ro.SetFinalizedBlockID(b1.Header().ID()))
+	_, err = ro.PruneBranches(context.Background(), 1)
+	assert.NotNil(t, err)
+
+	has, _ := repo.HasBlock(b2.Header().ID())
+	assert.False(t, has)
+}
+
+func TestMigrateLegacyData(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+
+	repo, _ := NewRepositoryWithOptions(db, b0, Options{})
+	tx1 := newTx()
+	receipts := tx.Receipts{&tx.Receipt{GasUsed: 1}}
+	b1 := newBlock(b0, 10, tx1)
+	assert.Nil(t, repo.AddBlock(b1, receipts))
+	assert.Nil(t, repo.SetBestBlockID(b1.Header().ID()))
+
+	// move data into legacy layout
+	data := db.NewStore("chain.blockdata")
+	legacy := db.NewStore("chain.data")
+	var keys [][]byte
+	assert.Nil(t, data.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+		key := pair.Key()
+		switch key[0] {
+		case 's':
+			assert.Nil(t, legacy.Put(key[1:], pair.Value()))
+		case 't', 'r':
+			infix := byte(0)
+			if key[0] == 'r' {
+				infix = 1
+			}
+			legacyKey := append(append(append([]byte(nil), key[1:33]...), infix), key[33:]...)
+			assert.Nil(t, legacy.Put(legacyKey, pair.Value()))
+		}
+		keys = append(keys, append([]byte(nil), key...))
+		return true
+	}))
+	for _, key := range keys {
+		assert.Nil(t, data.Delete(key))
+	}
+
+	_, err := NewRepositoryWithOptions(db, b0, Options{ReadOnly: true})
+	assert.NotNil(t, err, "read-only repository can't migrate")
+
+	repo, err = NewRepositoryWithOptions(db, b0, Options{})
+	assert.Nil(t, err)
+	assert.Equal(t, b1.Header().ID(), repo.BestBlock().Header().ID())
+	assert.Equal(t, tx1.ID(), repo.BestBlock().Transactions()[0].ID())
+	migrated, err := repo.GetBlockReceipts(b1.Header().ID())
+	assert.Nil(t, err)
+	assert.Equal(t, receipts.RootHash(), migrated.RootHash())
+
+	empty := true
+	assert.Nil(t, legacy.Iterate(kv.Range{}, func(kv.Pair) bool {
+		empty = false
+		return false
+	}))
+	assert.True(t, empty, "legacy data should be deleted")
+}
+
+func TestBlockBloom(t *testing.T) {
+	repo := newTestRepo()
+	b0 := repo.GenesisBlock()
+
+	addr := thor.BytesToAddress([]byte("addr"))
+	receipts := tx.Receipts{{Outputs: []*tx.Output{{Events: tx.Events{{Address: addr}}}}}}
+
+	b1 := newBlock(b0, 10, newTx())
+	assert.Nil(t, repo.AddBlock(b1, receipts))
+
+	filter, err := repo.GetBlockBloom(b1.Header().ID())
+	assert.Nil(t, err)
+	assert.True(t, filter.Contains(addr.Bytes()))
+
+	assert.Equal(t, M(receipts.Bloom(), nil), M(repo.GetBlockBloom(b1.Header().ID())))
+
+	filter, err = repo.GetBlockBloom(b0.Header().ID())
+	assert.Nil(t, err)
+	assert.Nil(t, filter)
+}
+
+func TestStateSnapshot(t *testing.T) {
+	db := muxdb.NewMem()
+	b0, _, _, _ := genesis.NewDevnet().Build(state.NewStater(db))
+
+	snap, err := state.OpenSnapshot(db)
+	assert.Nil(t, err)
+	assert.Nil(t, snap.Rebuild(db, b0.Header().StateRoot()))
+	stater := state.NewStaterWithSnapshot(db, snap)
+
+	repo, err := NewRepositoryWithOptions(db, b0, Options{StateSnapshot: snap})
+	assert.Nil(t, err)
+
+	newChild := func(parent *block.Block, balance int64) *block.Block {
+		st := stater.NewState(parent.Header().StateRoot())
+		st.SetBalance(thor.Address{1}, big.NewInt(balance))
+		stage, err := st.Stage()
+		assert.Nil(t, err)
+		root, err := stage.Commit()
+		assert.Nil(t, err)
+
+		b := new(block.Builder).
+			ParentID(parent.Header().ID()).
+			Timestamp(parent.Header().Timestamp() + 10).
+			StateRoot(root).
+			Build()
+		pk, _ := crypto.GenerateKey()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), pk)
+		b = b.WithSignature(sig)
+		assert.Nil(t, repo.AddBlock(b, nil))
+		return b
+	}
+	snapRoot := func() thor.Bytes32 {
+		root, ok := snap.Root()
+		assert.True(t, ok)
+		return root
+	}
+
+	b1 := newChild(b0, 1)
+	b1x := newChild(b0, 2)
+	assert.Equal(t, b0.Header().StateRoot(), snapRoot(), "should not move until best block changed")
+
+	assert.Nil(t, repo.SetBestBlockID(b1.Header().ID()))
+	assert.Equal(t, b1.Header().StateRoot(), snapRoot())
+
+	// reorg
+	b2x := newChild(b1x, 3)
+	assert.Nil(t, repo.SetBestBlockID(b2x.Header().ID()))
+	assert.Equal(t, b2x.Header().StateRoot(), snapRoot())
+
+	balance, err := stater.NewState(b2x.Header().StateRoot()).GetBalance(thor.Address{1})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(3), balance)
+}
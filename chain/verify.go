@@ -0,0 +1,92 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+)
+
+// CorruptError describes the corrupt block found by Verify.
+type CorruptError struct {
+	Num    uint32
+	ID     thor.Bytes32
+	Reason string
+}
+
+func (e *CorruptError) Error() string {
+	return fmt.Sprintf("corrupt block #%v %v: %v", e.Num, e.ID, e.Reason)
+}
+
+// Verify walks all blocks of the chain from genesis to head, and checks integrity of stored data.
+// Headers are re-hashed against block ids, and txs/receipts roots are re-computed from stored
+// txs and receipts. It stops at the first corrupt block, and returns *CorruptError describing it.
+func (c *Chain) Verify(ctx context.Context) error {
+	headNum := block.Number(c.HeadID())
+	var parentID thor.Bytes32
+	for num := uint32(0); num <= headNum; num++ {
+		id, err := c.GetBlockID(num)
+		if err != nil {
+			return errors.WithMessagef(err, "get block id #%v", num)
+		}
+		corrupt := func(reason string) error {
+			return &CorruptError{num, id, reason}
+		}
+
+		summary, err := c.repo.GetBlockSummary(id)
+		if err != nil {
+			if c.repo.IsNotFound(err) {
+				return corrupt("summary missing")
+			}
+			return corrupt("summary: " + err.Error())
+		}
+		header := summary.Header
+		if header.ID() != id {
+			return corrupt("header hash mismatch")
+		}
+		if header.Number() != num {
+			return corrupt("block number mismatch")
+		}
+		if num > 0 && header.ParentID() != parentID {
+			return corrupt("parent id mismatch")
+		}
+
+		txs, err := c.repo.GetBlockTransactions(id)
+		if err != nil {
+			return corrupt("txs: " + err.Error())
+		}
+		if txs.RootHash() != header.TxsRoot() {
+			return corrupt("txs root mismatch")
+		}
+		for i, tx := range txs {
+			if tx.ID() != summary.Txs[i] {
+				return corrupt("tx id mismatch")
+			}
+		}
+
+		receipts, err := c.repo.GetBlockReceipts(id)
+		if err != nil {
+			return corrupt("receipts: " + err.Error())
+		}
+		if receipts.RootHash() != header.ReceiptsRoot() {
+			return corrupt("receipts root mismatch")
+		}
+		parentID = id
+
+		if num%1000 == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// VerifyStateRoots checks that state of each block in range [from, to] is resolvable from the
+// header's state root, and all trie nodes are consistent with their hashes.
+//
+// The first block's state is fully traversed. For following blocks, only trie nodes differing from
+// the last intact state are traversed, so verifying a range costs little more than verifying one state.
+// Unlike Verify, it doesn't stop at the first damaged block, but returns all of them.
+// Note that state of blocks pruned by the state pruner is reported as damaged.
+func (c *Chain) VerifyStateRoots(ctx context.Context, from, to uint32, db *muxdb.MuxDB) ([]*CorruptError, error) {
+	var (
+		damaged  []*CorruptError
+		baseRoot thor.Bytes32
+		hasBase  bool
+	)
+	for num := from; num <= to; num++ {
+		header, err := c.GetBlockHeader(num)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "get block header #%v", num)
+		}
+
+		var base *muxdb.Trie
+		if hasBase {
+			base = db.NewSecureTrie(state.AccountTrieName, baseRoot)
+		}
+		if err := verifyStateTrie(ctx, db, header.StateRoot(), base); err != nil {
+			if err == ctx.Err() {
+				return nil, err
+			}
+			damaged = append(damaged, &CorruptError{num, header.ID(), "state: " + err.Error()})
+			hasBase = false
+		} else {
+			baseRoot, hasBase = header.StateRoot(), true
+		}
+
+		if num == to {
+			break
+		}
+	}
+	return damaged, nil
+}
+
+// verifyStateTrie verifies the accounts trie at root and storage tries of accounts.
+// If base is given, nodes in base are assumed to be intact and skipped.
+func verifyStateTrie(ctx context.Context, db *muxdb.MuxDB, root thor.Bytes32, base *muxdb.Trie) error {
+	accountTrie := db.NewSecureTrie(state.AccountTrieName, root)
+	return verifyTrie(ctx, accountTrie, base, func(key, blob []byte) error {
+		var acc state.Account
+		if err := rlp.DecodeBytes(blob, &acc); err != nil {
+			return err
+		}
+		// secure key of accounts trie is the address hash
+		name := state.StorageTrieName(thor.BytesToBytes32(key))
+
+		var storageBase *muxdb.Trie
+		if base != nil {
+			// storage trie of the same account in base state
+			if addr := accountTrie.GetKeyPreimage(thor.BytesToBytes32(key)); len(addr) > 0 {
+				if baseBlob, err := base.Get(addr); err == nil && len(baseBlob) > 0 {
+					var baseAcc state.Account
+					if err := rlp.DecodeBytes(baseBlob, &baseAcc); err == nil {
+						storageBase = db.NewSecureTrie(name, thor.BytesToBytes32(baseAcc.StorageRoot))
+					}
+				}
+			}
+		}
+		if err := verifyTrie(ctx, db.NewSecureTrie(name, thor.BytesToBytes32(acc.StorageRoot)), storageBase, nil); err != nil {
+			return errors.WithMessagef(err, "storage of account %x", key)
+		}
+		return nil
+	})
+}
+
+// verifyTrie traverses the trie, and checks hash of each node. If base is given, only nodes not in base are traversed.
+func verifyTrie(ctx context.Context, t *muxdb.Trie, base *muxdb.Trie, onLeaf func(key, blob []byte) error) error {
+	it := t.NodeIterator(nil)
+	if base != nil {
+		it, _ = trie.NewDifferenceIterator(base.NodeIterator(nil), it)
+	}
+	for n := 0; it.Next(true); n++ {
+		if hash := it.Hash(); !hash.IsZero() {
+			enc, err := it.Node()
+			if err != nil {
+				return err
+			}
+			if thor.Blake2b(enc) != hash {
+				return fmt.Errorf("trie node %v hash mismatch", hash)
+			}
+		}
+		if it.Leaf() && onLeaf != nil {
+			if err := onLeaf(it.LeafKey(), it.LeafBlob()); err != nil {
+				return err
+			}
+		}
+		if n%1000 == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+		}
+	}
+	return it.Error()
+}
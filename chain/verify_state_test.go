@@ -0,0 +1,78 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func newBlockWithStateRoot(parent *block.Block, ts uint64, root thor.Bytes32) *block.Block {
+	b := new(block.Builder).
+		ParentID(parent.Header().ID()).
+		Timestamp(ts).
+		StateRoot(root).
+		Build()
+	pk, _ := crypto.GenerateKey()
+	sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), pk)
+	return b.WithSignature(sig)
+}
+
+func TestVerifyStateRoots(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, err := genesis.NewDevnet().Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, b0)
+	assert.Nil(t, err)
+
+	// commit a new state derived from root
+	commit := func(root thor.Bytes32, i int64) thor.Bytes32 {
+		st := stater.NewState(root)
+		addr := thor.BytesToAddress(big.NewInt(i).Bytes())
+		st.SetBalance(addr, big.NewInt(i))
+		st.SetStorage(addr, thor.Bytes32{}, thor.BytesToBytes32([]byte("v")))
+		stage, err := st.Stage()
+		assert.Nil(t, err)
+		newRoot, err := stage.Commit()
+		assert.Nil(t, err)
+		return newRoot
+	}
+
+	root1 := commit(b0.Header().StateRoot(), 1)
+	b1 := newBlockWithStateRoot(b0, 10, root1)
+	// state of b2 missing
+	b2 := newBlockWithStateRoot(b1, 20, thor.BytesToBytes32([]byte("missing")))
+	b3 := newBlockWithStateRoot(b2, 30, commit(root1, 3))
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2, b3}, []tx.Receipts{nil, nil, nil}, true))
+
+	damaged, err := repo.NewBestChain().VerifyStateRoots(context.Background(), 0, 3, db)
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(damaged)) {
+		assert.Equal(t, uint32(2), damaged[0].Num)
+		assert.Equal(t, b2.Header().ID(), damaged[0].ID)
+	}
+
+	damaged, err = repo.NewBestChain().VerifyStateRoots(context.Background(), 3, 3, db)
+	assert.Nil(t, err)
+	assert.Empty(t, damaged)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = repo.NewBestChain().VerifyStateRoots(ctx, 0, 3, db)
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/tx"
+)
+
+func newBlockWithReceipts(parent *block.Block, ts uint64, txs tx.Transactions, receipts tx.Receipts) *block.Block {
+	builder := new(block.Builder).
+		ParentID(parent.Header().ID()).
+		Timestamp(ts).
+		ReceiptsRoot(receipts.RootHash())
+	for _, tx := range txs {
+		builder.Transaction(tx)
+	}
+	b := builder.Build()
+
+	pk, _ := crypto.GenerateKey()
+	sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), pk)
+	return b.WithSignature(sig)
+}
+
+func TestVerify(t *testing.T) {
+	repo := newTestRepo()
+
+	txs := tx.Transactions{new(tx.Builder).Nonce(1).Build()}
+	receipts := tx.Receipts{&tx.Receipt{GasUsed: 1}}
+	b1 := newBlockWithReceipts(repo.GenesisBlock(), 10, txs, receipts)
+	b2 := newBlockWithReceipts(b1, 20, nil, nil)
+	assert.Nil(t, repo.AddBlocks([]*block.Block{b1, b2}, []tx.Receipts{receipts, nil}, true))
+	assert.Nil(t, repo.NewBestChain().Verify(context.Background()))
+
+	// receipts not matching the header
+	b3 := newBlockWithReceipts(b2, 30, txs, receipts)
+	assert.Nil(t, repo.AddBlock(b3, tx.Receipts{&tx.Receipt{GasUsed: 2}}))
+	assert.Nil(t, repo.SetBestBlockID(b3.Header().ID()))
+
+	err := repo.NewBestChain().Verify(context.Background())
+	assert.Equal(t, &chain.CorruptError{Num: 3, ID: b3.Header().ID(), Reason: "receipts root mismatch"}, err)
+}
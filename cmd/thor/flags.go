@@ -6,7 +6,10 @@
 package main
 
 import (
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
 	cli "gopkg.in/urfave/cli.v1"
 )
 
@@ -102,6 +105,35 @@ var (
 		Value: 0,
 		Usage: "target block gas limit (adaptive if set to 0)",
 	}
+	packDeadlineFlag = cli.IntFlag{
+		Name:  "pack-deadline",
+		Value: 80,
+		Usage: "time to fill txs when packing, in percent of block interval (unlimited if set to 0)",
+	}
+	emptyBlockIntervalFlag = cli.IntFlag{
+		Name:  "empty-block-interval",
+		Value: 0,
+		Usage: "min seconds between an empty block and its parent, to suppress empty blocks in solo mode (disabled if set to 0)",
+	}
+	packStrategyFlag = cli.StringFlag{
+		Name:  "pack-strategy",
+		Value: "price",
+		Usage: "order of txs to pack, one of price|fifo|fairness",
+	}
+	priorityListFlag = cli.StringFlag{
+		Name:  "priority-list",
+		Usage: "path to the file of addresses whose txs are packed first (defaults to priority.list in the instance dir)",
+	}
+	packGasTargetFlag = cli.IntFlag{
+		Name:  "pack-gas-target",
+		Value: 100,
+		Usage: "target gas utilization of packed blocks, in percent of block gas limit",
+	}
+	packWorkersFlag = cli.IntFlag{
+		Name:  "pack-workers",
+		Value: 1,
+		Usage: "number of workers to execute txs concurrently when packing (serially if less than 2)",
+	}
 	bootNodeFlag = cli.StringFlag{
 		Name:  "bootnode",
 		Usage: "comma separated list of bootnode IDs",
@@ -114,6 +146,10 @@ var (
 		Name:  "skip-logs",
 		Usage: "skip writing event|transfer logs (/logs API will be disabled)",
 	}
+	metricsFlag = cli.BoolFlag{
+		Name:  metrics.MetricsEnabledFlag,
+		Usage: "enable metrics collection, exposed at /debug/metrics of API",
+	}
 	verifyLogsFlag = cli.BoolFlag{
 		Name:   "verify-logs",
 		Usage:  "verify log db at startup",
@@ -121,12 +157,61 @@ var (
 	}
 	cacheFlag = cli.IntFlag{
 		Name:  "cache",
-		Usage: "megabytes of ram allocated to trie nodes cache",
+		Usage: "megabytes of ram allocated to trie nodes cache, 0 to disable (hit rates exposed in metrics)",
 		Value: 2048,
 	}
+	dbEngineFlag = cli.StringFlag{
+		Name:  "db-engine",
+		Value: muxdb.EngineLevelDB,
+		Usage: "main database engine (" + muxdb.EngineLevelDB + "|" + muxdb.EngineBadger + "|" + muxdb.EngineRocksDB + ")",
+	}
+	dbEncryptionKeyFileFlag = cli.StringFlag{
+		Name:  "db-encryption-key-file",
+		Usage: "file contains hex encoded AES key (16, 24 or 32 bytes) to encrypt main database at rest",
+	}
 	disablePrunerFlag = cli.BoolFlag{
 		Name:  "disable-pruner",
-		Usage: "disable state pruner to keep all history",
+		Usage: "disable state pruner to keep all history (same as --state-retention=archive)",
+	}
+	stateRetentionFlag = cli.StringFlag{
+		Name:  "state-retention",
+		Value: stateRetentionFull,
+		Usage: "state retention mode (" + stateRetentionArchive + "|" + stateRetentionFull + "), archive keeps state of every block, full keeps state of recent blocks and periodic checkpoints",
+	}
+	stateHistoryFlag = cli.IntFlag{
+		Name:  "state-history",
+		Value: thor.MaxStateHistory,
+		Usage: "count of recent blocks whose state is kept in full retention mode",
+	}
+	freezeDepthFlag = cli.IntFlag{
+		Name:  "freeze-depth",
+		Usage: "move blocks deeper than this below the best block from main database into flat files, they are no longer reorganizable (disabled if set to 0)",
+	}
+	slashingHookFlag = cli.StringFlag{
+		Name:  "slashing-hook",
+		Usage: "executable to run when a double signing is detected, with the evidence in JSON as stdin",
+	}
+	livenessHookFlag = cli.StringFlag{
+		Name:  "liveness-hook",
+		Usage: "executable to run when an authority node misses too many slots, with the report in JSON as stdin",
+	}
+	livenessThresholdFlag = cli.IntFlag{
+		Name:  "liveness-threshold",
+		Value: 100,
+		Usage: "count of missed slots within a day to trigger the liveness hook",
+	}
+	stateSnapshotFlag = cli.BoolFlag{
+		Name:  "state-snapshot",
+		Usage: "maintain flat state snapshot to speed up state reads",
+	}
+	devMnemonicFlag = cli.StringFlag{
+		Name:  "dev-mnemonic",
+		Usage: "BIP39 mnemonic to derive dev accounts, built-in accounts are used if empty",
+	}
+	devAccountsFlag = cli.IntFlag{
+		Name:  "dev-accounts",
+		Value: 10,
+		Usage: "count of dev accounts",
 	}
 	txPoolLimitFlag = cli.IntFlag{
 		Name:  "txpool-limit",
@@ -138,4 +223,22 @@ var (
 		Value: 16,
 		Usage: "set tx limit per account in pool",
 	}
+	dbFileFlag = cli.StringFlag{
+		Name:  "file",
+		Usage: "path of the file to export blocks into, or import blocks from",
+	}
+	exportFromFlag = cli.IntFlag{
+		Name:  "from",
+		Usage: "number of the first block to export",
+	}
+	exportToFlag = cli.IntFlag{
+		Name:  "to",
+		Value: -1,
+		Usage: "number of the last block to export (defaults to the best block)",
+	}
+	pruneDepthFlag = cli.IntFlag{
+		Name:  "depth",
+		Value: 360,
+		Usage: "confirmation depth below the best block, side branches forked deeper are pruned",
+	}
 )
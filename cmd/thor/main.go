@@ -6,6 +6,7 @@
 package main
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -20,13 +21,13 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/cmd/thor/node"
 	"github.com/vechain/thor/cmd/thor/pruner"
 	"github.com/vechain/thor/cmd/thor/solo"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/muxdb"
-	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/txpool"
 	cli "gopkg.in/urfave/cli.v1"
@@ -42,6 +43,9 @@ var (
 		Limit:           10000,
 		LimitPerAccount: 16,
 		MaxLifetime:     20 * time.Minute,
+		PriceBump:       10,
+		LimitBytes:      64 * 1024 * 1024,
+		MaxExpiration:   720,
 	}
 )
 
@@ -66,6 +70,11 @@ func main() {
 			cacheFlag,
 			beneficiaryFlag,
 			targetGasLimitFlag,
+			packDeadlineFlag,
+			packGasTargetFlag,
+			packWorkersFlag,
+			packStrategyFlag,
+			priorityListFlag,
 			apiAddrFlag,
 			apiCorsFlag,
 			apiTimeoutFlag,
@@ -78,8 +87,18 @@ func main() {
 			bootNodeFlag,
 			skipLogsFlag,
 			pprofFlag,
+			metricsFlag,
 			verifyLogsFlag,
 			disablePrunerFlag,
+			stateRetentionFlag,
+			stateHistoryFlag,
+			stateSnapshotFlag,
+			freezeDepthFlag,
+			slashingHookFlag,
+			livenessHookFlag,
+			livenessThresholdFlag,
+			dbEngineFlag,
+			dbEncryptionKeyFileFlag,
 		},
 		Action: defaultAction,
 		Commands: []cli.Command{
@@ -95,15 +114,26 @@ func main() {
 					apiCallGasLimitFlag,
 					apiBacktraceLimitFlag,
 					onDemandFlag,
+					emptyBlockIntervalFlag,
 					persistFlag,
 					gasLimitFlag,
+					packStrategyFlag,
+					packWorkersFlag,
 					verbosityFlag,
 					pprofFlag,
+					metricsFlag,
 					verifyLogsFlag,
 					skipLogsFlag,
+					devMnemonicFlag,
+					devAccountsFlag,
 					txPoolLimitFlag,
 					txPoolLimitPerAccountFlag,
 					disablePrunerFlag,
+					stateRetentionFlag,
+					stateHistoryFlag,
+					stateSnapshotFlag,
+					dbEngineFlag,
+					dbEncryptionKeyFileFlag,
 				},
 				Action: soloAction,
 			},
@@ -117,6 +147,89 @@ func main() {
 				},
 				Action: masterKeyAction,
 			},
+			{
+				Name:  "db",
+				Usage: "main database management",
+				Subcommands: []cli.Command{
+					{
+						Name:  "verify",
+						Usage: "verify integrity of blocks on the best chain",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							verbosityFlag,
+							dbEngineFlag,
+							dbEncryptionKeyFileFlag,
+						},
+						Action: dbVerifyAction,
+					},
+					{
+						Name:  "export",
+						Usage: "export blocks on the best chain along with receipts into a file",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							verbosityFlag,
+							dbEngineFlag,
+							dbEncryptionKeyFileFlag,
+							dbFileFlag,
+							exportFromFlag,
+							exportToFlag,
+						},
+						Action: dbExportAction,
+					},
+					{
+						Name:  "import",
+						Usage: "import blocks from a file exported by a trusted node, blocks are not verified",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							verbosityFlag,
+							dbEngineFlag,
+							dbEncryptionKeyFileFlag,
+							dbFileFlag,
+						},
+						Action: dbImportAction,
+					},
+					{
+						Name:  "prune-branches",
+						Usage: "delete blocks of side branches forked deeper than the confirmation depth",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							verbosityFlag,
+							dbEngineFlag,
+							dbEncryptionKeyFileFlag,
+							pruneDepthFlag,
+						},
+						Action: dbPruneBranchesAction,
+					},
+					{
+						Name:  "reindex",
+						Usage: "rebuild the index of blocks and txs on the best chain",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							verbosityFlag,
+							dbEngineFlag,
+							dbEncryptionKeyFileFlag,
+						},
+						Action: dbReindexAction,
+					},
+					{
+						Name:  "leaves",
+						Usage: "list head blocks of all stored branches, with count of their blocks not on the best chain",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							verbosityFlag,
+							dbEngineFlag,
+							dbEncryptionKeyFileFlag,
+						},
+						Action: dbLeavesAction,
+					},
+				},
+			},
 		},
 	}
 
@@ -132,7 +245,7 @@ func defaultAction(ctx *cli.Context) error {
 	defer func() { log.Info("exited") }()
 
 	initLogger(ctx)
-	gene, forkConfig, err := selectGenesis(ctx)
+	gene, forkConfig, rewardPolicy, err := selectGenesis(ctx)
 	if err != nil {
 		return err
 	}
@@ -155,18 +268,41 @@ func defaultAction(ctx *cli.Context) error {
 	}
 	defer func() { log.Info("closing log database..."); logDB.Close() }()
 
-	repo, err := initChainRepository(gene, mainDB, logDB)
+	snap, err := openStateSnapshot(ctx, mainDB)
 	if err != nil {
 		return err
 	}
+	var freezerDir string
+	if ctx.Int(freezeDepthFlag.Name) > 0 {
+		freezerDir = filepath.Join(instanceDir, "freezer")
+	}
+	repo, err := initChainRepository(gene, mainDB, logDB, snap, freezerDir)
+	if err != nil {
+		return err
+	}
+	defer func() { log.Info("closing chain repository..."); repo.Close() }()
 
 	master, err := loadNodeMaster(ctx)
 	if err != nil {
 		return err
 	}
 
+	strategy, err := packStrategy(ctx)
+	if err != nil {
+		return err
+	}
+	priorityList, err := loadPriorityList(ctx, instanceDir)
+	if err != nil {
+		return err
+	}
+
 	printStartupMessage1(gene, repo, master, instanceDir, forkConfig)
 
+	stater, err := newStater(mainDB, repo, snap)
+	if err != nil {
+		return err
+	}
+
 	if !skipLogs {
 		if err := syncLogDB(exitSignal, repo, logDB, ctx.Bool(verifyLogsFlag.Name)); err != nil {
 			return err
@@ -174,19 +310,26 @@ func defaultAction(ctx *cli.Context) error {
 	}
 
 	txpoolOpt := defaultTxPoolOptions
-	txPool := txpool.New(repo, state.NewStater(mainDB), txpoolOpt)
+	txpoolOpt.EstimateGas = true
+	txpoolOpt.ForkConfig = forkConfig
+	txPool := txpool.New(repo, stater, txpoolOpt)
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
 
-	p2pcom, err := newP2PComm(ctx, repo, txPool, instanceDir)
+	evidencePool := newEvidencePool(ctx, mainDB)
+	livenessTracker := newLivenessTracker(ctx, mainDB)
+
+	p2pcom, err := newP2PComm(ctx, repo, stater, txPool, forkConfig, instanceDir)
 	if err != nil {
 		return err
 	}
 	apiHandler, apiCloser := api.New(
 		repo,
-		state.NewStater(mainDB),
+		stater,
 		txPool,
 		logDB,
 		p2pcom.comm,
+		evidencePool,
+		livenessTracker,
 		ctx.String(apiCorsFlag.Name),
 		uint32(ctx.Int(apiBacktraceLimitFlag.Name)),
 		uint64(ctx.Int(apiCallGasLimitFlag.Name)),
@@ -208,22 +351,33 @@ func defaultAction(ctx *cli.Context) error {
 	}
 	defer p2pcom.Stop()
 
-	if !ctx.Bool(disablePrunerFlag.Name) {
-		pruner := pruner.New(mainDB, repo)
+	if history, err := stateHistory(ctx); err != nil {
+		return err
+	} else if history > 0 {
+		pruner := pruner.New(mainDB, repo, history)
 		defer func() { log.Info("stopping pruner..."); pruner.Stop() }()
 	}
 
 	return node.New(
 		master,
 		repo,
-		state.NewStater(mainDB),
+		stater,
 		logDB,
 		txPool,
+		evidencePool,
+		livenessTracker,
 		filepath.Join(instanceDir, "tx.stash"),
 		p2pcom.comm,
 		uint64(ctx.Int(targetGasLimitFlag.Name)),
+		uint64(ctx.Int(packDeadlineFlag.Name)),
+		uint64(ctx.Int(packGasTargetFlag.Name)),
+		ctx.Int(packWorkersFlag.Name),
+		strategy,
+		priorityList,
 		skipLogs,
-		forkConfig).Run(exitSignal)
+		uint32(ctx.Int(freezeDepthFlag.Name)),
+		forkConfig,
+		rewardPolicy).Run(exitSignal)
 }
 
 func soloAction(ctx *cli.Context) error {
@@ -231,6 +385,9 @@ func soloAction(ctx *cli.Context) error {
 	defer func() { log.Info("exited") }()
 
 	initLogger(ctx)
+	if err := setupDevAccounts(ctx); err != nil {
+		return err
+	}
 	gene := genesis.NewDevnet()
 	// Solo forks from the start
 	forkConfig := thor.ForkConfig{}
@@ -258,13 +415,22 @@ func soloAction(ctx *cli.Context) error {
 		logDB = openMemLogDB()
 	}
 
-	repo, err := initChainRepository(gene, mainDB, logDB)
+	snap, err := openStateSnapshot(ctx, mainDB)
+	if err != nil {
+		return err
+	}
+	repo, err := initChainRepository(gene, mainDB, logDB, snap, "")
 	if err != nil {
 		return err
 	}
 
 	skipLogs := ctx.Bool(skipLogsFlag.Name)
 
+	stater, err := newStater(mainDB, repo, snap)
+	if err != nil {
+		return err
+	}
+
 	if !skipLogs {
 		if err := syncLogDB(exitSignal, repo, logDB, ctx.Bool(verifyLogsFlag.Name)); err != nil {
 			return err
@@ -274,16 +440,20 @@ func soloAction(ctx *cli.Context) error {
 	txPoolOption := defaultTxPoolOptions
 	txPoolOption.Limit = ctx.Int(txPoolLimitFlag.Name)
 	txPoolOption.LimitPerAccount = ctx.Int(txPoolLimitPerAccountFlag.Name)
+	txPoolOption.EstimateGas = true
+	txPoolOption.ForkConfig = forkConfig
 
-	txPool := txpool.New(repo, state.NewStater(mainDB), txPoolOption)
+	txPool := txpool.New(repo, stater, txPoolOption)
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
 
 	apiHandler, apiCloser := api.New(
 		repo,
-		state.NewStater(mainDB),
+		stater,
 		txPool,
 		logDB,
 		solo.Communicator{},
+		nil,
+		nil,
 		ctx.String(apiCorsFlag.Name),
 		uint32(ctx.Int(apiBacktraceLimitFlag.Name)),
 		uint64(ctx.Int(apiCallGasLimitFlag.Name)),
@@ -300,21 +470,187 @@ func soloAction(ctx *cli.Context) error {
 
 	printSoloStartupMessage(gene, repo, instanceDir, apiURL, forkConfig)
 
-	if !ctx.Bool(disablePrunerFlag.Name) {
-		pruner := pruner.New(mainDB, repo)
+	if history, err := stateHistory(ctx); err != nil {
+		return err
+	} else if history > 0 {
+		pruner := pruner.New(mainDB, repo, history)
 		defer func() { log.Info("stopping pruner..."); pruner.Stop() }()
 	}
 
+	strategy, err := packStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
 	return solo.New(repo,
-		state.NewStater(mainDB),
+		stater,
 		logDB,
 		txPool,
 		uint64(ctx.Int(gasLimitFlag.Name)),
+		strategy,
+		ctx.Int(packWorkersFlag.Name),
 		ctx.Bool(onDemandFlag.Name),
+		uint64(ctx.Int(emptyBlockIntervalFlag.Name)),
 		skipLogs,
 		forkConfig).Run(exitSignal)
 }
 
+func dbVerifyAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+
+	initLogger(ctx)
+	gene, mainDB, repo, err := openDBRepository(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer mainDB.Close()
+
+	log.Info("verifying genesis", "id", gene.ID())
+	if err := gene.Verify(mainDB, repo.GenesisBlock().Header()); err != nil {
+		return err
+	}
+
+	best := repo.BestBlock().Header()
+	log.Info("verifying blocks", "best", best.Number(), "id", best.ID())
+	if err := repo.NewBestChain().Verify(exitSignal); err != nil {
+		return err
+	}
+	log.Info("all blocks verified")
+	return nil
+}
+
+func dbExportAction(ctx *cli.Context) error {
+	initLogger(ctx)
+	path := ctx.String(dbFileFlag.Name)
+	if path == "" {
+		return fmt.Errorf("flag %s is required", dbFileFlag.Name)
+	}
+
+	_, mainDB, repo, err := openDBRepository(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer mainDB.Close()
+
+	from, to := uint32(ctx.Int(exportFromFlag.Name)), repo.BestBlock().Header().Number()
+	if v := ctx.Int(exportToFlag.Name); v >= 0 && uint32(v) < to {
+		to = uint32(v)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	log.Info("exporting blocks", "from", from, "to", to)
+	w := bufio.NewWriter(f)
+	if err := repo.Export(w, from, to); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	log.Info("blocks exported", "count", to-from+1, "file", path)
+	return nil
+}
+
+func dbImportAction(ctx *cli.Context) error {
+	initLogger(ctx)
+	path := ctx.String(dbFileFlag.Name)
+	if path == "" {
+		return fmt.Errorf("flag %s is required", dbFileFlag.Name)
+	}
+
+	_, mainDB, repo, err := openDBRepository(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer mainDB.Close()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	log.Info("importing blocks", "file", path)
+	count, err := repo.Import(bufio.NewReader(f))
+	if err != nil {
+		return err
+	}
+	best := repo.BestBlock().Header()
+	log.Info("blocks imported", "count", count, "best", best.Number(), "id", best.ID())
+	return nil
+}
+
+func dbPruneBranchesAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+
+	initLogger(ctx)
+	_, mainDB, repo, err := openDBRepository(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer mainDB.Close()
+
+	bestNum := repo.BestBlock().Header().Number()
+	depth := uint32(ctx.Int(pruneDepthFlag.Name))
+	if bestNum <= depth {
+		log.Info("no block deeper than the depth")
+		return nil
+	}
+
+	log.Info("pruning branches", "before", bestNum-depth)
+	count, err := repo.PruneBranches(exitSignal, bestNum-depth)
+	if err != nil {
+		return err
+	}
+	log.Info("branches pruned", "blocks", count)
+	return nil
+}
+
+func dbReindexAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+
+	initLogger(ctx)
+	_, mainDB, repo, err := openDBRepository(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer mainDB.Close()
+
+	best := repo.BestBlock().Header()
+	log.Info("reindexing blocks", "best", best.Number(), "id", best.ID())
+	startTime := time.Now()
+	if err := repo.ReindexTransactions(exitSignal); err != nil {
+		return err
+	}
+	log.Info("all blocks reindexed", "elapsed", time.Since(startTime).Round(time.Second))
+	return nil
+}
+
+func dbLeavesAction(ctx *cli.Context) error {
+	initLogger(ctx)
+	_, mainDB, repo, err := openDBRepository(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer mainDB.Close()
+
+	branches, err := repo.Leaves()
+	if err != nil {
+		return err
+	}
+	for _, branch := range branches {
+		fmt.Printf("%v\t#%v\t%v\n", branch.HeadID, block.Number(branch.HeadID), branch.Length)
+	}
+	return nil
+}
+
 func masterKeyAction(ctx *cli.Context) error {
 	hasImportFlag := ctx.Bool(importMasterKeyFlag.Name)
 	hasExportFlag := ctx.Bool(exportMasterKeyFlag.Name)
@@ -9,6 +9,7 @@ import (
 	"crypto/ecdsa"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/thor"
 )
 
@@ -20,3 +21,7 @@ type Master struct {
 func (m *Master) Address() thor.Address {
 	return thor.Address(crypto.PubkeyToAddress(m.PrivateKey.PublicKey))
 }
+
+func (m *Master) Sealer() block.Sealer {
+	return block.NewKeySealer(m.PrivateKey)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/vechain/thor/bft"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/cache"
 	"github.com/vechain/thor/chain"
@@ -27,8 +28,12 @@ import (
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/comm"
 	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/liveness"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -40,18 +45,26 @@ var log = log15.New("pkg", "node")
 type Node struct {
 	goes     co.Goes
 	packer   *packer.Packer
+	strategy packer.SelectionStrategy
+	workers  int
 	cons     *consensus.Consensus
 	consLock sync.Mutex
+	bft      *bft.Engine
 
 	master         *Master
 	repo           *chain.Repository
+	stater         *state.Stater
+	forkConfig     thor.ForkConfig
 	logDB          *logdb.LogDB
 	txPool         *txpool.TxPool
+	evidence       *evidence.Pool
+	liveness       *liveness.Tracker
 	txStashPath    string
 	comm           *comm.Communicator
 	commitLock     sync.Mutex
 	targetGasLimit uint64
 	skipLogs       bool
+	freezeDepth    uint32
 	logDBFailed    bool
 	bandwidth      bandwidth.Bandwidth
 }
@@ -62,24 +75,49 @@ func New(
 	stater *state.Stater,
 	logDB *logdb.LogDB,
 	txPool *txpool.TxPool,
+	evidencePool *evidence.Pool,
+	livenessTracker *liveness.Tracker,
 	txStashPath string,
 	comm *comm.Communicator,
 	targetGasLimit uint64,
+	packDeadline uint64,
+	packGasTarget uint64,
+	packWorkers int,
+	strategy packer.SelectionStrategy,
+	priorityOrigins []thor.Address,
 	skipLogs bool,
+	freezeDepth uint32,
 	forkConfig thor.ForkConfig,
+	rewardPolicy runtime.RewardPolicy,
 ) *Node {
-	return &Node{
+	n := &Node{
 		packer:         packer.New(repo, stater, master.Address(), master.Beneficiary, forkConfig),
+		strategy:       strategy,
+		workers:        packWorkers,
 		cons:           consensus.New(repo, stater, forkConfig),
+		bft:            bft.NewEngine(repo, stater, forkConfig),
 		master:         master,
 		repo:           repo,
+		stater:         stater,
+		forkConfig:     forkConfig,
 		logDB:          logDB,
 		txPool:         txPool,
+		evidence:       evidencePool,
+		liveness:       livenessTracker,
 		txStashPath:    txStashPath,
 		comm:           comm,
 		targetGasLimit: targetGasLimit,
 		skipLogs:       skipLogs,
+		freezeDepth:    freezeDepth,
 	}
+	n.packer.SetRewardPolicy(rewardPolicy)
+	n.packer.SetDeadline(packDeadline)
+	n.packer.SetGasTarget(packGasTarget)
+	if len(priorityOrigins) > 0 {
+		n.strategy = packer.Prioritize(n.strategy, priorityOrigins)
+	}
+	n.cons.SetRewardPolicy(rewardPolicy)
+	return n
 }
 
 func (n *Node) Run(ctx context.Context) error {
@@ -88,6 +126,9 @@ func (n *Node) Run(ctx context.Context) error {
 	n.goes.Go(func() { n.houseKeeping(ctx) })
 	n.goes.Go(func() { n.txStashLoop(ctx) })
 	n.goes.Go(func() { n.packerLoop(ctx) })
+	if n.freezeDepth > 0 {
+		n.goes.Go(func() { n.freezeLoop(ctx) })
+	}
 
 	n.goes.Wait()
 	return nil
@@ -138,8 +179,9 @@ func (n *Node) houseKeeping(ctx context.Context) {
 	newBlockCh := make(chan *comm.NewBlockEvent)
 	scope.Track(n.comm.SubscribeBlock(newBlockCh))
 
-	futureTicker := time.NewTicker(time.Duration(thor.BlockInterval) * time.Second)
-	defer futureTicker.Stop()
+	// future blocks are processed once per block interval, which may change by params
+	futureTimer := time.NewTimer(time.Duration(n.blockInterval()) * time.Second)
+	defer futureTimer.Stop()
 
 	connectivityTicker := time.NewTicker(time.Second)
 	defer connectivityTicker.Stop()
@@ -164,7 +206,8 @@ func (n *Node) houseKeeping(ctx context.Context) {
 				n.comm.BroadcastBlock(newBlock.Block)
 				log.Info(fmt.Sprintf("imported blocks (%v)", stats.processed), stats.LogContext(newBlock.Block.Header())...)
 			}
-		case <-futureTicker.C:
+		case <-futureTimer.C:
+			futureTimer.Reset(time.Duration(n.blockInterval()) * time.Second)
 			// process future blocks
 			var blocks []*block.Block
 			futureBlocks.ForEach(func(ent *cache.Entry) bool {
@@ -193,7 +236,7 @@ func (n *Node) houseKeeping(ctx context.Context) {
 				noPeerTimes++
 				if noPeerTimes > 30 {
 					noPeerTimes = 0
-					go checkClockOffset()
+					go checkClockOffset(n.blockInterval())
 				}
 			} else {
 				noPeerTimes = 0
@@ -202,6 +245,38 @@ func (n *Node) houseKeeping(ctx context.Context) {
 	}
 }
 
+// freezeLoop periodically moves blocks deeper than freezeDepth below the best block into the freezer.
+func (n *Node) freezeLoop(ctx context.Context) {
+	log.Debug("enter freeze loop")
+	defer log.Debug("leave freeze loop")
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			bestNum := n.repo.BestBlock().Header().Number()
+			if bestNum <= n.freezeDepth {
+				continue
+			}
+			startTime := mclock.Now()
+			count, err := n.repo.Freeze(ctx, bestNum-n.freezeDepth)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Warn("failed to freeze blocks", "err", err)
+				}
+				continue
+			}
+			if count > 0 {
+				log.Debug("blocks frozen", "count", count, "elapsed", common.PrettyDuration(mclock.Now()-startTime))
+			}
+		}
+	}
+}
+
 func (n *Node) txStashLoop(ctx context.Context) {
 	log.Debug("enter tx stash loop")
 	defer log.Debug("leave tx stash loop")
@@ -270,6 +345,12 @@ func (n *Node) processBlock(blk *block.Block, stats *blockStats) (bool, error) {
 		return false, err
 	}
 
+	if ev, err := n.evidence.Observe(blk.Header()); err != nil {
+		log.Warn("failed to observe block for evidence", "err", err)
+	} else if ev != nil {
+		log.Warn("double signing detected", "signer", ev.Signer, "number", ev.Number)
+	}
+
 	prevTrunk, curTrunk, err := n.commitBlock(stage, blk, receipts)
 	if err != nil {
 		log.Error("failed to commit block", "err", err)
@@ -283,7 +364,26 @@ func (n *Node) processBlock(blk *block.Block, stats *blockStats) (bool, error) {
 
 	stats.UpdateProcessed(1, len(receipts), execElapsed, commitElapsed, blk.Header().GasUsed())
 	n.processFork(prevTrunk, curTrunk)
-	return prevTrunk.HeadID() != curTrunk.HeadID(), nil
+
+	isTrunk := prevTrunk.HeadID() != curTrunk.HeadID()
+	if isTrunk {
+		n.observeLiveness(blk.Header())
+	}
+	return isTrunk, nil
+}
+
+// observeLiveness records proposers who missed slots right before the new best block.
+func (n *Node) observeLiveness(header *block.Header) {
+	n.consLock.Lock()
+	missed, err := n.cons.MissedSlots(header)
+	n.consLock.Unlock()
+	if err != nil {
+		log.Warn("failed to compute missed slots", "err", err)
+		return
+	}
+	if err := n.liveness.Observe(header.Number(), missed); err != nil {
+		log.Warn("failed to observe liveness", "err", err)
+	}
 }
 
 func (n *Node) commitBlock(stage *state.Stage, newBlock *block.Block, receipts tx.Receipts) (*chain.Chain, *chain.Chain, error) {
@@ -334,6 +434,12 @@ func (n *Node) commitBlock(stage *state.Stage, newBlock *block.Block, receipts t
 		if err := n.repo.SetBestBlockID(newBlock.Header().ID()); err != nil {
 			return nil, nil, err
 		}
+		// failure of finality doesn't affect the committed block
+		if finalized, err := n.bft.Update(); err != nil {
+			log.Warn("failed to update finality", "err", err)
+		} else if !finalized.IsZero() {
+			log.Info("checkpoint finalized", "id", finalized)
+		}
 	}
 
 	return n.repo.NewChain(prevBest.Header().ID()), n.repo.NewBestChain(), nil
@@ -391,13 +497,25 @@ side-chain:   %v  %v`,
 	}
 }
 
-func checkClockOffset() {
+// blockInterval returns the interval of the block next to the best block.
+// It falls back to thor.BlockInterval if failed to read the state.
+func (n *Node) blockInterval() uint64 {
+	best := n.repo.BestBlock().Header()
+	interval, err := poa.BlockIntervalOf(n.stater.NewState(best.StateRoot()), n.forkConfig, best.Number()+1)
+	if err != nil {
+		log.Warn("failed to get block interval", "err", err)
+		return thor.BlockInterval
+	}
+	return interval
+}
+
+func checkClockOffset(blockInterval uint64) {
 	resp, err := ntp.Query("pool.ntp.org")
 	if err != nil {
 		log.Debug("failed to access NTP", "err", err)
 		return
 	}
-	if resp.ClockOffset > time.Duration(thor.BlockInterval)*time.Second/2 {
+	if resp.ClockOffset > time.Duration(blockInterval)*time.Second/2 {
 		log.Warn("clock offset detected", "offset", common.PrettyDuration(resp.ClockOffset))
 	}
 }
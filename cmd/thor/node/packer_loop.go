@@ -18,6 +18,10 @@ import (
 	"github.com/vechain/thor/tx"
 )
 
+// sealMargin is the time reserved before the block time, for sealing, committing and broadcasting
+// the packed block, so extending the block with late txs stops earlier.
+const sealMargin = 2 * time.Second
+
 func (n *Node) packerLoop(ctx context.Context) {
 	log.Debug("enter packer loop")
 	defer log.Debug("leave packer loop")
@@ -67,10 +71,10 @@ func (n *Node) packerLoop(ctx context.Context) {
 		log.Debug("scheduled to pack block", "after", time.Duration(flow.When()-now)*time.Second)
 
 		for {
-			if uint64(time.Now().Unix())+thor.BlockInterval/2 > flow.When() {
+			if uint64(time.Now().Unix())+flow.Interval()/2 > flow.When() {
 				// time to pack block
 				// blockInterval/2 early to allow more time for processing txs
-				if err := n.pack(flow); err != nil {
+				if err := n.pack(ctx, flow); err != nil {
 					log.Error("failed to pack block", "err", err)
 				}
 				break
@@ -99,39 +103,87 @@ func (n *Node) packerLoop(ctx context.Context) {
 	}
 }
 
-func (n *Node) pack(flow *packer.Flow) error {
-	txs := n.txPool.Executables()
-	var txsToRemove []*tx.Transaction
+func (n *Node) pack(ctx context.Context, flow *packer.Flow) error {
+	var (
+		seen        = make(map[thor.Bytes32]bool)
+		txsToRemove tx.Transactions
+		execElapsed mclock.AbsTime // excludes time waiting for txs
+	)
+	// fills txs not seen before. A tx is seen once adopted or rejected, so that
+	// txs not adoptable now are retried in later fills.
+	fill := func() {
+		var txs tx.Transactions
+		for _, tx := range n.txPool.Executables() {
+			if !seen[tx.ID()] {
+				txs = append(txs, tx)
+			}
+		}
+		if len(txs) > 0 {
+			startTime := mclock.Now()
+			rejected := flow.FillParallel(packer.NewCandidates(txs, n.txPool), n.strategy, n.workers)
+			execElapsed += mclock.Now() - startTime
+
+			for _, tx := range rejected {
+				seen[tx.ID()] = true
+			}
+			for _, tx := range txs {
+				if flow.Adopted(tx.ID()) {
+					seen[tx.ID()] = true
+				}
+			}
+			txsToRemove = append(txsToRemove, rejected...)
+		}
+	}
 	defer func() {
 		for _, tx := range txsToRemove {
 			n.txPool.Remove(tx.Hash(), tx.ID())
 		}
 	}()
 
-	startTime := mclock.Now()
-	for _, tx := range txs {
-		if err := flow.Adopt(tx); err != nil {
-			if packer.IsGasLimitReached(err) {
-				break
-			}
-			if packer.IsTxNotAdoptableNow(err) {
-				continue
-			}
-			txsToRemove = append(txsToRemove, tx)
+	ticker := n.repo.NewTicker()
+	fill()
+
+	// keep extending the block with txs arriving later, until the fill deadline,
+	// or the block time minus the seal margin
+	sealTime := time.Unix(int64(flow.When()), 0).Add(-sealMargin)
+	if deadline := flow.Deadline(); !deadline.IsZero() && deadline.Before(sealTime) {
+		sealTime = deadline
+	}
+	for flow.Extendable() {
+		wait := time.Until(sealTime)
+		if wait <= 0 {
+			break
+		}
+		if wait > time.Second {
+			wait = time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		case <-time.After(wait):
+			fill()
+		}
+		// the block being packed is outdated
+		if n.repo.BestBlock().Header().ID() != flow.ParentHeader().ID() {
+			log.Debug("abort packing due to new best block")
+			return nil
 		}
 	}
 
-	newBlock, stage, receipts, err := flow.Pack(n.master.PrivateKey)
+	startTime := mclock.Now()
+	newBlock, stage, receipts, err := flow.PackWithSealer(n.master.Sealer())
 	if err != nil {
 		return err
 	}
-	execElapsed := mclock.Now() - startTime
+	execElapsed += mclock.Now() - startTime
+	startTime = mclock.Now()
 
 	prevTrunk, curTrunk, err := n.commitBlock(stage, newBlock, receipts)
 	if err != nil {
 		return errors.WithMessage(err, "commit block")
 	}
-	commitElapsed := mclock.Now() - startTime - execElapsed
+	commitElapsed := mclock.Now() - startTime
 
 	n.processFork(prevTrunk, curTrunk)
 
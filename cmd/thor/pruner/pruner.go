@@ -33,22 +33,28 @@ const (
 )
 
 // Pruner is the state pruner.
+//
+// It keeps state of the recent history blocks, and state of blocks at the end of each pruning cycle
+// as checkpoints.
 type Pruner struct {
-	db     *muxdb.MuxDB
-	repo   *chain.Repository
-	ctx    context.Context
-	cancel func()
-	goes   co.Goes
+	db      *muxdb.MuxDB
+	repo    *chain.Repository
+	history uint32
+	ctx     context.Context
+	cancel  func()
+	goes    co.Goes
 }
 
-// New creates and starts a state pruner.
-func New(db *muxdb.MuxDB, repo *chain.Repository) *Pruner {
+// New creates and starts a state pruner, which keeps state of at least recent history blocks.
+// The history should not be less than thor.MaxStateHistory.
+func New(db *muxdb.MuxDB, repo *chain.Repository, history uint32) *Pruner {
 	ctx, cancel := context.WithCancel(context.Background())
 	p := &Pruner{
-		db:     db,
-		repo:   repo,
-		ctx:    ctx,
-		cancel: cancel,
+		db:      db,
+		repo:    repo,
+		history: history,
+		ctx:     ctx,
+		cancel:  cancel,
 	}
 	p.goes.Go(func() {
 		if err := p.loop(); err != nil {
@@ -114,8 +120,8 @@ func (p *Pruner) loop() error {
 			status.N1 = status.N2
 			status.N2 = bestNum() + 10
 			// not necessary to prune if n2 is too small
-			if status.N2 < thor.MaxStateHistory {
-				status.N2 = thor.MaxStateHistory
+			if status.N2 < p.history {
+				status.N2 = p.history
 			}
 			if err := waitUntil(status.N2); err != nil {
 				return err
@@ -141,7 +147,7 @@ func (p *Pruner) loop() error {
 				"storageNodes", sNodeCount, "storageEntries", sEntryCount)
 			status.Step = stepDropStale
 		case stepDropStale:
-			if err := waitUntil(status.N2 + thor.MaxStateHistory + 128); err != nil {
+			if err := waitUntil(status.N2 + p.history + 128); err != nil {
 				return err
 			}
 			log.Info("sweeping stale nodes...")
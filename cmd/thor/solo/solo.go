@@ -34,6 +34,8 @@ type Solo struct {
 	repo        *chain.Repository
 	txPool      *txpool.TxPool
 	packer      *packer.Packer
+	strategy    packer.SelectionStrategy
+	workers     int
 	logDB       *logdb.LogDB
 	gasLimit    uint64
 	bandwidth   bandwidth.Bandwidth
@@ -48,11 +50,14 @@ func New(
 	logDB *logdb.LogDB,
 	txPool *txpool.TxPool,
 	gasLimit uint64,
+	strategy packer.SelectionStrategy,
+	workers int,
 	onDemand bool,
+	emptyBlockInterval uint64,
 	skipLogs bool,
 	forkConfig thor.ForkConfig,
 ) *Solo {
-	return &Solo{
+	s := &Solo{
 		repo:   repo,
 		txPool: txPool,
 		packer: packer.New(
@@ -61,11 +66,15 @@ func New(
 			genesis.DevAccounts()[0].Address,
 			&genesis.DevAccounts()[0].Address,
 			forkConfig),
+		strategy: strategy,
+		workers:  workers,
 		logDB:    logDB,
 		gasLimit: gasLimit,
 		skipLogs: skipLogs,
 		onDemand: onDemand,
 	}
+	s.packer.SetEmptyBlockInterval(emptyBlockInterval)
+	return s
 }
 
 // Run runs the packer for solo
@@ -131,16 +140,11 @@ func (s *Solo) packing(pendingTxs tx.Transactions, onDemand bool) error {
 	}
 
 	startTime := mclock.Now()
-	for _, tx := range pendingTxs {
-		if err := flow.Adopt(tx); err != nil {
-			if packer.IsGasLimitReached(err) {
-				break
-			}
-			if packer.IsTxNotAdoptableNow(err) {
-				continue
-			}
-			txsToRemove = append(txsToRemove, tx)
-		}
+	txsToRemove = flow.FillParallel(packer.NewCandidates(pendingTxs, s.txPool), s.strategy, s.workers)
+
+	if flow.Suppressed() {
+		log.Debug("empty block suppressed", "parent", fmt.Sprintf("[#%v…%x]", best.Header().Number(), best.Header().ID().Bytes()[28:]))
+		return nil
 	}
 
 	b, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
@@ -6,8 +6,10 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -16,6 +18,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/exec"
 	"os/signal"
 	"os/user"
 	"path/filepath"
@@ -41,10 +44,14 @@ import (
 	"github.com/vechain/thor/cmd/thor/node"
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/comm"
+	"github.com/vechain/thor/evidence"
 	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/liveness"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/p2psrv"
+	"github.com/vechain/thor/packer"
+	thorruntime "github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -190,44 +197,43 @@ func readPasswordFromNewTTY(prompt string) (string, error) {
 	return pass, err
 }
 
-func selectGenesis(ctx *cli.Context) (*genesis.Genesis, thor.ForkConfig, error) {
+func selectGenesis(ctx *cli.Context) (*genesis.Genesis, thor.ForkConfig, thorruntime.RewardPolicy, error) {
 	network := ctx.String(networkFlag.Name)
 	if network == "" {
 		_ = cli.ShowAppHelp(ctx)
-		return nil, thor.ForkConfig{}, errors.New("network flag not specified")
+		return nil, thor.ForkConfig{}, nil, errors.New("network flag not specified")
 	}
 
 	switch network {
 	case "test":
 		gene := genesis.NewTestnet()
-		return gene, thor.GetForkConfig(gene.ID()), nil
+		return gene, thor.GetForkConfig(gene.ID()), thorruntime.DefaultRewardPolicy, nil
 	case "main":
 		gene := genesis.NewMainnet()
-		return gene, thor.GetForkConfig(gene.ID()), nil
+		return gene, thor.GetForkConfig(gene.ID()), thorruntime.DefaultRewardPolicy, nil
 	default:
 		file, err := os.Open(network)
 		if err != nil {
-			return nil, thor.ForkConfig{}, errors.Wrap(err, "open genesis file")
+			return nil, thor.ForkConfig{}, nil, errors.Wrap(err, "open genesis file")
 		}
 		defer file.Close()
 
-		decoder := json.NewDecoder(file)
-		decoder.DisallowUnknownFields()
-
-		var forkConfig = thor.NoFork
-		var gen genesis.CustomGenesis
-		gen.ForkConfig = &forkConfig
+		gen, err := genesis.DecodeCustomGenesis(file)
+		if err != nil {
+			return nil, thor.ForkConfig{}, nil, errors.Wrap(err, "decode genesis file")
+		}
 
-		if err := decoder.Decode(&gen); err != nil {
-			return nil, thor.ForkConfig{}, errors.Wrap(err, "decode genesis file")
+		customGen, err := genesis.NewCustomNet(gen)
+		if err != nil {
+			return nil, thor.ForkConfig{}, nil, errors.Wrap(err, "build genesis")
 		}
 
-		customGen, err := genesis.NewCustomNet(&gen)
+		rewardPolicy, err := gen.RewardPolicy()
 		if err != nil {
-			return nil, thor.ForkConfig{}, errors.Wrap(err, "build genesis")
+			return nil, thor.ForkConfig{}, nil, errors.Wrap(err, "reward policy")
 		}
 
-		return customGen, forkConfig, nil
+		return customGen, *gen.ForkConfig, rewardPolicy, nil
 	}
 }
 
@@ -260,13 +266,20 @@ func makeInstanceDir(ctx *cli.Context, gene *genesis.Genesis) (string, error) {
 	return instanceDir, nil
 }
 
+// decodedTrieNodesPerCacheMB is the capacity of decoded trie node cache per MB of the cache size.
+// It makes the default 2048MB cache hold 65536 decoded nodes.
+const decodedTrieNodesPerCacheMB = 32
+
 func openMainDB(ctx *cli.Context, dir string) (*muxdb.MuxDB, error) {
 	cacheMB := normalizeCacheSize(ctx.Int(cacheFlag.Name))
 	log.Debug("cache size(MB)", "size", cacheMB)
 
 	// go-ethereum stuff
 	// Ensure Go's GC ignores the database cache for trigger percentage
-	gogc := math.Max(10, math.Min(100, 50/(float64(cacheMB)/1024)))
+	gogc := 100.0
+	if cacheMB > 0 {
+		gogc = math.Max(10, math.Min(100, 50/(float64(cacheMB)/1024)))
+	}
 
 	log.Debug("sanitize Go's GC trigger", "percent", int(gogc))
 	debug.SetGCPercent(int(gogc))
@@ -274,13 +287,25 @@ func openMainDB(ctx *cli.Context, dir string) (*muxdb.MuxDB, error) {
 	fdCache := suggestFDCache()
 	log.Debug("fd cache", "n", fdCache)
 
+	encryptionKey, err := loadDBEncryptionKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	engine := ctx.String(dbEngineFlag.Name)
 	path := filepath.Join(dir, "main.db")
+	if engine != muxdb.EngineLevelDB {
+		// keep away from leveldb files
+		path = filepath.Join(dir, "main."+engine)
+	}
 	db, err := muxdb.Open(path, &muxdb.Options{
+		Engine:                       engine,
 		EncodedTrieNodeCacheSizeMB:   cacheMB,
-		DecodedTrieNodeCacheCapacity: 65536,
+		DecodedTrieNodeCacheCapacity: cacheMB * decodedTrieNodesPerCacheMB,
 		OpenFilesCacheCapacity:       fdCache,
 		ReadCacheMB:                  256, // rely on os page cache other than huge db read cache.
 		WriteBufferMB:                128,
+		EncryptionKey:                encryptionKey,
 		PermanentTrie:                ctx.Bool(disablePrunerFlag.Name),
 	})
 	if err != nil {
@@ -289,9 +314,133 @@ func openMainDB(ctx *cli.Context, dir string) (*muxdb.MuxDB, error) {
 	return db, nil
 }
 
+// packStrategy returns the tx selection strategy specified by flag.
+func packStrategy(ctx *cli.Context) (packer.SelectionStrategy, error) {
+	name := ctx.String(packStrategyFlag.Name)
+	strategy, ok := packer.SelectionStrategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported pack strategy %q", name)
+	}
+	return strategy, nil
+}
+
+// loadPriorityList loads addresses, whose txs are packed first, from the file specified by flag, or
+// the one in the instance dir if not specified. It's a list of hex addresses, one per line,
+// and lines starting with '#' are ignored.
+func loadPriorityList(ctx *cli.Context, instanceDir string) ([]thor.Address, error) {
+	path := ctx.String(priorityListFlag.Name)
+	if path == "" {
+		path = filepath.Join(instanceDir, "priority.list")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil, nil
+		}
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read priority list")
+	}
+
+	var list []thor.Address
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addr, err := thor.ParseAddress(line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse priority list [%v]", line)
+		}
+		list = append(list, addr)
+	}
+	return list, nil
+}
+
+// loadDBEncryptionKey loads hex encoded key from the file specified by flag.
+func loadDBEncryptionKey(ctx *cli.Context) ([]byte, error) {
+	path := ctx.String(dbEncryptionKeyFileFlag.Name)
+	if path == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read db encryption key")
+	}
+	key, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "decode db encryption key")
+	}
+	return key, nil
+}
+
+// newEvidencePool creates the evidence pool, with the slashing hook if specified.
+func newEvidencePool(ctx *cli.Context, db *muxdb.MuxDB) *evidence.Pool {
+	var hook evidence.Hook
+	if path := ctx.String(slashingHookFlag.Name); path != "" {
+		run := execHook(path)
+		hook = func(ev *evidence.DoubleSign) { run(ev) }
+	}
+	return evidence.New(db, hook)
+}
+
+// newLivenessTracker creates the liveness tracker, with the liveness hook if specified.
+func newLivenessTracker(ctx *cli.Context, db *muxdb.MuxDB) *liveness.Tracker {
+	var hook liveness.Hook
+	if path := ctx.String(livenessHookFlag.Name); path != "" {
+		run := execHook(path)
+		hook = func(r *liveness.Report) { run(r) }
+	}
+	return liveness.New(db, liveness.DefaultWindow, uint32(ctx.Int(livenessThresholdFlag.Name)), hook)
+}
+
+// execHook returns a hook which runs the executable at path, with the value in JSON as stdin.
+// The executable runs in background, to not block the caller.
+func execHook(path string) func(interface{}) {
+	return func(v interface{}) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			log.Warn("failed to encode hook input", "hook", path, "err", err)
+			return
+		}
+		go func() {
+			cmd := exec.Command(path)
+			cmd.Stdin = bytes.NewReader(data)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				log.Warn("hook failed", "hook", path, "err", err, "output", string(out))
+			}
+		}()
+	}
+}
+
+// state retention modes
+const (
+	stateRetentionArchive = "archive"
+	stateRetentionFull    = "full"
+)
+
+// stateHistory returns count of recent blocks whose state should be kept by the pruner.
+// Zero means pruning is disabled.
+func stateHistory(ctx *cli.Context) (uint32, error) {
+	if ctx.Bool(disablePrunerFlag.Name) {
+		return 0, nil
+	}
+	switch mode := ctx.String(stateRetentionFlag.Name); mode {
+	case stateRetentionArchive:
+		return 0, nil
+	case stateRetentionFull:
+		history := ctx.Int(stateHistoryFlag.Name)
+		// the builtin prototype contract accesses historical state within thor.MaxStateHistory blocks
+		if history < thor.MaxStateHistory || history > math.MaxUint32/2 {
+			return 0, fmt.Errorf("invalid state history %v, should be in [%v, %v]", history, thor.MaxStateHistory, math.MaxUint32/2)
+		}
+		return uint32(history), nil
+	default:
+		return 0, fmt.Errorf("unsupported state retention mode %q", mode)
+	}
+}
+
 func normalizeCacheSize(sizeMB int) int {
-	if sizeMB < 128 {
-		sizeMB = 128
+	if sizeMB < 0 {
+		sizeMB = 0
 	}
 
 	var mem gosigar.Mem
@@ -341,13 +490,48 @@ func openLogDB(ctx *cli.Context, dir string) (*logdb.LogDB, error) {
 	return db, nil
 }
 
-func initChainRepository(gene *genesis.Genesis, mainDB *muxdb.MuxDB, logDB *logdb.LogDB) (*chain.Repository, error) {
+// openDBRepository opens the main db and the chain repository in it, for db management commands.
+// The genesis block is built in memory, to not write genesis state into main db.
+func openDBRepository(ctx *cli.Context, readOnly bool) (*genesis.Genesis, *muxdb.MuxDB, *chain.Repository, error) {
+	gene, _, _, err := selectGenesis(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	instanceDir, err := makeInstanceDir(ctx, gene)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	genesisBlock, _, _, err := gene.Build(state.NewStater(muxdb.NewMem()))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "build genesis block")
+	}
+
+	mainDB, err := openMainDB(ctx, instanceDir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	options := chain.DefaultOptions
+	options.ReadOnly = readOnly
+	repo, err := chain.NewRepositoryWithOptions(mainDB, genesisBlock, options)
+	if err != nil {
+		mainDB.Close()
+		return nil, nil, nil, errors.Wrap(err, "initialize block chain")
+	}
+	return gene, mainDB, repo, nil
+}
+
+// initChainRepository initializes the repository, which moves the state snapshot along the best block if not nil.
+// Old blocks can be frozen into freezerDir if not empty.
+func initChainRepository(gene *genesis.Genesis, mainDB *muxdb.MuxDB, logDB *logdb.LogDB, snap *state.Snapshot, freezerDir string) (*chain.Repository, error) {
 	genesisBlock, genesisEvents, genesisTransfers, err := gene.Build(state.NewStater(mainDB))
 	if err != nil {
 		return nil, errors.Wrap(err, "build genesis block")
 	}
 
-	repo, err := chain.NewRepository(mainDB, genesisBlock)
+	options := chain.DefaultOptions
+	options.StateSnapshot = snap
+	options.FreezerDir = freezerDir
+	repo, err := chain.NewRepositoryWithOptions(mainDB, genesisBlock, options)
 	if err != nil {
 		return nil, errors.Wrap(err, "initialize block chain")
 	}
@@ -406,7 +590,7 @@ type p2pComm struct {
 	enode          string
 }
 
-func newP2PComm(ctx *cli.Context, repo *chain.Repository, txPool *txpool.TxPool, instanceDir string) (*p2pComm, error) {
+func newP2PComm(ctx *cli.Context, repo *chain.Repository, stater *state.Stater, txPool *txpool.TxPool, forkConfig thor.ForkConfig, instanceDir string) (*p2pComm, error) {
 	configDir, err := makeConfigDir(ctx)
 	if err != nil {
 		return nil, err
@@ -456,7 +640,7 @@ func newP2PComm(ctx *cli.Context, repo *chain.Repository, txPool *txpool.TxPool,
 	}
 
 	return &p2pComm{
-		comm:           comm.New(repo, txPool),
+		comm:           comm.New(repo, stater, txPool, forkConfig),
 		p2pSrv:         p2psrv.New(opts),
 		peersCachePath: peersCachePath,
 		enode:          fmt.Sprintf("enode://%x@[extip]:%v", discover.PubkeyID(&key.PublicKey).Bytes(), ctx.Int(p2pPortFlag.Name)),
@@ -557,6 +741,35 @@ func printStartupMessage2(
 		nodeID)
 }
 
+// openStateSnapshot opens the flat state snapshot if enabled, otherwise returns nil.
+func openStateSnapshot(ctx *cli.Context, mainDB *muxdb.MuxDB) (*state.Snapshot, error) {
+	if !ctx.Bool(stateSnapshotFlag.Name) {
+		return nil, nil
+	}
+	snap, err := state.OpenSnapshot(mainDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "open state snapshot")
+	}
+	return snap, nil
+}
+
+// newStater creates the stater, with the flat state snapshot if not nil.
+// The snapshot is rebuilt if it's not at the state of the best block, e.g. it failed to follow a deep reorg.
+func newStater(mainDB *muxdb.MuxDB, repo *chain.Repository, snap *state.Snapshot) (*state.Stater, error) {
+	if snap == nil {
+		return state.NewStater(mainDB), nil
+	}
+	bestStateRoot := repo.BestBlock().Header().StateRoot()
+	if root, ok := snap.Root(); !ok || root != bestStateRoot {
+		log.Info("rebuilding state snapshot...")
+		if err := snap.Rebuild(mainDB, bestStateRoot); err != nil {
+			return nil, errors.Wrap(err, "rebuild state snapshot")
+		}
+		log.Info("state snapshot rebuilt")
+	}
+	return state.NewStaterWithSnapshot(mainDB, snap), nil
+}
+
 func openMemMainDB() *muxdb.MuxDB {
 	return muxdb.NewMem()
 }
@@ -613,6 +826,30 @@ func printSoloStartupMessage(
 	fmt.Print(info)
 }
 
+func setupDevAccounts(ctx *cli.Context) error {
+	mnemonic := ctx.String(devMnemonicFlag.Name)
+	count := ctx.Int(devAccountsFlag.Name)
+	if count <= 0 {
+		return fmt.Errorf("flag %s should be positive", devAccountsFlag.Name)
+	}
+
+	if mnemonic == "" {
+		accs := genesis.DevAccounts()
+		if count > len(accs) {
+			return fmt.Errorf("at most %v built-in dev accounts, use -%s to derive more", len(accs), devMnemonicFlag.Name)
+		}
+		genesis.SetDevAccounts(accs[:count])
+		return nil
+	}
+
+	accs, err := genesis.DeriveDevAccounts(mnemonic, count)
+	if err != nil {
+		return errors.Wrap(err, "derive dev accounts")
+	}
+	genesis.SetDevAccounts(accs)
+	return nil
+}
+
 func parseBootNode(ctx *cli.Context) []*discover.Node {
 	s := strings.TrimSpace(ctx.String(bootNodeFlag.Name))
 	if s == "" {
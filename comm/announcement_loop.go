@@ -7,8 +7,6 @@ package comm
 
 import (
 	"github.com/ethereum/go-ethereum/p2p/discover"
-	"github.com/ethereum/go-ethereum/rlp"
-	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/comm/proto"
 	"github.com/vechain/thor/thor"
 )
@@ -59,11 +57,9 @@ func (c *Communicator) announcementLoop() {
 }
 
 func (c *Communicator) fetchBlockByID(peer *Peer, newBlockID thor.Bytes32) {
-	if _, err := c.repo.GetBlockSummary(newBlockID); err != nil {
-		if !c.repo.IsNotFound(err) {
-			peer.logger.Error("failed to get block header", "err", err)
-		}
-	} else {
+	if has, err := c.repo.HasBlock(newBlockID); err != nil {
+		peer.logger.Error("failed to check block existence", "err", err)
+	} else if has {
 		// already in chain
 		return
 	}
@@ -78,13 +74,13 @@ func (c *Communicator) fetchBlockByID(peer *Peer, newBlockID thor.Bytes32) {
 		return
 	}
 
-	var blk block.Block
-	if err := rlp.DecodeBytes(result, &blk); err != nil {
+	blk, err := proto.DecodeBlock(peer.Version(), result)
+	if err != nil {
 		peer.logger.Debug("failed to decode block got by id", "err", err)
 		return
 	}
 
 	c.newBlockFeed.Send(&NewBlockEvent{
-		Block: &blk,
+		Block: blk,
 	})
 }
@@ -4,3 +4,36 @@
 // file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
 
 package comm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/comm"
+	"github.com/vechain/thor/comm/proto"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
+)
+
+func TestProtocols(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+	pool := txpool.New(repo, stater, txpool.Options{Limit: 100, LimitPerAccount: 16})
+	defer pool.Close()
+
+	protocols := comm.New(repo, stater, pool, thor.NoFork).Protocols()
+	// all supported versions are offered, and peers pick the highest shared one
+	assert.Equal(t, int(proto.Version-proto.MinVersion+1), len(protocols))
+	for i, p := range protocols {
+		assert.Equal(t, proto.Name, p.Name)
+		assert.Equal(t, proto.MinVersion+uint(i), p.Version)
+		assert.NotNil(t, p.Run)
+		assert.Equal(t, protocols[0].DiscTopic, p.DiscTopic)
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/comm/proto"
 	"github.com/vechain/thor/p2psrv"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
@@ -31,6 +33,7 @@ var log = log15.New("pkg", "comm")
 // Communicator communicates with remote p2p peers to exchange blocks and txs, etc.
 type Communicator struct {
 	repo           *chain.Repository
+	stater         *state.Stater
 	txPool         *txpool.TxPool
 	ctx            context.Context
 	cancel         context.CancelFunc
@@ -41,14 +44,17 @@ type Communicator struct {
 	feedScope      event.SubscriptionScope
 	goes           co.Goes
 	onceSynced     sync.Once
+	forkConfig     thor.ForkConfig
 }
 
 // New create a new Communicator instance.
-func New(repo *chain.Repository, txPool *txpool.TxPool) *Communicator {
+func New(repo *chain.Repository, stater *state.Stater, txPool *txpool.TxPool, forkConfig thor.ForkConfig) *Communicator {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Communicator{
 		repo:           repo,
+		stater:         stater,
 		txPool:         txPool,
+		forkConfig:     forkConfig,
 		ctx:            ctx,
 		cancel:         cancel,
 		peerSet:        newPeerSet(),
@@ -76,7 +82,7 @@ func (c *Communicator) Sync(handler HandleBlockStream) {
 		shouldSynced := func() bool {
 			bestBlockTime := c.repo.BestBlock().Header().Timestamp()
 			now := uint64(time.Now().Unix())
-			if bestBlockTime+thor.BlockInterval >= now {
+			if bestBlockTime+c.blockInterval() >= now {
 				return true
 			}
 			if syncCount > 2 {
@@ -128,18 +134,28 @@ func (c *Communicator) Sync(handler HandleBlockStream) {
 }
 
 // Protocols returns all supported protocols.
+// Each supported version is a protocol, and the highest version shared with the peer is run.
 func (c *Communicator) Protocols() []*p2psrv.Protocol {
 	genesisID := c.repo.GenesisBlock().Header().ID()
-	return []*p2psrv.Protocol{
-		&p2psrv.Protocol{
+	// versions share the topic, to discover peers of any version
+	discTopic := fmt.Sprintf("%v%v@%x", proto.Name, proto.MinVersion, genesisID[24:])
+
+	var protocols []*p2psrv.Protocol
+	for ver := proto.MinVersion; ver <= proto.Version; ver++ {
+		ver := ver
+		protocols = append(protocols, &p2psrv.Protocol{
 			Protocol: p2p.Protocol{
 				Name:    proto.Name,
-				Version: proto.Version,
+				Version: ver,
 				Length:  proto.Length,
-				Run:     c.servePeer,
+				Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+					return c.servePeer(p, rw, ver)
+				},
 			},
-			DiscTopic: fmt.Sprintf("%v%v@%x", proto.Name, proto.Version, genesisID[24:]),
-		}}
+			DiscTopic: discTopic,
+		})
+	}
+	return protocols
 }
 
 // Start start the communicator.
@@ -160,8 +176,8 @@ type txsToSync struct {
 	synced bool
 }
 
-func (c *Communicator) servePeer(p *p2p.Peer, rw p2p.MsgReadWriter) error {
-	peer := newPeer(p, rw)
+func (c *Communicator) servePeer(p *p2p.Peer, rw p2p.MsgReadWriter, version uint) error {
+	peer := newPeer(p, rw, version)
 	c.goes.Go(func() {
 		c.runPeer(peer)
 	})
@@ -196,7 +212,7 @@ func (c *Communicator) runPeer(peer *Peer) {
 	if localClock < remoteClock {
 		diff = remoteClock - localClock
 	}
-	if diff > thor.BlockInterval*2 {
+	if diff > c.blockInterval()*2 {
 		peer.logger.Debug("failed to handshake", "err", "sys time diff too large")
 		return
 	}
@@ -284,3 +300,15 @@ func (c *Communicator) PeersStats() []*PeerStats {
 	})
 	return stats
 }
+
+// blockInterval returns the interval of the block next to the best block.
+// It falls back to thor.BlockInterval if failed to read the state.
+func (c *Communicator) blockInterval() uint64 {
+	best := c.repo.BestBlock().Header()
+	interval, err := poa.BlockIntervalOf(c.stater.NewState(best.StateRoot()), c.forkConfig, best.Number()+1)
+	if err != nil {
+		log.Warn("failed to get block interval", "err", err)
+		return thor.BlockInterval
+	}
+	return interval
+}
@@ -12,11 +12,9 @@ import (
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
-	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/comm/proto"
 	"github.com/vechain/thor/metric"
 	"github.com/vechain/thor/thor"
-	"github.com/vechain/thor/tx"
 )
 
 // peer will be disconnected if error returned
@@ -44,10 +42,14 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 			BestBlockID:    best.ID(),
 		})
 	case proto.MsgNewBlock:
-		var newBlock *block.Block
-		if err := msg.Decode(&newBlock); err != nil {
+		var raw rlp.RawValue
+		if err := msg.Decode(&raw); err != nil {
 			return errors.WithMessage(err, "decode msg")
 		}
+		newBlock, err := proto.DecodeBlock(peer.Version(), raw)
+		if err != nil {
+			return errors.WithMessage(err, "decode block")
+		}
 
 		peer.MarkBlock(newBlock.Header().ID())
 		peer.UpdateHead(newBlock.Header().ID(), newBlock.Header().TotalScore())
@@ -65,10 +67,14 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 		}
 		write(&struct{}{})
 	case proto.MsgNewTx:
-		var newTx *tx.Transaction
-		if err := msg.Decode(&newTx); err != nil {
+		var raw rlp.RawValue
+		if err := msg.Decode(&raw); err != nil {
 			return errors.WithMessage(err, "decode msg")
 		}
+		newTx, err := proto.DecodeTx(peer.Version(), raw)
+		if err != nil {
+			return errors.WithMessage(err, "decode tx")
+		}
 		peer.MarkTransaction(newTx.Hash())
 		_ = c.txPool.Add(newTx)
 		write(&struct{}{})
@@ -83,9 +89,10 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 			if !c.repo.IsNotFound(err) {
 				log.Error("failed to get block", "err", err)
 			}
+		} else if raw, err := proto.EncodeBlock(peer.Version(), b); err != nil {
+			log.Error("failed to encode block", "err", err)
 		} else {
-			raw, _ := rlp.EncodeToBytes(b)
-			result = append(result, rlp.RawValue(raw))
+			result = append(result, raw)
 		}
 		write(result)
 	case proto.MsgGetBlockIDByNumber:
@@ -122,8 +129,12 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 				}
 				break
 			}
-			raw, _ := rlp.EncodeToBytes(b)
-			result = append(result, rlp.RawValue(raw))
+			raw, err := proto.EncodeBlock(peer.Version(), b)
+			if err != nil {
+				log.Error("failed to encode block", "err", err)
+				break
+			}
+			result = append(result, raw)
 			num++
 			size += metric.StorageSize(len(raw))
 		}
@@ -135,14 +146,14 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 		}
 
 		if txsToSync.synced {
-			write(tx.Transactions(nil))
+			write([]rlp.RawValue(nil))
 		} else {
 			if len(txsToSync.txs) == 0 {
 				txsToSync.txs = c.txPool.Executables()
 			}
 
 			var (
-				toSend tx.Transactions
+				toSend []rlp.RawValue
 				size   metric.StorageSize
 				n      int
 			)
@@ -152,8 +163,13 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 				if peer.IsTransactionKnown(tx.Hash()) {
 					continue
 				}
+				raw, err := proto.EncodeTx(peer.Version(), tx)
+				if err != nil {
+					log.Debug("failed to encode tx", "err", err)
+					continue
+				}
 				peer.MarkTransaction(tx.Hash())
-				toSend = append(toSend, tx)
+				toSend = append(toSend, raw)
 				size += tx.Size()
 				if size >= maxTxSyncSize {
 					break
@@ -32,7 +32,8 @@ func init() {
 type Peer struct {
 	*p2p.Peer
 	*rpc.RPC
-	logger log15.Logger
+	logger  log15.Logger
+	version uint
 
 	createdTime mclock.AbsTime
 	knownTxs    *lru.Cache
@@ -44,7 +45,7 @@ type Peer struct {
 	}
 }
 
-func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter, version uint) *Peer {
 	dir := "outbound"
 	if peer.Inbound() {
 		dir = "inbound"
@@ -52,6 +53,7 @@ func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
 	ctx := []interface{}{
 		"peer", peer,
 		"dir", dir,
+		"ver", version,
 	}
 	knownTxs, _ := lru.New(maxKnownTxs)
 	knownBlocks, _ := lru.New(maxKnownBlocks)
@@ -59,12 +61,18 @@ func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
 		Peer:        peer,
 		RPC:         rpc.New(peer, rw),
 		logger:      log.New(ctx...),
+		version:     version,
 		createdTime: mclock.Now(),
 		knownTxs:    knownTxs,
 		knownBlocks: knownBlocks,
 	}
 }
 
+// Version returns the protocol version negotiated with the peer.
+func (p *Peer) Version() uint {
+	return p.version
+}
+
 // Head returns head block ID and total score.
 func (p *Peer) Head() (id thor.Bytes32, totalScore uint64) {
 	p.head.Lock()
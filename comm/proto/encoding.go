@@ -0,0 +1,81 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package proto
+
+import (
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/tx"
+)
+
+// protobufVersion is the protocol version since which blocks and txs are protobuf encoded.
+const protobufVersion uint = 2
+
+// Blocks and txs are encoded on the wire according to the protocol version negotiated with the peer.
+// Before version 2, they are plain rlp. Since version 2, they are protobuf encoded (see block.proto and tx.proto),
+// and wrapped as rlp strings to fit in rlp encoded messages.
+
+// EncodeBlock encodes the block for the protocol version.
+func EncodeBlock(version uint, blk *block.Block) (rlp.RawValue, error) {
+	if version < protobufVersion {
+		return rlp.EncodeToBytes(blk)
+	}
+	data, err := blk.MarshalProto()
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(data)
+}
+
+// DecodeBlock decodes the block encoded by EncodeBlock.
+func DecodeBlock(version uint, raw rlp.RawValue) (*block.Block, error) {
+	var blk block.Block
+	if version < protobufVersion {
+		if err := rlp.DecodeBytes(raw, &blk); err != nil {
+			return nil, err
+		}
+		return &blk, nil
+	}
+	var data []byte
+	if err := rlp.DecodeBytes(raw, &data); err != nil {
+		return nil, err
+	}
+	if err := blk.UnmarshalProto(data); err != nil {
+		return nil, err
+	}
+	return &blk, nil
+}
+
+// EncodeTx encodes the tx for the protocol version.
+func EncodeTx(version uint, trx *tx.Transaction) (rlp.RawValue, error) {
+	if version < protobufVersion {
+		return rlp.EncodeToBytes(trx)
+	}
+	data, err := trx.MarshalProto()
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(data)
+}
+
+// DecodeTx decodes the tx encoded by EncodeTx.
+func DecodeTx(version uint, raw rlp.RawValue) (*tx.Transaction, error) {
+	var trx tx.Transaction
+	if version < protobufVersion {
+		if err := rlp.DecodeBytes(raw, &trx); err != nil {
+			return nil, err
+		}
+		return &trx, nil
+	}
+	var data []byte
+	if err := rlp.DecodeBytes(raw, &data); err != nil {
+		return nil, err
+	}
+	if err := trx.UnmarshalProto(data); err != nil {
+		return nil, err
+	}
+	return &trx, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package proto_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/comm/proto"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestEncoding(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	trx := new(tx.Builder).ChainTag(1).Gas(21000).Nonce(1).Clause(tx.NewClause(&thor.Address{})).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+	trx = trx.WithSignature(sig)
+
+	blk := new(block.Builder).ParentID(thor.Bytes32{1}).Timestamp(10).Transaction(trx).Build()
+	sig, _ = crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+	blk = blk.WithSignature(sig)
+
+	for ver := proto.MinVersion; ver <= proto.Version; ver++ {
+		raw, err := proto.EncodeBlock(ver, blk)
+		assert.Nil(t, err)
+		decodedBlk, err := proto.DecodeBlock(ver, raw)
+		assert.Nil(t, err)
+		assert.Equal(t, blk.Header().ID(), decodedBlk.Header().ID())
+		assert.Equal(t, trx.ID(), decodedBlk.Transactions()[0].ID())
+
+		raw, err = proto.EncodeTx(ver, trx)
+		assert.Nil(t, err)
+		decodedTx, err := proto.DecodeTx(ver, raw)
+		assert.Nil(t, err)
+		assert.Equal(t, trx.ID(), decodedTx.ID())
+	}
+
+	// version 1 is plain rlp
+	raw, _ := proto.EncodeBlock(1, blk)
+	enc, _ := rlp.EncodeToBytes(blk)
+	assert.Equal(t, enc, []byte(raw))
+
+	// version 2 is protobuf
+	raw, _ = proto.EncodeTx(2, trx)
+	enc, _ = trx.MarshalProto()
+	var data []byte
+	assert.Nil(t, rlp.DecodeBytes(raw, &data))
+	assert.Equal(t, enc, data)
+
+	_, err := proto.DecodeTx(1, raw)
+	assert.NotNil(t, err)
+}
@@ -12,7 +12,8 @@ import (
 // Constants
 const (
 	Name              = "thor"
-	Version    uint   = 1
+	Version    uint   = 2 // the latest version, see EncodeBlock for differences
+	MinVersion uint   = 1 // the oldest version still supported
 	Length     uint64 = 8
 	MaxMsgSize        = 10 * 1024 * 1024
 )
@@ -29,6 +29,8 @@ type (
 type RPC interface {
 	Notify(ctx context.Context, msgCode uint64, arg interface{}) error
 	Call(ctx context.Context, msgCode uint64, arg interface{}, result interface{}) error
+	// Version returns the protocol version negotiated with the remote peer.
+	Version() uint
 }
 
 // GetStatus get status of remote peer.
@@ -47,16 +49,24 @@ func NotifyNewBlockID(ctx context.Context, rpc RPC, id thor.Bytes32) error {
 
 // NotifyNewBlock notify new block to remote peer.
 func NotifyNewBlock(ctx context.Context, rpc RPC, block *block.Block) error {
-	return rpc.Notify(ctx, MsgNewBlock, block)
+	raw, err := EncodeBlock(rpc.Version(), block)
+	if err != nil {
+		return err
+	}
+	return rpc.Notify(ctx, MsgNewBlock, raw)
 }
 
 // NotifyNewTx notify new tx to remote peer.
 func NotifyNewTx(ctx context.Context, rpc RPC, tx *tx.Transaction) error {
-	return rpc.Notify(ctx, MsgNewTx, tx)
+	raw, err := EncodeTx(rpc.Version(), tx)
+	if err != nil {
+		return err
+	}
+	return rpc.Notify(ctx, MsgNewTx, raw)
 }
 
 // GetBlockByID query block from remote peer by given block ID.
-// It may return nil block even no error.
+// It may return nil block even no error. The block should be decoded by DecodeBlock.
 func GetBlockByID(ctx context.Context, rpc RPC, id thor.Bytes32) (rlp.RawValue, error) {
 	var result []rlp.RawValue
 	if err := rpc.Call(ctx, MsgGetBlockByID, id, &result); err != nil {
@@ -78,6 +88,7 @@ func GetBlockIDByNumber(ctx context.Context, rpc RPC, num uint32) (thor.Bytes32,
 }
 
 // GetBlocksFromNumber get a batch of blocks starts with num from remote peer.
+// Blocks should be decoded by DecodeBlock.
 func GetBlocksFromNumber(ctx context.Context, rpc RPC, num uint32) ([]rlp.RawValue, error) {
 	var blocks []rlp.RawValue
 	if err := rpc.Call(ctx, MsgGetBlocksFromNumber, num, &blocks); err != nil {
@@ -88,9 +99,17 @@ func GetBlocksFromNumber(ctx context.Context, rpc RPC, num uint32) ([]rlp.RawVal
 
 // GetTxs get txs from remote peer.
 func GetTxs(ctx context.Context, rpc RPC) (tx.Transactions, error) {
-	var txs tx.Transactions
-	if err := rpc.Call(ctx, MsgGetTxs, &struct{}{}, &txs); err != nil {
+	var result []rlp.RawValue
+	if err := rpc.Call(ctx, MsgGetTxs, &struct{}{}, &result); err != nil {
 		return nil, err
 	}
+	txs := make(tx.Transactions, 0, len(result))
+	for _, raw := range result {
+		tx, err := DecodeTx(rpc.Version(), raw)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
 	return txs, nil
 }
@@ -9,7 +9,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/co"
@@ -54,8 +53,8 @@ func (c *Communicator) download(peer *Peer, fromNum uint32, handler HandleBlockS
 
 			blocks = blocks[:0]
 			for _, raw := range result {
-				var blk block.Block
-				if err := rlp.DecodeBytes(raw, &blk); err != nil {
+				blk, err := proto.DecodeBlock(peer.Version(), raw)
+				if err != nil {
 					errCh <- errors.Wrap(err, "invalid block")
 					return
 				}
@@ -64,7 +63,7 @@ func (c *Communicator) download(peer *Peer, fromNum uint32, handler HandleBlockS
 					return
 				}
 				fromNum++
-				blocks = append(blocks, &blk)
+				blocks = append(blocks, blk)
 			}
 
 			<-co.Parallel(func(queue chan<- func()) {
@@ -28,41 +28,63 @@ type Consensus struct {
 	forkConfig           thor.ForkConfig
 	correctReceiptsRoots map[string]string
 	candidatesCache      *simplelru.LRU
+	epochMembersCache    *simplelru.LRU
+	rewardPolicy         runtime.RewardPolicy
 }
 
 // New create a Consensus instance.
 func New(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfig) *Consensus {
 	candidatesCache, _ := simplelru.NewLRU(16, nil)
+	epochMembersCache, _ := simplelru.NewLRU(16, nil)
 	return &Consensus{
 		repo:                 repo,
 		stater:               stater,
 		forkConfig:           forkConfig,
 		correctReceiptsRoots: thor.LoadCorrectReceiptsRoots(),
 		candidatesCache:      candidatesCache,
+		epochMembersCache:    epochMembersCache,
+		rewardPolicy:         runtime.DefaultRewardPolicy,
 	}
 }
 
+// SetRewardPolicy sets the policy to reward block beneficiaries, runtime.DefaultRewardPolicy if not set.
+func (c *Consensus) SetRewardPolicy(policy runtime.RewardPolicy) {
+	c.rewardPolicy = policy
+}
+
 // Process process a block.
 func (c *Consensus) Process(blk *block.Block, nowTimestamp uint64) (*state.Stage, tx.Receipts, error) {
+	stage, receipts, _, err := c.process(blk, nowTimestamp, false)
+	return stage, receipts, err
+}
+
+// ProcessWithWitness process a block like Process, and also returns the witness of the parent state
+// touched during processing.
+func (c *Consensus) ProcessWithWitness(blk *block.Block, nowTimestamp uint64) (*state.Stage, tx.Receipts, *state.Witness, error) {
+	return c.process(blk, nowTimestamp, true)
+}
+
+func (c *Consensus) process(blk *block.Block, nowTimestamp uint64, withWitness bool) (*state.Stage, tx.Receipts, *state.Witness, error) {
 	header := blk.Header()
 
-	if _, err := c.repo.GetBlockSummary(header.ID()); err != nil {
-		if !c.repo.IsNotFound(err) {
-			return nil, nil, err
-		}
-	} else {
-		return nil, nil, errKnownBlock
+	if has, err := c.repo.HasBlock(header.ID()); err != nil {
+		return nil, nil, nil, err
+	} else if has {
+		return nil, nil, nil, errKnownBlock
 	}
 
 	parentSummary, err := c.repo.GetBlockSummary(header.ParentID())
 	if err != nil {
 		if !c.repo.IsNotFound(err) {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
-		return nil, nil, errParentMissing
+		return nil, nil, nil, errParentMissing
 	}
 
 	state := c.stater.NewState(parentSummary.Header.StateRoot())
+	if withWitness {
+		state.RecordWitness()
+	}
 
 	vip191 := c.forkConfig.VIP191
 	if vip191 == 0 {
@@ -71,7 +93,7 @@ func (c *Consensus) Process(blk *block.Block, nowTimestamp uint64) (*state.Stage
 	// Before process hook of VIP-191, update builtin extension contract's code to V2
 	if header.Number() == vip191 {
 		if err := state.SetCode(builtin.Extension.Address, builtin.Extension.V2.RuntimeBytecodes()); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -79,17 +101,27 @@ func (c *Consensus) Process(blk *block.Block, nowTimestamp uint64) (*state.Stage
 	if header.Number() >= vip191 {
 		features |= tx.DelegationFeature
 	}
+	if header.Number() >= c.forkConfig.NON_ATOMIC {
+		features |= tx.NonAtomicFeature
+	}
 
 	if header.TxsFeatures() != features {
-		return nil, nil, consensusError(fmt.Sprintf("block txs features invalid: want %v, have %v", features, header.TxsFeatures()))
+		return nil, nil, nil, consensusError(fmt.Sprintf("block txs features invalid: want %v, have %v", features, header.TxsFeatures()))
 	}
 
 	stage, receipts, err := c.validate(state, blk, parentSummary.Header, nowTimestamp)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return stage, receipts, nil
+	if !withWitness {
+		return stage, receipts, nil, nil
+	}
+	witness, err := state.Witness()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return stage, receipts, witness, nil
 }
 
 func (c *Consensus) NewRuntimeForReplay(header *block.Header, skipPoA bool) (*runtime.Runtime, error) {
@@ -106,7 +138,15 @@ func (c *Consensus) NewRuntimeForReplay(header *block.Header, skipPoA bool) (*ru
 	}
 	state := c.stater.NewState(parentSummary.Header.StateRoot())
 	if !skipPoA {
-		if _, err := c.validateProposer(header, parentSummary.Header, state); err != nil {
+		interval, err := c.blockInterval(header, state)
+		if err != nil {
+			return nil, err
+		}
+		members, err := c.epochMembers(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.validateProposer(header, parentSummary.Header, state, interval, members); err != nil {
 			return nil, err
 		}
 	}
@@ -122,5 +162,37 @@ func (c *Consensus) NewRuntimeForReplay(header *block.Header, skipPoA bool) (*ru
 			GasLimit:    header.GasLimit(),
 			TotalScore:  header.TotalScore(),
 		},
-		c.forkConfig), nil
+		c.forkConfig).SetRewardPolicy(c.rewardPolicy), nil
+}
+
+// MissedSlots returns proposers who missed their time slots between the block and its parent.
+// A proposer is listed once for each slot missed. The block is assumed to be valid.
+func (c *Consensus) MissedSlots(header *block.Header) ([]thor.Address, error) {
+	parentSummary, err := c.repo.GetBlockSummary(header.ParentID())
+	if err != nil {
+		if !c.repo.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, errParentMissing
+	}
+	state := c.stater.NewState(parentSummary.Header.StateRoot())
+
+	interval, err := c.blockInterval(header, state)
+	if err != nil {
+		return nil, err
+	}
+	members, err := c.epochMembers(header)
+	if err != nil {
+		return nil, err
+	}
+	sched, _, err := c.scheduler(header, parentSummary.Header, state, interval, members)
+	if err != nil {
+		return nil, err
+	}
+
+	var missed []thor.Address
+	for _, p := range sched.Missed(header.Timestamp()) {
+		missed = append(missed, p.Address)
+	}
+	return missed, nil
 }
@@ -109,9 +109,11 @@ func newTestConsensus(t *testing.T) *testConsensus {
 	}
 
 	forkConfig := thor.ForkConfig{
-		VIP191:    math.MaxUint32,
-		ETH_CONST: math.MaxUint32,
-		BLOCKLIST: 0,
+		VIP191:     math.MaxUint32,
+		ETH_CONST:  math.MaxUint32,
+		BLOCKLIST:  0,
+		NON_ATOMIC: math.MaxUint32,
+		ROTATION:   math.MaxUint32,
 	}
 
 	con := New(repo, stater, forkConfig)
@@ -249,6 +251,15 @@ func (tc *testConsensus) TestValidateBlockHeader() {
 	}
 }
 
+func (tc *testConsensus) TestProcessWithWitness() {
+	stage, receipts, witness, err := tc.con.ProcessWithWitness(tc.original, tc.time)
+	tc.assert.Nil(err)
+	tc.assert.NotNil(stage)
+	tc.assert.Equal(len(tc.original.Transactions()), len(receipts))
+	tc.assert.Equal(tc.parent.Header().StateRoot(), witness.Root)
+	tc.assert.NotEmpty(witness.Nodes)
+}
+
 func (tc *testConsensus) TestTxDepBroken() {
 	txID := txSign(txBuilder(tc.tag)).ID()
 	tx := txSign(txBuilder(tc.tag).DependsOn(&txID))
@@ -291,6 +302,21 @@ func (tc *testConsensus) TestValidateBlockBody() {
 		tc.assert.Equal(err, expect)
 	}
 
+	triggers["triggerErrTxFeaturesUnsupported"] = func() {
+		var features tx.Features
+		features.SetDelegated(true)
+		transaction := txBuilder(tc.tag).Features(features).Build()
+		origin, delegator := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+		sig, _ := crypto.Sign(transaction.SigningHash().Bytes(), origin.PrivateKey)
+		dsig, _ := crypto.Sign(transaction.DelegatorSigningHash(origin.Address).Bytes(), delegator.PrivateKey)
+		transaction = transaction.WithSignature(append(sig, dsig...))
+
+		blk := tc.sign(tc.originalBuilder().Transaction(transaction).Build())
+		err := tc.consent(blk)
+		expect := consensusError("invalid tx: unsupported features")
+		tc.assert.Equal(err, expect)
+	}
+
 	triggers["triggerErrTxsRootMismatch"] = func() {
 		transaction := txSign(txBuilder(tc.tag))
 		transactions := tx.Transactions{transaction}
@@ -402,3 +428,296 @@ func (tc *testConsensus) TestValidateProposer() {
 		trigger()
 	}
 }
+
+func TestRotation(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	parent, _, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(1526400000).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			for _, acc := range genesis.DevAccounts() {
+				state.SetBalance(acc.Address, new(big.Int).Set(thor.InitialProposerEndorsement))
+				builtin.Authority.Native(state).Add(acc.Address, acc.Address, thor.Bytes32{})
+			}
+			return nil
+		}).Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, parent)
+	assert.Nil(t, err)
+
+	forkConfig := thor.NoFork
+	forkConfig.ROTATION = 0
+	con := New(repo, stater, forkConfig)
+
+	// blocks scheduled by packer are accepted for every proposer
+	for _, acc := range genesis.DevAccounts() {
+		p := packer.New(repo, stater, acc.Address, &acc.Address, forkConfig)
+		flow, err := p.Schedule(parent.Header(), parent.Header().Timestamp())
+		assert.Nil(t, err)
+		blk, _, _, err := flow.Pack(acc.PrivateKey)
+		assert.Nil(t, err)
+		_, _, err = con.Process(blk, flow.When())
+		assert.Nil(t, err)
+	}
+}
+
+func TestBlockInterval(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	proposer := genesis.DevAccounts()[0]
+	parent, _, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(1526400000).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			state.SetBalance(proposer.Address, new(big.Int).Set(thor.InitialProposerEndorsement))
+			builtin.Authority.Native(state).Add(proposer.Address, proposer.Address, thor.Bytes32{})
+			state.SetCode(builtin.Params.Address, builtin.Params.RuntimeBytecodes())
+			builtin.Params.Native(state).Set(thor.KeyBlockInterval, big.NewInt(1))
+			return nil
+		}).Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, parent)
+	assert.Nil(t, err)
+
+	forkConfig := thor.NoFork
+	forkConfig.INTERVAL = 0
+
+	p := packer.New(repo, stater, proposer.Address, &proposer.Address, forkConfig)
+	flow, err := p.Schedule(parent.Header(), parent.Header().Timestamp()+3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), flow.Interval())
+	assert.Equal(t, parent.Header().Timestamp()+3, flow.When())
+	blk, _, _, err := flow.Pack(proposer.PrivateKey)
+	assert.Nil(t, err)
+
+	// not rounded by the default interval before fork
+	_, _, err = New(repo, stater, thor.NoFork).Process(blk, flow.When())
+	assert.Equal(t, consensusError(fmt.Sprintf("block interval not rounded: parent %v, current %v",
+		parent.Header().Timestamp(), blk.Header().Timestamp())), err)
+
+	_, _, err = New(repo, stater, forkConfig).Process(blk, flow.When())
+	assert.Nil(t, err)
+}
+
+func TestValidateHeaderChain(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	proposer := genesis.DevAccounts()[0]
+	gene, _, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(1526400000).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			state.SetBalance(proposer.Address, new(big.Int).Set(thor.InitialProposerEndorsement))
+			builtin.Authority.Native(state).Add(proposer.Address, proposer.Address, thor.Bytes32{})
+			return nil
+		}).Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, gene)
+	assert.Nil(t, err)
+
+	// headers signed by the key in every slot, without bodies executed
+	buildHeaders := func(parent *block.Header, count int, key *ecdsa.PrivateKey) (headers []*block.Header) {
+		for i := 0; i < count; i++ {
+			b := new(block.Builder).
+				ParentID(parent.ID()).
+				Timestamp(parent.Timestamp() + thor.BlockInterval).
+				TotalScore(parent.TotalScore() + 1).
+				GasLimit(parent.GasLimit()).
+				StateRoot(parent.StateRoot()).
+				Build()
+			sig, err := crypto.Sign(b.Header().SigningHash().Bytes(), key)
+			assert.Nil(t, err)
+			parent = b.WithSignature(sig).Header()
+			headers = append(headers, parent)
+		}
+		return
+	}
+
+	forkConfig := thor.NoFork
+	forkConfig.ROTATION = 0
+	con := New(repo, stater, forkConfig)
+
+	assert.Nil(t, con.ValidateHeaderChain(nil))
+
+	// crosses epoch boundaries, whose anchors are in the batch
+	headers := buildHeaders(gene.Header(), int(thor.CheckpointInterval)*2+5, proposer.PrivateKey)
+	assert.Nil(t, con.ValidateHeaderChain(headers))
+	assert.Nil(t, New(repo, stater, thor.NoFork).ValidateHeaderChain(headers))
+
+	assert.True(t, IsParentMissing(con.ValidateHeaderChain(headers[1:])))
+
+	// broken linkage
+	broken := append(append([]*block.Header(nil), headers[:3]...), headers[4:]...)
+	assert.Equal(t, consensusError(fmt.Sprintf("block parent mismatch: parent %v, current parent id %v",
+		headers[2].ID(), headers[4].ParentID())), con.ValidateHeaderChain(broken))
+
+	// signed by an outsider
+	outsider, _ := crypto.GenerateKey()
+	bad := append(append([]*block.Header(nil), headers[:3]...), buildHeaders(headers[2], 1, outsider)...)
+	err = con.ValidateHeaderChain(bad)
+	assert.True(t, IsCritical(err))
+	assert.True(t, strings.HasPrefix(err.Error(), "block signer invalid"))
+
+	// total score not match the schedule
+	b := new(block.Builder).
+		ParentID(headers[2].ID()).
+		Timestamp(headers[2].Timestamp() + thor.BlockInterval).
+		TotalScore(headers[2].TotalScore() + 2).
+		GasLimit(headers[2].GasLimit()).
+		Build()
+	sig, err := crypto.Sign(b.Header().SigningHash().Bytes(), proposer.PrivateKey)
+	assert.Nil(t, err)
+	bad = append(headers[:3:3], b.WithSignature(sig).Header())
+	assert.Equal(t, consensusError(fmt.Sprintf("block total score invalid: want %v, have %v",
+		headers[2].TotalScore()+1, headers[2].TotalScore()+2)), con.ValidateHeaderChain(bad))
+}
+
+func TestBlockSize(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	proposer := genesis.DevAccounts()[0]
+	launchTime := uint64(1526400000)
+	parent, _, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(launchTime).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			state.SetBalance(proposer.Address, new(big.Int).Set(thor.InitialProposerEndorsement))
+			state.SetEnergy(proposer.Address, new(big.Int).Set(thor.InitialProposerEndorsement), launchTime)
+			builtin.Authority.Native(state).Add(proposer.Address, proposer.Address, thor.Bytes32{})
+			return nil
+		}).Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, parent)
+	assert.Nil(t, err)
+
+	// a valid block, only oversized
+	trx := new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		Clause(tx.NewClause(&proposer.Address).WithData(make([]byte, thor.MaxBlockSize))).
+		Gas(9000000).Nonce(1).Expiration(math.MaxUint32).Build()
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), proposer.PrivateKey)
+	assert.Nil(t, err)
+	trx = trx.WithSignature(sig)
+
+	flow, err := packer.New(repo, stater, proposer.Address, &proposer.Address, thor.NoFork).
+		Schedule(parent.Header(), parent.Header().Timestamp())
+	assert.Nil(t, err)
+	assert.Nil(t, flow.Adopt(trx))
+	blk, _, _, err := flow.Pack(proposer.PrivateKey)
+	assert.Nil(t, err)
+	assert.True(t, uint64(blk.Size()) > thor.MaxBlockSize)
+
+	_, _, err = New(repo, stater, thor.NoFork).Process(blk, flow.When())
+	assert.Nil(t, err)
+
+	forkConfig := thor.NoFork
+	forkConfig.BLOCKSIZE = 0
+	_, _, err = New(repo, stater, forkConfig).Process(blk, flow.When())
+	assert.Equal(t, consensusError(fmt.Sprintf("block size exceeds limit: size %v, limit %v",
+		uint64(blk.Size()), thor.MaxBlockSize)), err)
+}
+
+func TestEndorsements(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	accs := genesis.DevAccounts()
+	gene, _, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(1526400000).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			for _, acc := range accs {
+				state.SetBalance(acc.Address, new(big.Int).Set(thor.InitialProposerEndorsement))
+				builtin.Authority.Native(state).Add(acc.Address, acc.Address, thor.Bytes32{})
+			}
+			// accs[4] is listed but inactive
+			_, err := builtin.Authority.Native(state).Update(accs[4].Address, false)
+			return err
+		}).Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, gene)
+	assert.Nil(t, err)
+
+	forkConfig := thor.NoFork
+	forkConfig.ENDORSEMENT = 0
+	con := New(repo, stater, forkConfig)
+
+	// b1 proposed by accs[0]
+	flow, err := packer.New(repo, stater, accs[0].Address, &accs[0].Address, forkConfig).Schedule(gene.Header(), gene.Header().Timestamp())
+	assert.Nil(t, err)
+	b1, _, _, err := flow.Pack(accs[0].PrivateKey)
+	assert.Nil(t, err)
+	stage, receipts, err := con.Process(b1, flow.When())
+	assert.Nil(t, err)
+	_, err = stage.Commit()
+	assert.Nil(t, err)
+	assert.Nil(t, repo.AddBlock(b1, receipts))
+
+	// b2 proposed by accs[1], carrying endorsements of b1
+	flow, err = packer.New(repo, stater, accs[1].Address, &accs[1].Address, forkConfig).Schedule(b1.Header(), b1.Header().Timestamp())
+	assert.Nil(t, err)
+	b2, _, _, err := flow.Pack(accs[1].PrivateKey)
+	assert.Nil(t, err)
+	now := flow.When()
+
+	endorse := func(keys ...*ecdsa.PrivateKey) (sigs [][]byte) {
+		for _, key := range keys {
+			sig, err := crypto.Sign(b1.Header().EndorsementHash().Bytes(), key)
+			assert.Nil(t, err)
+			sigs = append(sigs, sig)
+		}
+		return
+	}
+	build := func(sigs [][]byte) *block.Block {
+		h := b2.Header()
+		blk := new(block.Builder).
+			ParentID(h.ParentID()).
+			Timestamp(h.Timestamp()).
+			TotalScore(h.TotalScore()).
+			GasLimit(h.GasLimit()).
+			GasUsed(h.GasUsed()).
+			Beneficiary(h.Beneficiary()).
+			StateRoot(h.StateRoot()).
+			ReceiptsRoot(h.ReceiptsRoot()).
+			TransactionFeatures(h.TxsFeatures()).
+			Endorsements(sigs).
+			Build()
+		sig, err := crypto.Sign(blk.Header().SigningHash().Bytes(), accs[1].PrivateKey)
+		assert.Nil(t, err)
+		return blk.WithSignature(sig)
+	}
+
+	sigs, err := block.AggregateEndorsements(b1.Header().ID(), endorse(accs[2].PrivateKey, accs[3].PrivateKey)...)
+	assert.Nil(t, err)
+	endorsed := build(sigs)
+	_, _, err = con.Process(endorsed, now)
+	assert.Nil(t, err)
+
+	// endorsements are committed by the block ID
+	assert.NotEqual(t, endorsed.Header().ID(), build(nil).Header().ID())
+	assert.NotEqual(t, endorsed.Header().ID(), build([][]byte{sigs[1], sigs[0]}).Header().ID())
+
+	_, _, err = con.Process(build([][]byte{sigs[1], sigs[0]}), now)
+	assert.Equal(t, consensusError("block endorsements not sorted"), err)
+
+	_, _, err = con.Process(build(endorse(accs[0].PrivateKey)), now)
+	assert.Equal(t, consensusError(fmt.Sprintf("parent block endorsed by its signer: %v", accs[0].Address)), err)
+
+	_, _, err = con.Process(build(endorse(accs[1].PrivateKey)), now)
+	assert.Equal(t, consensusError(fmt.Sprintf("block endorsed by its signer: %v", accs[1].Address)), err)
+
+	_, _, err = con.Process(build(endorse(accs[4].PrivateKey)), now)
+	assert.Equal(t, consensusError(fmt.Sprintf("block endorser inactive: %v", accs[4].Address)), err)
+
+	outsider, _ := crypto.GenerateKey()
+	_, _, err = con.Process(build(endorse(outsider)), now)
+	assert.Equal(t, consensusError(fmt.Sprintf("block endorser invalid: %v", thor.Address(crypto.PubkeyToAddress(outsider.PublicKey)))), err)
+
+	_, _, err = New(repo, stater, thor.NoFork).Process(endorsed, now)
+	assert.Equal(t, consensusError("block endorsements not allowed before fork"), err)
+}
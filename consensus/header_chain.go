@@ -0,0 +1,103 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package consensus
+
+import (
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/thor"
+)
+
+// ValidateHeaderChain validates a batch of consecutive headers without executing block bodies.
+// It checks parent linkage, timestamps, gas limits, signatures, schedules and total scores.
+// The parent of the first header must be in the repository.
+//
+// Since bodies are not executed, the authority set and params are assumed to be unchanged by
+// transactions in the batch, apart from activity updates of the schedule. It's a cheap pre-check
+// for sync, and blocks still have to be processed when bodies arrive.
+func (c *Consensus) ValidateHeaderChain(headers []*block.Header) error {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	parentSummary, err := c.repo.GetBlockSummary(headers[0].ParentID())
+	if err != nil {
+		if !c.repo.IsNotFound(err) {
+			return err
+		}
+		return errParentMissing
+	}
+	var (
+		base   = parentSummary.Header
+		chain  = c.repo.NewChain(base.ID())
+		st     = c.stater.NewState(base.StateRoot())
+		parent = base
+	)
+
+	// the interval read from state of the base applies to the whole batch
+	paramInterval, err := poa.BlockInterval(st)
+	if err != nil {
+		return err
+	}
+
+	// members of epochs whose anchors are in the batch, keyed by epoch start
+	batchMembers := make(map[uint32][]thor.Address)
+
+	// returns id of the block numbered num, either in the batch or on the chain of the base
+	getID := func(num uint32) (thor.Bytes32, error) {
+		if num > base.Number() {
+			return headers[num-base.Number()-1].ID(), nil
+		}
+		return chain.GetBlockID(num)
+	}
+
+	for _, header := range headers {
+		interval := thor.BlockInterval
+		if header.Number() >= c.forkConfig.INTERVAL {
+			interval = paramInterval
+		}
+		if err := header.ValidateAgainstParentWithInterval(parent, interval); err != nil {
+			return consensusError(err.Error())
+		}
+
+		var members []thor.Address
+		if header.Number() >= c.forkConfig.ROTATION {
+			if poa.EpochAnchor(header.Number()) <= base.Number() {
+				if members, err = c.epochMembersOn(chain, header.Number()); err != nil {
+					return err
+				}
+			} else if cached, ok := batchMembers[poa.EpochStart(header.Number())]; ok {
+				members = cached
+			} else {
+				// the anchor is in the batch, whose state is approximated by the evolving state of the base
+				seed, err := getID(poa.EpochSeed(header.Number()))
+				if err != nil {
+					return err
+				}
+				list, err := builtin.Authority.Native(st).AllCandidates()
+				if err != nil {
+					return err
+				}
+				proposers, err := poa.NewCandidates(list).Pick(st)
+				if err != nil {
+					return err
+				}
+				members = poa.ShuffleMembers(proposers, seed)
+				batchMembers[poa.EpochStart(header.Number())] = members
+			}
+		}
+
+		if _, err := c.validateProposer(header, parent, st, interval, members); err != nil {
+			return err
+		}
+		if err := c.validateEndorsements(header, parent, st); err != nil {
+			return err
+		}
+		parent = header
+	}
+	return nil
+}
@@ -6,10 +6,12 @@
 package consensus
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/poa"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
@@ -26,15 +28,29 @@ func (c *Consensus) validate(
 ) (*state.Stage, tx.Receipts, error) {
 	header := block.Header()
 
-	if err := c.validateBlockHeader(header, parentHeader, nowTimestamp); err != nil {
+	interval, err := c.blockInterval(header, state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.validateBlockHeader(header, parentHeader, nowTimestamp, interval); err != nil {
+		return nil, nil, err
+	}
+
+	members, err := c.epochMembers(header)
+	if err != nil {
 		return nil, nil, err
 	}
 
-	candidates, err := c.validateProposer(header, parentHeader, state)
+	candidates, err := c.validateProposer(header, parentHeader, state, interval, members)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if err := c.validateEndorsements(header, parentHeader, state); err != nil {
+		return nil, nil, err
+	}
+
 	if err := c.validateBlockBody(block); err != nil {
 		return nil, nil, err
 	}
@@ -88,96 +104,188 @@ func (c *Consensus) validate(
 	return stage, receipts, nil
 }
 
-func (c *Consensus) validateBlockHeader(header *block.Header, parent *block.Header, nowTimestamp uint64) error {
-	if header.Timestamp() <= parent.Timestamp() {
-		return consensusError(fmt.Sprintf("block timestamp behind parents: parent %v, current %v", parent.Timestamp(), header.Timestamp()))
-	}
+// blockInterval returns the block interval for the block, read from the parent state since INTERVAL fork.
+func (c *Consensus) blockInterval(header *block.Header, parentState *state.State) (uint64, error) {
+	return poa.BlockIntervalOf(parentState, c.forkConfig, header.Number())
+}
 
-	if (header.Timestamp()-parent.Timestamp())%thor.BlockInterval != 0 {
-		return consensusError(fmt.Sprintf("block interval not rounded: parent %v, current %v", parent.Timestamp(), header.Timestamp()))
+func (c *Consensus) validateBlockHeader(header *block.Header, parent *block.Header, nowTimestamp uint64, interval uint64) error {
+	if err := header.ValidateAgainstParentWithInterval(parent, interval); err != nil {
+		return consensusError(err.Error())
 	}
 
-	if header.Timestamp() > nowTimestamp+thor.BlockInterval {
+	if header.Timestamp() > nowTimestamp+interval {
 		return errFutureBlock
 	}
+	return nil
+}
 
-	if !block.GasLimit(header.GasLimit()).IsValid(parent.GasLimit()) {
-		return consensusError(fmt.Sprintf("block gas limit invalid: parent %v, current %v", parent.GasLimit(), header.GasLimit()))
+// validateProposer checks the block signer against the schedule. If members is not nil,
+// proposers are limited to epoch members.
+func (c *Consensus) validateProposer(header *block.Header, parent *block.Header, st *state.State, interval uint64, members []thor.Address) (*poa.Candidates, error) {
+	sched, candidates, err := c.scheduler(header, parent, st, interval, members)
+	if err != nil {
+		return nil, err
 	}
 
-	if header.GasUsed() > header.GasLimit() {
-		return consensusError(fmt.Sprintf("block gas used exceeds limit: limit %v, used %v", header.GasLimit(), header.GasUsed()))
+	signer, _ := header.Signer()
+	if !sched.IsTheTime(header.Timestamp()) {
+		return nil, consensusError(fmt.Sprintf("block timestamp unscheduled: t %v, s %v", header.Timestamp(), signer))
 	}
 
-	if header.TotalScore() <= parent.TotalScore() {
-		return consensusError(fmt.Sprintf("block total score invalid: parent %v, current %v", parent.TotalScore(), header.TotalScore()))
+	updates, score := sched.Updates(header.Timestamp())
+	if parent.TotalScore()+score != header.TotalScore() {
+		return nil, consensusError(fmt.Sprintf("block total score invalid: want %v, have %v", parent.TotalScore()+score, header.TotalScore()))
 	}
-	return nil
+
+	authority := builtin.Authority.Native(st)
+	for _, u := range updates {
+		if _, err := authority.Update(u.Address, u.Active); err != nil {
+			return nil, err
+		}
+		if !candidates.Update(u.Address, u.Active) {
+			// should never happen
+			panic("something wrong with candidates list")
+		}
+	}
+
+	return candidates, nil
 }
 
-func (c *Consensus) validateProposer(header *block.Header, parent *block.Header, st *state.State) (*poa.Candidates, error) {
+// scheduler creates the scheduler of the block signer upon the parent state, and returns it
+// with the candidates list used.
+func (c *Consensus) scheduler(header *block.Header, parent *block.Header, st *state.State, interval uint64, members []thor.Address) (*poa.Scheduler, *poa.Candidates, error) {
 	signer, err := header.Signer()
 	if err != nil {
-		return nil, consensusError(fmt.Sprintf("block signer unavailable: %v", err))
+		return nil, nil, consensusError(fmt.Sprintf("block signer unavailable: %v", err))
 	}
 
-	authority := builtin.Authority.Native(st)
 	var candidates *poa.Candidates
 	if entry, ok := c.candidatesCache.Get(parent.ID()); ok {
 		candidates = entry.(*poa.Candidates).Copy()
 	} else {
-		list, err := authority.AllCandidates()
+		list, err := builtin.Authority.Native(st).AllCandidates()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		candidates = poa.NewCandidates(list)
 	}
 
 	proposers, err := candidates.Pick(st)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if members != nil {
+		proposers = poa.EpochProposers(members, proposers)
+	}
+
+	var weights map[thor.Address]uint64
+	if header.Number() >= c.forkConfig.WEIGHTING {
+		if weights, err = poa.Weights(st, proposers); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	sched, err := poa.NewScheduler(signer, proposers, parent.Number(), parent.Timestamp())
+	sched, err := poa.NewWeightedScheduler(signer, proposers, weights, parent.Number(), parent.Timestamp(), interval)
 	if err != nil {
-		return nil, consensusError(fmt.Sprintf("block signer invalid: %v %v", signer, err))
+		return nil, nil, consensusError(fmt.Sprintf("block signer invalid: %v %v", signer, err))
 	}
+	return sched, candidates, nil
+}
 
-	if !sched.IsTheTime(header.Timestamp()) {
-		return nil, consensusError(fmt.Sprintf("block timestamp unscheduled: t %v, s %v", header.Timestamp(), signer))
+// epochMembers returns members of the epoch which the block belongs to, or nil before ROTATION fork.
+func (c *Consensus) epochMembers(header *block.Header) ([]thor.Address, error) {
+	if header.Number() < c.forkConfig.ROTATION {
+		return nil, nil
 	}
+	return c.epochMembersOn(c.repo.NewChain(header.ParentID()), header.Number())
+}
 
-	updates, score := sched.Updates(header.Timestamp())
-	if parent.TotalScore()+score != header.TotalScore() {
-		return nil, consensusError(fmt.Sprintf("block total score invalid: want %v, have %v", parent.TotalScore()+score, header.TotalScore()))
+// epochMembersOn returns members of the epoch which the block numbered blockNum belongs to.
+// The chain must contain the parent of the block.
+func (c *Consensus) epochMembersOn(chain *chain.Chain, blockNum uint32) ([]thor.Address, error) {
+	anchorID, err := chain.GetBlockID(poa.EpochAnchor(blockNum))
+	if err != nil {
+		return nil, err
+	}
+	if members, ok := c.epochMembersCache.Get(anchorID); ok {
+		return members.([]thor.Address), nil
+	}
+	members, err := poa.EpochMembers(chain, c.stater, blockNum)
+	if err != nil {
+		return nil, err
 	}
+	c.epochMembersCache.Add(anchorID, members)
+	return members, nil
+}
 
-	for _, u := range updates {
-		if _, err := authority.Update(u.Address, u.Active); err != nil {
-			return nil, err
+// validateEndorsements validates endorsements of the parent block carried by the header.
+func (c *Consensus) validateEndorsements(header *block.Header, parent *block.Header, st *state.State) error {
+	if len(header.Endorsements()) == 0 {
+		return nil
+	}
+	if header.Number() < c.forkConfig.ENDORSEMENT {
+		return consensusError("block endorsements not allowed before fork")
+	}
+
+	endorsers, err := header.Endorsers()
+	if err != nil {
+		return consensusError(fmt.Sprintf("block endorser unavailable: %v", err))
+	}
+	parentSigner, err := parent.Signer()
+	if err != nil {
+		return consensusError(fmt.Sprintf("parent block signer unavailable: %v", err))
+	}
+	signer, err := header.Signer()
+	if err != nil {
+		return consensusError(fmt.Sprintf("block signer unavailable: %v", err))
+	}
+
+	authority := builtin.Authority.Native(st)
+	for i, endorser := range endorsers {
+		// strictly ascending, to be canonical and without duplicates
+		if i > 0 && bytes.Compare(endorsers[i-1][:], endorser[:]) >= 0 {
+			return consensusError("block endorsements not sorted")
 		}
-		if !candidates.Update(u.Address, u.Active) {
-			// should never happen
-			panic("something wrong with candidates list")
+		if endorser == parentSigner {
+			return consensusError(fmt.Sprintf("parent block endorsed by its signer: %v", endorser))
+		}
+		if endorser == signer {
+			return consensusError(fmt.Sprintf("block endorsed by its signer: %v", endorser))
+		}
+		// st is the parent state, in which the endorser should be an active master
+		listed, _, _, active, err := authority.Get(endorser)
+		if err != nil {
+			return err
+		}
+		if !listed {
+			return consensusError(fmt.Sprintf("block endorser invalid: %v", endorser))
+		}
+		if !active {
+			return consensusError(fmt.Sprintf("block endorser inactive: %v", endorser))
 		}
 	}
-
-	return candidates, nil
+	return nil
 }
 
 func (c *Consensus) validateBlockBody(blk *block.Block) error {
 	header := blk.Header()
+	if header.Number() >= c.forkConfig.BLOCKSIZE && uint64(blk.Size()) > thor.MaxBlockSize {
+		return consensusError(fmt.Sprintf("block size exceeds limit: size %v, limit %v", uint64(blk.Size()), thor.MaxBlockSize))
+	}
+
 	txs := blk.Transactions()
 	if header.TxsRoot() != txs.RootHash() {
 		return consensusError(fmt.Sprintf("block txs root mismatch: want %v, have %v", header.TxsRoot(), txs.RootHash()))
 	}
 
-	for _, tx := range txs {
-		origin, err := tx.Origin()
-		if err != nil {
-			return consensusError(fmt.Sprintf("tx signer unavailable: %v", err))
-		}
+	origins, err := txs.Signers()
+	if err != nil {
+		return consensusError(fmt.Sprintf("tx signer unavailable: %v", err))
+	}
 
+	for i, tx := range txs {
+		origin := origins[i]
 		if header.Number() >= c.forkConfig.BLOCKLIST && thor.IsOriginBlocked(origin) {
 			return consensusError(fmt.Sprintf("tx origin blocked got packed: %v", origin))
 		}
@@ -219,7 +327,7 @@ func (c *Consensus) verifyBlock(blk *block.Block, state *state.State) (*state.St
 			GasLimit:    header.GasLimit(),
 			TotalScore:  header.TotalScore(),
 		},
-		c.forkConfig)
+		c.forkConfig).SetRewardPolicy(c.rewardPolicy)
 
 	findTx := func(txID thor.Bytes32) (found bool, reverted bool, err error) {
 		if reverted, ok := processedTxs[txID]; ok {
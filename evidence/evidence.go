@@ -0,0 +1,174 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package evidence detects and keeps evidence of misbehaving block signers.
+package evidence
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	storeName = "evidence"
+
+	// count of recent (signer, number) pairs kept to detect double signing
+	recentHeadersLimit = 8192
+)
+
+// DoubleSign is the evidence that a signer signed two different blocks with the same number.
+type DoubleSign struct {
+	Signer  thor.Address    `json:"signer"`
+	Number  uint32          `json:"number"`
+	Headers []*block.Header `json:"headers"` // the two conflicting headers
+}
+
+// Verify checks that the two headers are different, and both signed by the signer at the number.
+func (ev *DoubleSign) Verify() error {
+	if len(ev.Headers) != 2 {
+		return errors.New("expect two headers")
+	}
+	if ev.Headers[0].ID() == ev.Headers[1].ID() {
+		return errors.New("headers are identical")
+	}
+	for _, h := range ev.Headers {
+		if h.Number() != ev.Number {
+			return errors.New("header number mismatch")
+		}
+		signer, err := h.Signer()
+		if err != nil {
+			return err
+		}
+		if signer != ev.Signer {
+			return errors.New("header signer mismatch")
+		}
+	}
+	return nil
+}
+
+// Hook is called when new evidence is found, e.g. to report the signer to the Authority contract.
+// It's called synchronously, and should return quickly.
+type Hook func(ev *DoubleSign)
+
+// Pool detects double signing from observed headers, and persists evidence found.
+// It's safe for concurrent use.
+type Pool struct {
+	store kv.Store
+	hook  Hook
+
+	lock   sync.Mutex
+	recent *simplelru.LRU // key(signer, number) => header
+}
+
+// New creates an evidence pool. The hook is optional.
+func New(db *muxdb.MuxDB, hook Hook) *Pool {
+	recent, _ := simplelru.NewLRU(recentHeadersLimit, nil)
+	return &Pool{
+		store:  db.NewStore(storeName),
+		hook:   hook,
+		recent: recent,
+	}
+}
+
+func makeKey(signer thor.Address, num uint32) []byte {
+	var key [thor.AddressLength + 4]byte
+	copy(key[:], signer[:])
+	binary.BigEndian.PutUint32(key[thor.AddressLength:], num)
+	return key[:]
+}
+
+// Observe checks the header against recently observed ones. If another header with the
+// same number is signed by the same signer, the evidence is persisted and returned.
+// Headers should have been validated, otherwise signers might be not authorized at all.
+func (p *Pool) Observe(header *block.Header) (*DoubleSign, error) {
+	signer, err := header.Signer()
+	if err != nil {
+		return nil, err
+	}
+	key := makeKey(signer, header.Number())
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	v, ok := p.recent.Get(string(key))
+	if !ok {
+		p.recent.Add(string(key), header)
+		return nil, nil
+	}
+	seen := v.(*block.Header)
+	if seen.ID() == header.ID() {
+		// the same block, maybe with different endorsements
+		return nil, nil
+	}
+
+	// only the first evidence is kept
+	if has, err := p.store.Has(key); err != nil || has {
+		return nil, err
+	}
+	ev := &DoubleSign{
+		Signer:  signer,
+		Number:  header.Number(),
+		Headers: []*block.Header{seen, header},
+	}
+	data, err := rlp.EncodeToBytes(ev)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.store.Put(key, data); err != nil {
+		return nil, err
+	}
+	if p.hook != nil {
+		p.hook(ev)
+	}
+	return ev, nil
+}
+
+// Get returns the evidence of the signer at the block number. Nil returned if not found.
+func (p *Pool) Get(signer thor.Address, num uint32) (*DoubleSign, error) {
+	data, err := p.store.Get(makeKey(signer, num))
+	if err != nil {
+		if p.store.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var ev DoubleSign
+	if err := rlp.DecodeBytes(data, &ev); err != nil {
+		return nil, err
+	}
+	return &ev, nil
+}
+
+// All returns all evidence, ordered by signer and block number.
+// If signer is not nil, only evidence of the signer is returned.
+func (p *Pool) All(signer *thor.Address) (list []*DoubleSign, err error) {
+	var r kv.Range
+	if signer != nil {
+		r.Start = signer[:]
+	}
+	if iterErr := p.store.Iterate(r, func(pair kv.Pair) bool {
+		if signer != nil && !bytes.HasPrefix(pair.Key(), signer[:]) {
+			return false
+		}
+		var ev DoubleSign
+		if err = rlp.DecodeBytes(pair.Value(), &ev); err != nil {
+			return false
+		}
+		list = append(list, &ev)
+		return true
+	}); iterErr != nil {
+		return nil, iterErr
+	}
+	return
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package evidence_test
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func newHeader(parentID thor.Bytes32, ts uint64, key *ecdsa.PrivateKey) *block.Header {
+	b := new(block.Builder).ParentID(parentID).Timestamp(ts).Build()
+	sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), key)
+	return b.WithSignature(sig).Header()
+}
+
+func TestPool(t *testing.T) {
+	var hooked []*evidence.DoubleSign
+	pool := evidence.New(muxdb.NewMem(), func(ev *evidence.DoubleSign) {
+		hooked = append(hooked, ev)
+	})
+
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	signer1 := thor.Address(crypto.PubkeyToAddress(key1.PublicKey))
+
+	var parentID thor.Bytes32
+	h1 := newHeader(parentID, 10, key1)
+
+	// the first and the same header
+	assert.Equal(t, M((*evidence.DoubleSign)(nil), nil), M(pool.Observe(h1)))
+	assert.Equal(t, M((*evidence.DoubleSign)(nil), nil), M(pool.Observe(h1)))
+	// another signer at the same number
+	assert.Equal(t, M((*evidence.DoubleSign)(nil), nil), M(pool.Observe(newHeader(parentID, 10, key2))))
+
+	// signer1 signs another block at the same number
+	h2 := newHeader(parentID, 20, key1)
+	ev, err := pool.Observe(h2)
+	assert.Nil(t, err)
+	if assert.NotNil(t, ev) {
+		assert.Nil(t, ev.Verify())
+		assert.Equal(t, signer1, ev.Signer)
+		assert.Equal(t, uint32(1), ev.Number)
+		assert.Equal(t, []thor.Bytes32{h1.ID(), h2.ID()}, []thor.Bytes32{ev.Headers[0].ID(), ev.Headers[1].ID()})
+	}
+	assert.Equal(t, 1, len(hooked))
+
+	// kept only once
+	ev, err = pool.Observe(newHeader(parentID, 30, key1))
+	assert.Nil(t, err)
+	assert.Nil(t, ev)
+	assert.Equal(t, 1, len(hooked))
+
+	got, err := pool.Get(signer1, 1)
+	assert.Nil(t, err)
+	if assert.NotNil(t, got) {
+		assert.Nil(t, got.Verify())
+		assert.Equal(t, h2.ID(), got.Headers[1].ID())
+	}
+	assert.Equal(t, M((*evidence.DoubleSign)(nil), nil), M(pool.Get(signer1, 2)))
+
+	all, err := pool.All(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(all))
+
+	all, err = pool.All(&signer1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(all))
+
+	signer2 := thor.Address(crypto.PubkeyToAddress(key2.PublicKey))
+	all, err = pool.All(&signer2)
+	assert.Nil(t, err)
+	assert.Empty(t, all)
+}
+
+func TestVerify(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	h1 := newHeader(thor.Bytes32{}, 10, key1)
+	signer1 := thor.Address(crypto.PubkeyToAddress(key1.PublicKey))
+
+	ev := &evidence.DoubleSign{Signer: signer1, Number: 1, Headers: []*block.Header{h1, h1}}
+	assert.Error(t, ev.Verify())
+
+	ev.Headers = []*block.Header{h1, newHeader(thor.Bytes32{}, 20, key2)}
+	assert.Error(t, ev.Verify())
+
+	var parentID thor.Bytes32
+	parentID[3] = 1
+	ev.Headers = []*block.Header{h1, newHeader(parentID, 20, key1)}
+	assert.Error(t, ev.Verify())
+}
+
+func M(a ...interface{}) []interface{} {
+	return a
+}
@@ -6,10 +6,14 @@
 package genesis
 
 import (
+	"encoding/csv"
+	"io"
 	"math"
+	"math/big"
 
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
@@ -24,10 +28,17 @@ type Builder struct {
 	gasLimit  uint64
 
 	stateProcs []func(state *state.State) error
+	allocProcs []func(state *state.State) (vet, energy *big.Int, err error)
+	hooks      []hook
 	calls      []call
 	extraData  [28]byte
 }
 
+type hook struct {
+	name string
+	proc func(state *state.State) error
+}
+
 type call struct {
 	clause *tx.Clause
 	caller thor.Address
@@ -51,7 +62,109 @@ func (b *Builder) State(proc func(state *state.State) error) *Builder {
 	return b
 }
 
-// Call add a contract call.
+// Hook add a named state process, which runs after all state and allocation processes and before contract calls.
+// Hooks run in order of registration, and a hook with the same name replaces the previous one.
+func (b *Builder) Hook(name string, proc func(state *state.State) error) *Builder {
+	for i := range b.hooks {
+		if b.hooks[i].name == name {
+			b.hooks[i].proc = proc
+			return b
+		}
+	}
+	b.hooks = append(b.hooks, hook{name, proc})
+	return b
+}
+
+// alloc add an allocation process, which runs after all state processes. VET and energy
+// allocated by it are added to initial supply, like built-in allocations counted by state processes.
+func (b *Builder) alloc(proc func(state *state.State) (vet, energy *big.Int, err error)) *Builder {
+	b.allocProcs = append(b.allocProcs, proc)
+	return b
+}
+
+// DeployContract add a contract with runtime code, initial storage and energy at the given address.
+// The energy is counted into initial energy supply.
+func (b *Builder) DeployContract(addr thor.Address, runtimeCode []byte, storage map[thor.Bytes32]thor.Bytes32, energy *big.Int) *Builder {
+	return b.alloc(func(state *state.State) (*big.Int, *big.Int, error) {
+		if err := state.SetCode(addr, runtimeCode); err != nil {
+			return nil, nil, err
+		}
+		for k, v := range storage {
+			state.SetStorage(addr, k, v)
+		}
+		if energy == nil {
+			energy = &big.Int{}
+		}
+		if err := state.SetEnergy(addr, energy, b.timestamp); err != nil {
+			return nil, nil, err
+		}
+		return &big.Int{}, energy, nil
+	})
+}
+
+// AllocFromCSV add allocations read from r, one account per record in form of
+// 'address,balance,energy', where balance and energy are decimal integers in wei.
+// The energy field can be empty. Lines starting with '#' are ignored.
+//
+// Records are read from the start of r and applied one by one on each build, so
+// the whole list is never held in memory. Allocations are counted into initial supply.
+func (b *Builder) AllocFromCSV(r io.ReadSeeker) *Builder {
+	parseAmount := func(str string) (*big.Int, bool) {
+		if str == "" {
+			return &big.Int{}, true
+		}
+		v, ok := new(big.Int).SetString(str, 10)
+		if !ok || v.Sign() < 0 {
+			return nil, false
+		}
+		return v, true
+	}
+
+	return b.alloc(func(state *state.State) (*big.Int, *big.Int, error) {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return nil, nil, err
+		}
+		reader := csv.NewReader(r)
+		reader.Comment = '#'
+		reader.FieldsPerRecord = 3
+		reader.TrimLeadingSpace = true
+		reader.ReuseRecord = true
+
+		vetSum, energySum := &big.Int{}, &big.Int{}
+		for i := 0; ; i++ {
+			record, err := reader.Read()
+			if err == io.EOF {
+				return vetSum, energySum, nil
+			}
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "read csv")
+			}
+			addr, err := thor.ParseAddress(record[0])
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "record #%v: address", i)
+			}
+			balance, ok := parseAmount(record[1])
+			if !ok {
+				return nil, nil, errors.Errorf("record #%v: invalid balance", i)
+			}
+			energy, ok := parseAmount(record[2])
+			if !ok {
+				return nil, nil, errors.Errorf("record #%v: invalid energy", i)
+			}
+			if err := state.SetBalance(addr, balance); err != nil {
+				return nil, nil, err
+			}
+			if err := state.SetEnergy(addr, energy, b.timestamp); err != nil {
+				return nil, nil, err
+			}
+			vetSum.Add(vetSum, balance)
+			energySum.Add(energySum, energy)
+		}
+	})
+}
+
+// Call add a contract call. The caller can be any address, and VET carried by the clause
+// is transferred from the caller's balance, which should be allocated by a state process.
 func (b *Builder) Call(clause *tx.Clause, caller thor.Address) *Builder {
 	b.calls = append(b.calls, call{clause, caller})
 	return b
@@ -84,12 +197,42 @@ func (b *Builder) Build(stater *state.Stater) (blk *block.Block, events tx.Event
 		}
 	}
 
+	if len(b.allocProcs) > 0 {
+		vetSupply, energySupply := &big.Int{}, &big.Int{}
+		for _, proc := range b.allocProcs {
+			vet, energy, err := proc(state)
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, "alloc process")
+			}
+			vetSupply.Add(vetSupply, vet)
+			energySupply.Add(energySupply, energy)
+		}
+		if err := builtin.Energy.Native(state, b.timestamp).AddInitialSupply(vetSupply, energySupply); err != nil {
+			return nil, nil, nil, errors.Wrap(err, "add initial supply")
+		}
+	}
+
+	for _, hook := range b.hooks {
+		if err := hook.proc(state); err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "hook %v", hook.name)
+		}
+	}
+
 	rt := runtime.New(nil, state, &xenv.BlockContext{
 		Time:     b.timestamp,
 		GasLimit: b.gasLimit,
 	}, thor.NoFork)
 
-	for _, call := range b.calls {
+	for i, call := range b.calls {
+		if value := call.clause.Value(); value.Sign() > 0 {
+			balance, err := state.GetBalance(call.caller)
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, "get balance")
+			}
+			if balance.Cmp(value) < 0 {
+				return nil, nil, nil, errors.Errorf("call #%v: insufficient balance of caller %v", i, call.caller)
+			}
+		}
 		exec, _ := rt.PrepareClause(call.clause, 0, math.MaxUint64, &xenv.TransactionContext{
 			Origin: call.caller,
 		})
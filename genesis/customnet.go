@@ -9,12 +9,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -31,6 +33,23 @@ type CustomGenesis struct {
 	Params     Params           `json:"params"`
 	Executor   Executor         `json:"executor"`
 	ForkConfig *thor.ForkConfig `json:"forkConfig"`
+	Rewards    *Rewards         `json:"rewards"`
+}
+
+// DecodeCustomGenesis decodes custom genesis from JSON spec. Unknown fields are rejected.
+// ForkConfig defaults to thor.NoFork if absent.
+func DecodeCustomGenesis(r io.Reader) (*CustomGenesis, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	var forkConfig = thor.NoFork
+	var gen CustomGenesis
+	gen.ForkConfig = &forkConfig
+
+	if err := decoder.Decode(&gen); err != nil {
+		return nil, err
+	}
+	return &gen, nil
 }
 
 // NewCustomNet create custom network genesis.
@@ -183,6 +202,9 @@ func NewCustomNet(gen *CustomGenesis) (*Genesis, error) {
 		}
 	}
 
+	if len(gen.ExtraData) > 28 {
+		return nil, errors.New("extraData should not be longer than 28 bytes")
+	}
 	if len(gen.ExtraData) > 0 {
 		var extra [28]byte
 		copy(extra[:], gen.ExtraData)
@@ -196,6 +218,24 @@ func NewCustomNet(gen *CustomGenesis) (*Genesis, error) {
 	return &Genesis{builder, id, "customnet"}, nil
 }
 
+// RewardPolicy returns the reward policy configured. It defaults to runtime.DefaultRewardPolicy if absent.
+func (gen *CustomGenesis) RewardPolicy() (runtime.RewardPolicy, error) {
+	if gen.Rewards == nil {
+		return runtime.DefaultRewardPolicy, nil
+	}
+	if gen.Rewards.Disabled {
+		return runtime.NoRewardPolicy, nil
+	}
+	if len(gen.Rewards.Splits) == 0 {
+		return runtime.DefaultRewardPolicy, nil
+	}
+	splits := make([]runtime.RewardSplit, 0, len(gen.Rewards.Splits))
+	for _, s := range gen.Rewards.Splits {
+		splits = append(splits, runtime.RewardSplit{Recipient: s.Address, Share: s.Share})
+	}
+	return runtime.NewSplitRewardPolicy(splits)
+}
+
 // Account is the account will set to the genesis block
 type Account struct {
 	Address thor.Address            `json:"address"`
@@ -231,6 +271,18 @@ type Params struct {
 	ExecutorAddress     *thor.Address    `json:"executorAddress"`
 }
 
+// Rewards configures how block beneficiaries are rewarded. It's not part of the genesis block.
+type Rewards struct {
+	Disabled bool          `json:"disabled"`
+	Splits   []RewardSplit `json:"splits"`
+}
+
+// RewardSplit is the share of rewards for the address, out of runtime.MaxRewardShare.
+type RewardSplit struct {
+	Address thor.Address `json:"address"`
+	Share   uint32       `json:"share"`
+}
+
 // hexOrDecimal256 marshals big.Int as hex or decimal.
 // Copied from go-ethereum/common/math and implement json. Marshaler
 type hexOrDecimal256 big.Int
@@ -26,7 +26,42 @@ type DevAccount struct {
 
 var devAccounts atomic.Value
 
+// path of dev accounts is m/44'/818'/0'/0/index, 818 is the coin type of VeChain.
+var devAccountsPath = []uint32{44 + hardenedKeyStart, 818 + hardenedKeyStart, hardenedKeyStart, 0}
+
+// DeriveDevAccounts derives count of accounts from BIP39 mnemonic, along path m/44'/818'/0'/0/index.
+func DeriveDevAccounts(mnemonic string, count int) ([]DevAccount, error) {
+	key := newMasterHDKey(mnemonicToSeed(mnemonic))
+	for _, i := range devAccountsPath {
+		var err error
+		if key, err = key.child(i); err != nil {
+			return nil, err
+		}
+	}
+
+	accs := make([]DevAccount, 0, count)
+	for i := 0; i < count; i++ {
+		child, err := key.child(uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		pk, err := child.privateKey()
+		if err != nil {
+			return nil, err
+		}
+		accs = append(accs, DevAccount{thor.Address(crypto.PubkeyToAddress(pk.PublicKey)), pk})
+	}
+	return accs, nil
+}
+
+// SetDevAccounts replaces accounts returned by DevAccounts.
+// It should be called before NewDevnet, since dev accounts are pre-alloced in devnet genesis.
+func SetDevAccounts(accs []DevAccount) {
+	devAccounts.Store(accs)
+}
+
 // DevAccounts returns pre-alloced accounts for solo mode.
+// The default 10 accounts are fixed, unless replaced by SetDevAccounts.
 func DevAccounts() []DevAccount {
 	if accs := devAccounts.Load(); accs != nil {
 		return accs.([]DevAccount)
@@ -57,15 +92,49 @@ func DevAccounts() []DevAccount {
 	return accs
 }
 
+// DevConfig optional parameters of devnet genesis. Zero values fall back to defaults.
+type DevConfig struct {
+	// LaunchTime is the timestamp of genesis block.
+	LaunchTime uint64
+	// GasLimit is the gas limit of genesis block.
+	GasLimit uint64
+	// BaseGasPrice is the initial base gas price.
+	BaseGasPrice *big.Int
+	// RewardRatio is the initial reward ratio.
+	RewardRatio *big.Int
+	// Extend is called with the builder after the devnet setup, to add extra states, hooks and calls.
+	Extend func(builder *Builder)
+}
+
 // NewDevnet create genesis for solo mode.
 func NewDevnet() *Genesis {
+	return NewDevnetWithConfig(DevConfig{})
+}
+
+// NewDevnetWithConfig create genesis for solo mode with config.
+func NewDevnetWithConfig(config DevConfig) *Genesis {
 	launchTime := uint64(1526400000) // 'Wed May 16 2018 00:00:00 GMT+0800 (CST)'
+	if config.LaunchTime != 0 {
+		launchTime = config.LaunchTime
+	}
+	gasLimit := thor.InitialGasLimit
+	if config.GasLimit != 0 {
+		gasLimit = config.GasLimit
+	}
+	baseGasPrice := thor.InitialBaseGasPrice
+	if config.BaseGasPrice != nil {
+		baseGasPrice = config.BaseGasPrice
+	}
+	rewardRatio := thor.InitialRewardRatio
+	if config.RewardRatio != nil {
+		rewardRatio = config.RewardRatio
+	}
 
 	executor := DevAccounts()[0].Address
 	soloBlockSigner := DevAccounts()[0]
 
 	builder := new(Builder).
-		GasLimit(thor.InitialGasLimit).
+		GasLimit(gasLimit).
 		Timestamp(launchTime).
 		State(func(state *state.State) error {
 			// alloc precompiled contracts
@@ -111,10 +180,10 @@ func NewDevnet() *Genesis {
 			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyExecutorAddress, new(big.Int).SetBytes(executor[:]))),
 			thor.Address{}).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyRewardRatio, thor.InitialRewardRatio)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyRewardRatio, rewardRatio)),
 			executor).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyBaseGasPrice, thor.InitialBaseGasPrice)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyBaseGasPrice, baseGasPrice)),
 			executor).
 		Call(
 			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyProposerEndorsement, thor.InitialProposerEndorsement)),
@@ -123,6 +192,10 @@ func NewDevnet() *Genesis {
 			tx.NewClause(&builtin.Authority.Address).WithData(mustEncodeInput(builtin.Authority.ABI, "add", soloBlockSigner.Address, soloBlockSigner.Address, thor.BytesToBytes32([]byte("Solo Block Signer")))),
 			executor)
 
+	if config.Extend != nil {
+		config.Extend(builder)
+	}
+
 	id, err := builder.ComputeID()
 	if err != nil {
 		panic(err)
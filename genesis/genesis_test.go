@@ -6,15 +6,26 @@
 package genesis_test
 
 import (
+	"errors"
+	"math/big"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
 )
 
+func M(args ...interface{}) []interface{} {
+	return args
+}
+
 func TestTestnetGenesis(t *testing.T) {
 	db := muxdb.NewMem()
 	gene := genesis.NewTestnet()
@@ -28,3 +39,277 @@ func TestTestnetGenesis(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, v)
 }
+
+func TestPresetGenesisID(t *testing.T) {
+	for _, c := range []struct {
+		gene *genesis.Genesis
+		name string
+		id   string
+	}{
+		{genesis.NewMainnet(), "mainnet", "0x00000000851caf3cfdb6e899cf5958bfb1ac3413d346d43539627e6be7ec1b4a"},
+		{genesis.NewTestnet(), "testnet", "0x000000000b2bce3c70bc649a02749e8687721b09ed2e15997f466536b20bb127"},
+	} {
+		assert.Equal(t, c.name, c.gene.Name())
+		assert.Equal(t, thor.MustParseBytes32(c.id), c.gene.ID())
+
+		// official networks have fork config
+		assert.NotEqual(t, thor.NoFork, thor.GetForkConfig(c.gene.ID()))
+
+		b0, _, _, err := c.gene.Build(state.NewStater(muxdb.NewMem()))
+		assert.Nil(t, err)
+		assert.Equal(t, c.gene.ID(), b0.Header().ID())
+	}
+}
+
+func TestCustomNetGenesis(t *testing.T) {
+	file, err := os.Open("example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	gen, err := genesis.DecodeCustomGenesis(file)
+	assert.Nil(t, err)
+	assert.Equal(t, thor.NoFork, *gen.ForkConfig)
+
+	gene, err := genesis.NewCustomNet(gen)
+	assert.Nil(t, err)
+
+	db := muxdb.NewMem()
+	b0, _, _, err := gene.Build(state.NewStater(db))
+	assert.Nil(t, err)
+	assert.Equal(t, gene.ID(), b0.Header().ID())
+
+	st := state.New(db, b0.Header().StateRoot())
+	addr := thor.MustParseAddress("0x7567d83b7b8d80addcb281a71d54fc7b3364ffed")
+	v, err := st.GetStorage(addr, thor.BytesToBytes32([]byte{1}))
+	assert.Nil(t, err)
+	assert.Equal(t, thor.BytesToBytes32([]byte{2}), v)
+
+	_, err = genesis.DecodeCustomGenesis(strings.NewReader(`{"unknownField": 1}`))
+	assert.NotNil(t, err)
+}
+
+func TestCustomNetRewardPolicy(t *testing.T) {
+	decode := func(s string) (runtime.RewardPolicy, error) {
+		gen, err := genesis.DecodeCustomGenesis(strings.NewReader(s))
+		assert.Nil(t, err)
+		return gen.RewardPolicy()
+	}
+
+	assert.Equal(t, M(runtime.DefaultRewardPolicy, nil), M(decode(`{}`)))
+	assert.Equal(t, M(runtime.NoRewardPolicy, nil), M(decode(`{"rewards": {"disabled": true}}`)))
+
+	policy, err := decode(`{"rewards": {"splits": [{"address": "0x7567d83b7b8d80addcb281a71d54fc7b3364ffed", "share": 5000}]}}`)
+	assert.Nil(t, err)
+	assert.NotEqual(t, runtime.DefaultRewardPolicy, policy)
+
+	_, err = decode(`{"rewards": {"splits": [{"address": "0x7567d83b7b8d80addcb281a71d54fc7b3364ffed", "share": 10001}]}}`)
+	assert.NotNil(t, err)
+}
+
+func TestBuilderDeployContract(t *testing.T) {
+	addr := thor.BytesToAddress([]byte("contract"))
+	code := []byte{0x60, 0x60, 0x60, 0x40, 0x52}
+	key := thor.BytesToBytes32([]byte("key"))
+	val := thor.BytesToBytes32([]byte("val"))
+
+	builder := new(genesis.Builder).
+		Timestamp(1000).
+		GasLimit(thor.InitialGasLimit).
+		State(func(state *state.State) error {
+			return state.SetCode(builtin.Energy.Address, builtin.Energy.RuntimeBytecodes())
+		}).
+		DeployContract(addr, code, map[thor.Bytes32]thor.Bytes32{key: val}, big.NewInt(100))
+
+	db := muxdb.NewMem()
+	b0, _, _, err := builder.Build(state.NewStater(db))
+	assert.Nil(t, err)
+
+	st := state.New(db, b0.Header().StateRoot())
+	assert.Equal(t, M(code, nil), M(st.GetCode(addr)))
+	assert.Equal(t, M(val, nil), M(st.GetStorage(addr, key)))
+	assert.Equal(t, M(big.NewInt(100), nil), M(st.GetEnergy(addr, 1000)))
+	assert.Equal(t, M(big.NewInt(100), nil), M(builtin.Energy.Native(st, 1000).TotalSupply()))
+}
+
+func TestBuilderCallWithValue(t *testing.T) {
+	caller := thor.BytesToAddress([]byte("caller"))
+	treasury := thor.BytesToAddress([]byte("treasury"))
+
+	newBuilder := func(balance int64) *genesis.Builder {
+		return new(genesis.Builder).
+			GasLimit(thor.InitialGasLimit).
+			State(func(state *state.State) error {
+				state.SetBalance(caller, big.NewInt(balance))
+				return state.SetEnergy(caller, &big.Int{}, 0)
+			}).
+			Call(tx.NewClause(&treasury).WithValue(big.NewInt(100)), caller)
+	}
+
+	db := muxdb.NewMem()
+	b0, _, transfers, err := newBuilder(1000).Build(state.NewStater(db))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(transfers))
+	assert.Equal(t, caller, transfers[0].Sender)
+	assert.Equal(t, treasury, transfers[0].Recipient)
+
+	st := state.New(db, b0.Header().StateRoot())
+	assert.Equal(t, M(big.NewInt(900), nil), M(st.GetBalance(caller)))
+	assert.Equal(t, M(big.NewInt(100), nil), M(st.GetBalance(treasury)))
+
+	_, _, _, err = newBuilder(99).Build(state.NewStater(muxdb.NewMem()))
+	assert.NotNil(t, err)
+}
+
+func TestBuilderAllocFromCSV(t *testing.T) {
+	a1 := thor.BytesToAddress([]byte("a1"))
+	a2 := thor.BytesToAddress([]byte("a2"))
+
+	builder := new(genesis.Builder).GasLimit(thor.InitialGasLimit).
+		State(func(state *state.State) error {
+			if err := state.SetCode(builtin.Energy.Address, builtin.Energy.RuntimeBytecodes()); err != nil {
+				return err
+			}
+			// built-in allocation
+			return builtin.Energy.Native(state, 0).SetInitialSupply(big.NewInt(1), big.NewInt(2))
+		}).
+		AllocFromCSV(strings.NewReader(
+			"# address,balance,energy\n" +
+				a1.String() + ",1000,20\n" +
+				a2.String() + ", 5,\n"))
+
+	db := muxdb.NewMem()
+	b0, _, _, err := builder.Build(state.NewStater(db))
+	assert.Nil(t, err)
+
+	// records are read again on each build
+	again, _, _, err := builder.Build(state.NewStater(muxdb.NewMem()))
+	assert.Nil(t, err)
+	assert.Equal(t, b0.Header().ID(), again.Header().ID())
+
+	st := state.New(db, b0.Header().StateRoot())
+	assert.Equal(t, M(big.NewInt(1000), nil), M(st.GetBalance(a1)))
+	assert.Equal(t, M(big.NewInt(20), nil), M(st.GetEnergy(a1, 0)))
+	assert.Equal(t, M(big.NewInt(5), nil), M(st.GetBalance(a2)))
+	assert.Equal(t, M(&big.Int{}, nil), M(st.GetEnergy(a2, 0)))
+	assert.Equal(t, M(big.NewInt(1006), nil), M(builtin.Energy.Native(st, 0).TokenTotalSupply()))
+	assert.Equal(t, M(big.NewInt(22), nil), M(builtin.Energy.Native(st, 0).TotalSupply()))
+
+	for _, invalid := range []string{
+		"0x1,1,1\n",
+		a1.String() + ",-1,1\n",
+		a1.String() + ",1,x\n",
+		a1.String() + ",1\n",
+	} {
+		_, _, _, err := new(genesis.Builder).AllocFromCSV(strings.NewReader(invalid)).Build(state.NewStater(muxdb.NewMem()))
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestDevAccounts(t *testing.T) {
+	mnemonic := "denial kitchen pet squirrel other broom bar gas better priority spoil cross"
+	accs, err := genesis.DeriveDevAccounts(mnemonic, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, len(accs))
+
+	// deterministic
+	again, _ := genesis.DeriveDevAccounts(mnemonic, 2)
+	assert.Equal(t, accs[:2], again)
+
+	defaults := genesis.DevAccounts()
+	assert.Equal(t, 10, len(defaults))
+	defaultID := genesis.NewDevnet().ID()
+
+	genesis.SetDevAccounts(accs)
+	defer genesis.SetDevAccounts(defaults)
+
+	assert.Equal(t, accs, genesis.DevAccounts())
+	assert.NotEqual(t, defaultID, genesis.NewDevnet().ID())
+}
+
+func TestExtraData(t *testing.T) {
+	newBuilder := func() *genesis.Builder {
+		return new(genesis.Builder).
+			Timestamp(1000).
+			GasLimit(thor.InitialGasLimit)
+	}
+
+	var extra [28]byte
+	copy(extra[:], "network 1")
+	id1, err := newBuilder().ExtraData(extra).ComputeID()
+	assert.Nil(t, err)
+
+	copy(extra[:], "network 2")
+	id2, err := newBuilder().ExtraData(extra).ComputeID()
+	assert.Nil(t, err)
+	assert.NotEqual(t, id1, id2, "identical specs with distinct extra data should have distinct ids")
+
+	_, err = genesis.NewCustomNet(&genesis.CustomGenesis{
+		ExtraData: "longer than twenty-eight bytes",
+		Authority: []genesis.Authority{{}},
+	})
+	assert.EqualError(t, err, "extraData should not be longer than 28 bytes")
+}
+
+func TestVerify(t *testing.T) {
+	db := muxdb.NewMem()
+	gene := genesis.NewDevnet()
+	b0, _, _, err := gene.Build(state.NewStater(db))
+	assert.Nil(t, err)
+
+	assert.Nil(t, gene.Verify(db, b0.Header()))
+	assert.NotNil(t, genesis.NewTestnet().Verify(db, b0.Header()), "id mismatch")
+	assert.NotNil(t, gene.Verify(muxdb.NewMem(), b0.Header()), "state missing")
+}
+
+func TestDevnetWithConfig(t *testing.T) {
+	assert.Equal(t, genesis.NewDevnet().ID(), genesis.NewDevnetWithConfig(genesis.DevConfig{}).ID())
+
+	gene := genesis.NewDevnetWithConfig(genesis.DevConfig{
+		LaunchTime:   2000000000,
+		GasLimit:     20000000,
+		BaseGasPrice: big.NewInt(1e14),
+		RewardRatio:  big.NewInt(1e17),
+	})
+	db := muxdb.NewMem()
+	b0, _, _, err := gene.Build(state.NewStater(db))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2000000000), b0.Header().Timestamp())
+	assert.Equal(t, uint64(20000000), b0.Header().GasLimit())
+
+	st := state.New(db, b0.Header().StateRoot())
+	params := builtin.Params.Native(st)
+	assert.Equal(t, M(big.NewInt(1e14), nil), M(params.Get(thor.KeyBaseGasPrice)))
+	assert.Equal(t, M(big.NewInt(1e17), nil), M(params.Get(thor.KeyRewardRatio)))
+}
+
+func TestBuilderHook(t *testing.T) {
+	addr := thor.BytesToAddress([]byte("plugin"))
+	setCode := func(code []byte) func(*state.State) error {
+		return func(state *state.State) error {
+			return state.SetCode(addr, code)
+		}
+	}
+
+	gene := genesis.NewDevnetWithConfig(genesis.DevConfig{
+		Extend: func(builder *genesis.Builder) {
+			builder.
+				Hook("plugin", setCode([]byte{1})).
+				Hook("other", func(state *state.State) error { return nil }).
+				Hook("plugin", setCode([]byte{2}))
+		},
+	})
+	assert.NotEqual(t, genesis.NewDevnet().ID(), gene.ID())
+
+	db := muxdb.NewMem()
+	b0, _, _, err := gene.Build(state.NewStater(db))
+	assert.Nil(t, err)
+	st := state.New(db, b0.Header().StateRoot())
+	assert.Equal(t, M([]byte{2}, nil), M(st.GetCode(addr)))
+
+	_, _, _, err = new(genesis.Builder).
+		Hook("bad", func(*state.State) error { return errors.New("bad") }).
+		Build(state.NewStater(muxdb.NewMem()))
+	assert.EqualError(t, err, "hook bad: bad")
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package genesis
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const hardenedKeyStart = uint32(0x80000000)
+
+// hdKey is the extended private key defined by BIP32.
+type hdKey struct {
+	key       *big.Int
+	chainCode []byte
+}
+
+// mnemonicToSeed converts BIP39 mnemonic into seed, with empty passphrase.
+func mnemonicToSeed(mnemonic string) []byte {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	return pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"), 2048, 64, sha512.New)
+}
+
+// newMasterHDKey creates master key from seed.
+func newMasterHDKey(seed []byte) *hdKey {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	return &hdKey{new(big.Int).SetBytes(sum[:32]), sum[32:]}
+}
+
+// child derives the child key at index i. Index not less than hardenedKeyStart derives hardened key.
+func (k *hdKey) child(i uint32) (*hdKey, error) {
+	var data []byte
+	if i >= hardenedKeyStart {
+		data = append([]byte{0}, k.keyBytes()...)
+	} else {
+		priv, err := k.privateKey()
+		if err != nil {
+			return nil, err
+		}
+		data = crypto.CompressPubkey(&priv.PublicKey)
+	}
+	var index [4]byte
+	binary.BigEndian.PutUint32(index[:], i)
+	data = append(data, index[:]...)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(n) >= 0 {
+		return nil, errors.New("invalid derived key")
+	}
+	childKey := il.Add(il, k.key)
+	childKey.Mod(childKey, n)
+	if childKey.Sign() == 0 {
+		return nil, errors.New("invalid derived key")
+	}
+	return &hdKey{childKey, sum[32:]}, nil
+}
+
+func (k *hdKey) keyBytes() []byte {
+	b := make([]byte, 32)
+	kb := k.key.Bytes()
+	copy(b[32-len(kb):], kb)
+	return b
+}
+
+func (k *hdKey) privateKey() (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(k.keyBytes())
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package genesis
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// Verify checks the stored genesis block header and its state in db against the genesis.
+//
+// The genesis state is rebuilt in memory and compared with the stored one leaf by leaf,
+// so that missing or corrupt state data is detected, besides mismatched id.
+func (g *Genesis) Verify(db *muxdb.MuxDB, stored *block.Header) error {
+	if stored.ID() != g.id {
+		return fmt.Errorf("genesis id mismatch: want %v, got %v", g.id, stored.ID())
+	}
+
+	memDB := muxdb.NewMem()
+	b, _, _, err := g.builder.Build(state.NewStater(memDB))
+	if err != nil {
+		return errors.Wrap(err, "rebuild genesis")
+	}
+	root := b.Header().StateRoot()
+	if root != stored.StateRoot() {
+		return fmt.Errorf("genesis state root mismatch: want %v, got %v", root, stored.StateRoot())
+	}
+
+	return compareTrieLeaves(
+		memDB.NewSecureTrie(state.AccountTrieName, root),
+		db.NewSecureTrie(state.AccountTrieName, root),
+		func(key, val []byte) error {
+			var acc state.Account
+			if err := rlp.DecodeBytes(val, &acc); err != nil {
+				return err
+			}
+			if len(acc.StorageRoot) == 0 {
+				return nil
+			}
+			// keys of secure trie are hashed addresses
+			name := state.StorageTrieName(thor.BytesToBytes32(key))
+			storageRoot := thor.BytesToBytes32(acc.StorageRoot)
+			return compareTrieLeaves(
+				memDB.NewSecureTrie(name, storageRoot),
+				db.NewSecureTrie(name, storageRoot),
+				func(key, val []byte) error { return nil })
+		})
+}
+
+// compareTrieLeaves checks that the actual trie has exactly the same leaves as the expected one.
+// fn is called for each leaf.
+func compareTrieLeaves(expected, actual *muxdb.Trie, fn func(key, val []byte) error) error {
+	eit := trie.NewIterator(expected.NodeIterator(nil))
+	ait := trie.NewIterator(actual.NodeIterator(nil))
+	for eit.Next() {
+		if !ait.Next() {
+			if ait.Err != nil {
+				return errors.Wrapf(ait.Err, "trie %v", actual.Name())
+			}
+			return fmt.Errorf("trie %v: missing leaf %x", actual.Name(), eit.Key)
+		}
+		if !bytes.Equal(eit.Key, ait.Key) || !bytes.Equal(eit.Value, ait.Value) {
+			return fmt.Errorf("trie %v: leaf mismatch %x", actual.Name(), eit.Key)
+		}
+		if err := fn(eit.Key, eit.Value); err != nil {
+			return err
+		}
+	}
+	if eit.Err != nil {
+		return eit.Err
+	}
+	if ait.Next() {
+		return fmt.Errorf("trie %v: unexpected leaf %x", actual.Name(), ait.Key)
+	}
+	if ait.Err != nil {
+		return errors.Wrapf(ait.Err, "trie %v", actual.Name())
+	}
+	return nil
+}
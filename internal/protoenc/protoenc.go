@@ -0,0 +1,77 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package protoenc provides helpers to hand-code protobuf messages, shared by block and tx.
+package protoenc
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ErrInvalid is returned when data is not a well-formed protobuf message.
+var ErrInvalid = errors.New("invalid protobuf encoding")
+
+// AppendVarintField appends a varint field. Zero value is omitted, as proto3 does.
+func AppendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// AppendBytesField appends a length-delimited field. Empty value is omitted, as proto3 does.
+func AppendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// AppendRepeatedBytesField appends a length-delimited field, even if empty, as an element of repeated field.
+func AppendRepeatedBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// ConsumeFields iterates fields of a protobuf message.
+// Varint fields are passed as v, and length-delimited fields as data. Others are skipped.
+func ConsumeFields(data []byte, fn func(num protowire.Number, v uint64, data []byte) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return ErrInvalid
+		}
+		data = data[n:]
+
+		var (
+			v     uint64
+			field []byte
+		)
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			field, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+			if n >= 0 {
+				data = data[n:]
+				continue
+			}
+		}
+		if n < 0 {
+			return ErrInvalid
+		}
+		if err := fn(num, v, field); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
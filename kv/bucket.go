@@ -0,0 +1,133 @@
+// Copyright (c) 2019 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package kv
+
+// Bucket uses prefix to simulate bucket.
+type Bucket []byte
+
+// ProxyGetter returns a getter which reads keys in the bucket.
+func (b Bucket) ProxyGetter(getter Getter) Getter {
+	return &struct {
+		GetFunc
+		HasFunc
+	}{
+		func(key []byte) ([]byte, error) {
+			return getter.Get(b.makeKey(key))
+		},
+		func(key []byte) (bool, error) {
+			return getter.Has(b.makeKey(key))
+		},
+	}
+}
+
+// ProxyPutter returns a putter which writes keys in the bucket.
+func (b Bucket) ProxyPutter(putter Putter) Putter {
+	return &struct {
+		PutFunc
+		DeleteFunc
+	}{
+		func(key, val []byte) error {
+			return putter.Put(b.makeKey(key), val)
+		},
+		func(key []byte) error {
+			return putter.Delete(b.makeKey(key))
+		},
+	}
+}
+
+// ProxyGetPutter returns a GetPutter which reads and writes keys in the bucket.
+func (b Bucket) ProxyGetPutter(gp GetPutter) GetPutter {
+	return &struct {
+		Getter
+		Putter
+	}{
+		b.ProxyGetter(gp),
+		b.ProxyPutter(gp),
+	}
+}
+
+// ProxyStore returns a store which contains only keys in the bucket.
+func (b Bucket) ProxyStore(src Store) Store {
+	return &struct {
+		Getter
+		Putter
+		SnapshotFunc
+		BatchFunc
+		IterateFunc
+		IsNotFoundFunc
+	}{
+		b.ProxyGetter(src),
+		b.ProxyPutter(src),
+		func(fn func(Getter) error) error {
+			return src.Snapshot(func(getter Getter) error {
+				return fn(b.ProxyGetter(getter))
+			})
+		},
+		func(fn func(PutFlusher) error) error {
+			return src.Batch(func(putter PutFlusher) error {
+				return fn(struct {
+					Putter
+					FlushFunc
+				}{
+					b.ProxyPutter(putter),
+					putter.Flush,
+				})
+			})
+		},
+		func(r Range, fn func(Pair) bool) error {
+			return src.Iterate(b.MakeRange(r), func(pair Pair) bool {
+				return fn(b.MakePair(pair))
+			})
+		},
+		src.IsNotFound,
+	}
+}
+
+// MakeRange converts the range into bucket key space.
+// Like engines, the limit is exclusive, and nil limit means the end of the bucket.
+func (b Bucket) MakeRange(r Range) Range {
+	r.Start = b.makeKey(r.Start)
+	if r.Limit == nil {
+		r.Limit = prefixLimit(b)
+	} else {
+		r.Limit = b.makeKey(r.Limit)
+	}
+	return r
+}
+
+// MakePair converts the pair from bucket key space.
+func (b Bucket) MakePair(pair Pair) Pair {
+	return &struct {
+		KeyFunc
+		ValueFunc
+	}{
+		func() []byte {
+			// skip bucket prefix
+			return pair.Key()[len(b):]
+		},
+		pair.Value,
+	}
+}
+
+func (b Bucket) makeKey(key []byte) []byte {
+	newKey := make([]byte, 0, len(b)+len(key))
+	return append(append(newKey, b...), key...)
+}
+
+// prefixLimit returns the limit of key range which covers all keys with the given prefix.
+func prefixLimit(prefix []byte) []byte {
+	var limit []byte
+	for i := len(prefix) - 1; i >= 0; i-- {
+		c := prefix[i]
+		if c < 0xff {
+			limit = make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i] = c + 1
+			break
+		}
+	}
+	return limit
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package kv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/muxdb"
+)
+
+func M(args ...interface{}) []interface{} {
+	return args
+}
+
+func TestBucketProxyGetterPutter(t *testing.T) {
+	db := muxdb.NewMem().LowStore()
+	originalKey := []byte("key")
+	value := []byte("value")
+	bkt := kv.Bucket("bucket")
+	getter := bkt.ProxyGetter(db)
+	putter := bkt.ProxyPutter(db)
+
+	assert.Nil(t, putter.Put(originalKey, value))
+	_, err := db.Get(originalKey)
+	assert.True(t, db.IsNotFound(err))
+
+	assert.Equal(t, M(value, nil), M(getter.Get(originalKey)))
+	assert.Equal(t, M(true, nil), M(getter.Has(originalKey)))
+
+	assert.Nil(t, putter.Delete(originalKey))
+	_, err = getter.Get(originalKey)
+	assert.True(t, db.IsNotFound(err))
+	assert.Equal(t, M(false, nil), M(getter.Has(originalKey)))
+}
+
+func TestBucketProxyStore(t *testing.T) {
+	db := muxdb.NewMem().LowStore()
+	outer := kv.Bucket("o").ProxyStore(db)
+	inner := kv.Bucket("i").ProxyStore(outer)
+
+	assert.Nil(t, inner.Batch(func(putter kv.PutFlusher) error {
+		for _, k := range []string{"a", "b", "c"} {
+			if err := putter.Put([]byte(k), []byte(k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	assert.Nil(t, outer.Put([]byte("j"), []byte("j")))
+
+	iterate := func(s kv.Store, rng kv.Range) (keys []string) {
+		assert.Nil(t, s.Iterate(rng, func(pair kv.Pair) bool {
+			keys = append(keys, string(pair.Key()))
+			return true
+		}))
+		return
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, iterate(inner, kv.Range{}))
+	assert.Equal(t, []string{"b"}, iterate(inner, kv.Range{Start: []byte("b"), Limit: []byte("c")}))
+	assert.Equal(t, []string{"ia", "ib", "ic", "j"}, iterate(outer, kv.Range{}))
+	// limit is exclusive, not a prefix
+	assert.Equal(t, []string{"a", "b"}, iterate(inner, kv.Range{Limit: []byte("c")}))
+	assert.Equal(t, []string{"ic"}, iterate(outer, kv.Range{Start: []byte("ic"), Limit: []byte("j")}))
+
+	assert.Nil(t, inner.Snapshot(func(getter kv.Getter) error {
+		assert.Equal(t, M([]byte("a"), nil), M(getter.Get([]byte("a"))))
+		return nil
+	}))
+}
+
+func TestBucketIterator(t *testing.T) {
+	db := muxdb.NewMem().LowStore()
+	store := kv.Bucket("b").ProxyStore(db)
+	for _, k := range []string{"a\xff", "a\xff\x00", "a\xff\xff", "b", "b\x00"} {
+		assert.Nil(t, store.Put([]byte(k), nil))
+	}
+	// keys right after the bucket
+	assert.Nil(t, db.Put([]byte("c"), nil))
+
+	iterate := func(prefix string) (keys []string) {
+		it := kv.NewIterator(store, []byte(prefix), nil)
+		for it.Next() {
+			keys = append(keys, string(it.Key()))
+		}
+		assert.Nil(t, it.Error())
+		return
+	}
+	assert.Equal(t, []string{"a\xff", "a\xff\x00", "a\xff\xff", "b", "b\x00"}, iterate(""))
+	assert.Equal(t, []string{"a\xff", "a\xff\x00", "a\xff\xff"}, iterate("a\xff"))
+	assert.Equal(t, []string{"b", "b\x00"}, iterate("b"))
+}
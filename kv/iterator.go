@@ -0,0 +1,91 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package kv
+
+// max count of pairs fetched by each short-range iteration.
+const iteratorBatchSize = 256
+
+// Iterator iterates over pairs with the given key prefix, in ascending key order.
+//
+// The source is scanned by short-range iterations, so no snapshot is held for long time,
+// and it's safe to write the source while iterating. Writes behind the iterator position
+// are not visible to the iterator.
+type Iterator struct {
+	src   Store
+	rng   Range
+	pairs [][2][]byte
+	pos   int
+	done  bool
+	err   error
+}
+
+// NewIterator creates an iterator over pairs with the given key prefix.
+// The optional start is the position relative to prefix, where the iteration starts from.
+func NewIterator(src Store, prefix, start []byte) *Iterator {
+	rangeStart := make([]byte, 0, len(prefix)+len(start))
+	rangeStart = append(append(rangeStart, prefix...), start...)
+	return &Iterator{
+		src: src,
+		rng: Range{
+			Start: rangeStart,
+			Limit: prefixLimit(prefix),
+		},
+	}
+}
+
+// Next moves the iterator to the next pair. It returns false if no more pairs or error occurred.
+func (i *Iterator) Next() bool {
+	if i.err != nil {
+		return false
+	}
+	if i.pos+1 < len(i.pairs) {
+		i.pos++
+		return true
+	}
+	if i.done {
+		i.pairs = nil
+		return false
+	}
+
+	i.pairs = i.pairs[:0]
+	i.pos = 0
+	if err := i.src.Iterate(i.rng, func(pair Pair) bool {
+		i.pairs = append(i.pairs, [2][]byte{
+			append([]byte(nil), pair.Key()...),
+			append([]byte(nil), pair.Value()...),
+		})
+		return len(i.pairs) < iteratorBatchSize
+	}); err != nil {
+		i.err = err
+		i.pairs = nil
+		return false
+	}
+	if len(i.pairs) < iteratorBatchSize {
+		i.done = true
+	}
+	if len(i.pairs) == 0 {
+		return false
+	}
+	// continue from the key next to the last one
+	lastKey := i.pairs[len(i.pairs)-1][0]
+	i.rng.Start = append(append(make([]byte, 0, len(lastKey)+1), lastKey...), 0)
+	return true
+}
+
+// Key returns the key of current pair.
+func (i *Iterator) Key() []byte {
+	return i.pairs[i.pos][0]
+}
+
+// Value returns the value of current pair.
+func (i *Iterator) Value() []byte {
+	return i.pairs[i.pos][1]
+}
+
+// Error returns error occurred during iteration.
+func (i *Iterator) Error() error {
+	return i.err
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package kv_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/muxdb"
+)
+
+func TestIterator(t *testing.T) {
+	store := muxdb.NewMem().NewStore("test")
+
+	const n = 1000
+	for _, prefix := range []string{"a", "b", "c"} {
+		for i := 0; i < n; i++ {
+			var key [5]byte
+			key[0] = prefix[0]
+			binary.BigEndian.PutUint32(key[1:], uint32(i))
+			assert.Nil(t, store.Put(key[:], []byte(prefix)))
+		}
+	}
+
+	// count pairs and check order
+	iterate := func(prefix, start []byte) (count int) {
+		it := kv.NewIterator(store, prefix, start)
+		var last []byte
+		for it.Next() {
+			if last != nil {
+				assert.True(t, string(last) < string(it.Key()))
+			}
+			last = it.Key()
+			assert.Equal(t, prefix, it.Value())
+			count++
+		}
+		assert.Nil(t, it.Error())
+		return
+	}
+
+	assert.Equal(t, n, iterate([]byte("b"), nil))
+	assert.Equal(t, n, iterate([]byte("c"), nil))
+	assert.Equal(t, 0, iterate([]byte("d"), nil))
+
+	var start [4]byte
+	binary.BigEndian.PutUint32(start[:], 300)
+	assert.Equal(t, n-300, iterate([]byte("a"), start[:]))
+
+	count := 0
+	it := kv.NewIterator(store, nil, nil)
+	for it.Next() {
+		// deleting while iterating is safe
+		assert.Nil(t, store.Delete(it.Key()))
+		count++
+	}
+	assert.Nil(t, it.Error())
+	assert.Equal(t, 3*n, count)
+	assert.Equal(t, 0, iterate([]byte("a"), nil))
+}
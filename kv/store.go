@@ -17,6 +17,12 @@ type Putter interface {
 	Delete(key []byte) error
 }
 
+// GetPutter defines methods to read and write kv.
+type GetPutter interface {
+	Getter
+	Putter
+}
+
 // PutFlusher defines putter with Flush method.
 type PutFlusher interface {
 	Putter
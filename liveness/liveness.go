@@ -0,0 +1,191 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package liveness tracks authority nodes which missed their proposer slots.
+package liveness
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	storeName = "liveness"
+
+	// DefaultWindow is the default count of recent blocks, over which missed slots are counted.
+	// It's about one day with the default block interval.
+	DefaultWindow = thor.CheckpointInterval * 48
+)
+
+// Report is reported when the missed count of a proposer reaches the threshold.
+type Report struct {
+	Address thor.Address `json:"address"`
+	Missed  uint32       `json:"missed"`
+	Window  uint32       `json:"window"`
+	Number  uint32       `json:"number"` // number of the block at which the threshold is reached
+}
+
+// Hook is called when a report is made, e.g. to report the proposer to the Authority contract.
+// It's called synchronously, and should return quickly.
+type Hook func(r *Report)
+
+// Tracker counts missed slots of proposers over a sliding window of recent blocks.
+// Missed slots of each block are persisted, and counters are restored from them.
+// It's safe for concurrent use.
+type Tracker struct {
+	store     kv.Store
+	window    uint32
+	threshold uint32
+	hook      Hook
+
+	lock   sync.Mutex
+	loaded bool
+	head   uint32
+	counts map[thor.Address]uint32
+}
+
+// New creates a tracker. The hook is optional, and disabled if threshold is 0.
+func New(db *muxdb.MuxDB, window uint32, threshold uint32, hook Hook) *Tracker {
+	return &Tracker{
+		store:     db.NewStore(storeName),
+		window:    window,
+		threshold: threshold,
+		hook:      hook,
+	}
+}
+
+func makeKey(num uint32) []byte {
+	var key [4]byte
+	binary.BigEndian.PutUint32(key[:], num)
+	return key[:]
+}
+
+func (t *Tracker) getMissed(num uint32) (missed []thor.Address, err error) {
+	data, err := t.store.Get(makeKey(num))
+	if err != nil {
+		if t.store.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	err = rlp.DecodeBytes(data, &missed)
+	return
+}
+
+func (t *Tracker) deleteMissed(num uint32) error {
+	if err := t.store.Delete(makeKey(num)); err != nil && !t.store.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// windowStart returns number of the first block in the window ending at num.
+func (t *Tracker) windowStart(num uint32) uint32 {
+	if num >= t.window {
+		return num - t.window + 1
+	}
+	return 0
+}
+
+// load restores counters of blocks in the window before num.
+func (t *Tracker) load(num uint32) error {
+	counts := make(map[thor.Address]uint32)
+	for n := t.windowStart(num); n < num; n++ {
+		missed, err := t.getMissed(n)
+		if err != nil {
+			return err
+		}
+		for _, addr := range missed {
+			counts[addr]++
+		}
+	}
+	t.counts = counts
+	t.loaded = true
+	return nil
+}
+
+// Observe records proposers who missed slots right before the block numbered num, and slides the window to it.
+// Blocks are expected to be observed as they become the best block, and a block with the same
+// number replaces the one observed before.
+func (t *Tracker) Observe(num uint32, missed []thor.Address) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// the window before num is reloaded, unless sliding from the previous block
+	sliding := t.loaded && num == t.head+1
+	if !sliding {
+		if err := t.load(num); err != nil {
+			return err
+		}
+	}
+
+	if len(missed) > 0 {
+		data, err := rlp.EncodeToBytes(missed)
+		if err != nil {
+			return err
+		}
+		if err := t.store.Put(makeKey(num), data); err != nil {
+			return err
+		}
+	} else if err := t.deleteMissed(num); err != nil {
+		return err
+	}
+
+	for _, addr := range missed {
+		t.counts[addr]++
+		if t.hook != nil && t.threshold > 0 && t.counts[addr] == t.threshold {
+			t.hook(&Report{
+				Address: addr,
+				Missed:  t.counts[addr],
+				Window:  t.window,
+				Number:  num,
+			})
+		}
+	}
+
+	// slide out the block leaving the window
+	if num >= t.window {
+		expired := num - t.window
+		if sliding {
+			missed, err := t.getMissed(expired)
+			if err != nil {
+				return err
+			}
+			for _, addr := range missed {
+				if t.counts[addr]--; t.counts[addr] == 0 {
+					delete(t.counts, addr)
+				}
+			}
+		}
+		if err := t.deleteMissed(expired); err != nil {
+			return err
+		}
+	}
+	t.head = num
+	return nil
+}
+
+// Counts returns missed counts of proposers in the window ending at the last observed block,
+// along with number of that block.
+func (t *Tracker) Counts() (map[thor.Address]uint32, uint32) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	counts := make(map[thor.Address]uint32, len(t.counts))
+	for addr, n := range t.counts {
+		counts[addr] = n
+	}
+	return counts, t.head
+}
+
+// Window returns the count of blocks in the window.
+func (t *Tracker) Window() uint32 {
+	return t.window
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package liveness_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/liveness"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestTracker(t *testing.T) {
+	var (
+		db     = muxdb.NewMem()
+		a1     = thor.BytesToAddress([]byte("a1"))
+		a2     = thor.BytesToAddress([]byte("a2"))
+		hooked []*liveness.Report
+	)
+	tracker := liveness.New(db, 3, 2, func(r *liveness.Report) {
+		hooked = append(hooked, r)
+	})
+
+	assert.Nil(t, tracker.Observe(1, []thor.Address{a1}))
+	assert.Nil(t, tracker.Observe(2, []thor.Address{a1, a2}))
+	assert.Equal(t, M(map[thor.Address]uint32{a1: 2, a2: 1}, uint32(2)), M(tracker.Counts()))
+	assert.Equal(t, []*liveness.Report{{Address: a1, Missed: 2, Window: 3, Number: 2}}, hooked)
+
+	// block 1 slides out
+	assert.Nil(t, tracker.Observe(3, nil))
+	assert.Nil(t, tracker.Observe(4, nil))
+	assert.Equal(t, M(map[thor.Address]uint32{a1: 1, a2: 1}, uint32(4)), M(tracker.Counts()))
+
+	// block 4 replaced, and counters restored by a new tracker
+	tracker = liveness.New(db, 3, 2, nil)
+	assert.Nil(t, tracker.Observe(4, []thor.Address{a2}))
+	assert.Equal(t, M(map[thor.Address]uint32{a1: 1, a2: 2}, uint32(4)), M(tracker.Counts()))
+}
+
+func M(a ...interface{}) []interface{} {
+	return a
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/vechain/thor/kv"
+)
+
+// max count of pairs written by each batch during backup.
+const backupBatchSize = 4096
+
+// Backup copies a consistent snapshot of the whole DB into a new leveldb database at dir,
+// while the DB keeps serving reads and writes. The backup can be opened by Open with EngineLevelDB.
+//
+// Values are copied as is, so the backup of an encrypted DB is also encrypted with the same key.
+func (db *MuxDB) Backup(dir string) error {
+	if entries, err := ioutil.ReadDir(dir); err == nil && len(entries) > 0 {
+		return errors.New("backup dir not empty")
+	} else if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	ldb, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return err
+	}
+	dst := newLevelEngine(ldb)
+	defer dst.Close()
+
+	src := db.engine
+	if encrypted, ok := src.(*encryptedEngine); ok {
+		src = encrypted.engine
+	}
+
+	return dst.Batch(func(putter kv.PutFlusher) error {
+		var (
+			n      int
+			putErr error
+		)
+		// a single iteration always sees a consistent snapshot of the engine
+		if err := src.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+			if putErr = putter.Put(pair.Key(), pair.Value()); putErr != nil {
+				return false
+			}
+			if n++; n%backupBatchSize == 0 {
+				putErr = putter.Flush()
+			}
+			return putErr == nil
+		}); err != nil {
+			return err
+		}
+		return putErr
+	})
+}
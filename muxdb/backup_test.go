@@ -0,0 +1,44 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+)
+
+func TestBackup(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "muxdb-backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	db := NewMem()
+	store := db.NewStore("s")
+	assert.Nil(t, store.Put([]byte("k"), []byte("v")))
+
+	tr := db.NewTrie("t", thor.Bytes32{})
+	assert.Nil(t, tr.Update([]byte("tk"), []byte("tv")))
+	root, err := tr.Commit()
+	assert.Nil(t, err)
+
+	dir := filepath.Join(tmp, "backup")
+	assert.Nil(t, db.Backup(dir))
+	assert.NotNil(t, db.Backup(dir), "should fail on non-empty dir")
+
+	backup, err := Open(dir, &Options{})
+	assert.Nil(t, err)
+	defer backup.Close()
+
+	assert.Equal(t, M([]byte("v"), nil), M(backup.NewStore("s").Get([]byte("k"))))
+	assert.Equal(t, M([]byte("tv"), nil), M(backup.NewTrie("t", root).Get([]byte("tk"))))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"bytes"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/vechain/thor/kv"
+)
+
+type badgerEngine struct {
+	db *badger.DB
+}
+
+// openBadgerEngine opens badger db at the given path, as an alternative engine to leveldb.
+func openBadgerEngine(path string, options *Options) (engine, error) {
+	opts := badger.DefaultOptions(path).WithLogger(nil).WithReadOnly(options.ReadOnly)
+	if options.ReadCacheMB > 0 {
+		opts = opts.WithBlockCacheSize(int64(options.ReadCacheMB) << 20)
+	}
+	if options.WriteBufferMB > 0 {
+		opts = opts.WithMaxTableSize(int64(options.WriteBufferMB) << 20)
+	}
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &badgerEngine{db}, nil
+}
+
+func (bdb *badgerEngine) Close() error {
+	return bdb.db.Close()
+}
+
+func (bdb *badgerEngine) IsNotFound(err error) bool {
+	return err == badger.ErrKeyNotFound
+}
+
+func (bdb *badgerEngine) Get(key []byte) (val []byte, err error) {
+	err = bdb.db.View(func(txn *badger.Txn) error {
+		val, err = txnGet(txn, key)
+		return err
+	})
+	return
+}
+
+func (bdb *badgerEngine) Has(key []byte) (has bool, err error) {
+	err = bdb.db.View(func(txn *badger.Txn) error {
+		has, err = txnHas(txn, key)
+		return err
+	})
+	return
+}
+
+func (bdb *badgerEngine) Put(key, val []byte) error {
+	return bdb.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, val)
+	})
+}
+
+func (bdb *badgerEngine) Delete(key []byte) error {
+	return bdb.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+func (bdb *badgerEngine) Snapshot(fn func(kv.Getter) error) error {
+	txn := bdb.db.NewTransaction(false)
+	defer txn.Discard()
+
+	return fn(&struct {
+		kv.GetFunc
+		kv.HasFunc
+	}{
+		func(key []byte) ([]byte, error) { return txnGet(txn, key) },
+		func(key []byte) (bool, error) { return txnHas(txn, key) },
+	})
+}
+
+// Batch buffers writes, and applies them in a single transaction on each flush, so they are atomic.
+// badger.ErrTxnTooBig is returned if writes between flushes exceed the transaction limit.
+func (bdb *badgerEngine) Batch(fn func(kv.PutFlusher) error) error {
+	type op struct {
+		key, val []byte
+		del      bool
+	}
+	var ops []op
+	flush := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		if err := bdb.db.Update(func(txn *badger.Txn) error {
+			for _, o := range ops {
+				var err error
+				if o.del {
+					err = txn.Delete(o.key)
+				} else {
+					err = txn.Set(o.key, o.val)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		ops = nil
+		return nil
+	}
+
+	if err := fn(&struct {
+		kv.PutFunc
+		kv.DeleteFunc
+		kv.FlushFunc
+	}{
+		func(key, val []byte) error {
+			// txn holds references, but callers may reuse buffers
+			ops = append(ops, op{append([]byte(nil), key...), append([]byte(nil), val...), false})
+			return nil
+		},
+		func(key []byte) error {
+			ops = append(ops, op{append([]byte(nil), key...), nil, true})
+			return nil
+		},
+		flush,
+	}); err != nil {
+		return err
+	}
+	return flush()
+}
+
+func (bdb *badgerEngine) Iterate(rng kv.Range, fn func(kv.Pair) bool) error {
+	return bdb.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		var (
+			item   *badger.Item
+			valErr error
+			pair   = &struct {
+				kv.KeyFunc
+				kv.ValueFunc
+			}{
+				func() []byte { return item.Key() },
+				func() []byte {
+					val, err := item.ValueCopy(nil)
+					if err != nil && valErr == nil {
+						// kv.Pair has no way to return it, so it ends the iteration
+						valErr = err
+					}
+					return val
+				},
+			}
+		)
+		for it.Seek(rng.Start); it.Valid(); it.Next() {
+			item = it.Item()
+			if rng.Limit != nil && bytes.Compare(item.Key(), rng.Limit) >= 0 {
+				break
+			}
+			if !fn(pair) || valErr != nil {
+				break
+			}
+		}
+		return valErr
+	})
+}
+
+func txnGet(txn *badger.Txn, key []byte) ([]byte, error) {
+	item, err := txn.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
+
+func txnHas(txn *badger.Txn, key []byte) (bool, error) {
+	if _, err := txn.Get(key); err != nil {
+		if err == badger.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
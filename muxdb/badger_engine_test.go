@@ -0,0 +1,103 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+)
+
+func TestBadgerEngine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := Open(dir, &Options{Engine: EngineBadger})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	store := db.NewStore("test")
+	_, err = store.Get([]byte("k1"))
+	assert.True(t, store.IsNotFound(err))
+
+	assert.Nil(t, store.Put([]byte("k1"), []byte("v1")))
+	assert.Equal(t, M([]byte("v1"), nil), M(store.Get([]byte("k1"))))
+	assert.Equal(t, M(true, nil), M(store.Has([]byte("k1"))))
+
+	assert.Nil(t, store.Batch(func(putter kv.PutFlusher) error {
+		putter.Put([]byte("k2"), []byte("v2"))
+		putter.Delete([]byte("k1"))
+		if err := putter.Flush(); err != nil {
+			return err
+		}
+		return putter.Put([]byte("k3"), []byte("v3"))
+	}))
+	assert.Equal(t, M(false, nil), M(store.Has([]byte("k1"))))
+
+	var keys []string
+	assert.Nil(t, store.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+		keys = append(keys, string(pair.Key())+"="+string(pair.Value()))
+		return true
+	}))
+	assert.Equal(t, []string{"k2=v2", "k3=v3"}, keys)
+
+	assert.Nil(t, store.Snapshot(func(getter kv.Getter) error {
+		assert.Equal(t, M([]byte("v3"), nil), M(getter.Get([]byte("k3"))))
+		return nil
+	}))
+
+	tr := db.NewTrie("trie", thor.Bytes32{})
+	tr.Update([]byte("foo"), []byte("bar"))
+	root, err := tr.Commit()
+	assert.Nil(t, err)
+	assert.Equal(t, M([]byte("bar"), nil), M(db.NewTrie("trie", root).Get([]byte("foo"))))
+}
+
+func TestBadgerBatchAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// small table size to limit txn size
+	db, err := Open(dir, &Options{Engine: EngineBadger, WriteBufferMB: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	store := db.NewStore("test")
+
+	// nothing written if fn fails
+	assert.NotNil(t, store.Batch(func(putter kv.PutFlusher) error {
+		putter.Put([]byte("k1"), []byte("v1"))
+		return errors.New("failed")
+	}))
+	assert.Equal(t, M(false, nil), M(store.Has([]byte("k1"))))
+
+	// too big batch is never split
+	val := make([]byte, 512) // below value threshold, so stored in LSM tree
+	err = store.Batch(func(putter kv.PutFlusher) error {
+		for i := 0; i < 1024; i++ {
+			putter.Put([]byte(fmt.Sprintf("key%v", i)), val)
+		}
+		return nil
+	})
+	assert.Equal(t, badger.ErrTxnTooBig, err)
+	assert.Equal(t, M(false, nil), M(store.Has([]byte("key0"))))
+}
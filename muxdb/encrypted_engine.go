@@ -0,0 +1,134 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+
+	"github.com/vechain/thor/kv"
+)
+
+var errDecrypt = errors.New("encrypted engine: malformed ciphertext")
+
+// encryptedEngine encrypts values using AES-GCM.
+// Keys are kept plain, so that the order of keys is preserved for iteration.
+type encryptedEngine struct {
+	engine
+	aead cipher.AEAD
+}
+
+// newEncryptedEngine wraps the engine with encryption. The length of key should be 16, 24 or 32.
+func newEncryptedEngine(src engine, key []byte) (engine, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedEngine{src, aead}, nil
+}
+
+// seal encrypts val, and the result consists of ( nonce | ciphertext ).
+// The key is authenticated as additional data, so values can't be swapped between keys.
+func (e *encryptedEngine) seal(key, val []byte) ([]byte, error) {
+	nonceSize := e.aead.NonceSize()
+	dst := make([]byte, nonceSize, nonceSize+len(val)+e.aead.Overhead())
+	if _, err := rand.Read(dst); err != nil {
+		return nil, err
+	}
+	return e.aead.Seal(dst, dst, val, key), nil
+}
+
+func (e *encryptedEngine) open(key, data []byte) ([]byte, error) {
+	nonceSize := e.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errDecrypt
+	}
+	return e.aead.Open(nil, data[:nonceSize], data[nonceSize:], key)
+}
+
+func (e *encryptedEngine) proxyGetter(getter kv.Getter) kv.Getter {
+	return &struct {
+		kv.GetFunc
+		kv.HasFunc
+	}{
+		func(key []byte) ([]byte, error) {
+			data, err := getter.Get(key)
+			if err != nil {
+				return nil, err
+			}
+			return e.open(key, data)
+		},
+		getter.Has,
+	}
+}
+
+func (e *encryptedEngine) proxyPutter(putter kv.Putter) kv.Putter {
+	return &struct {
+		kv.PutFunc
+		kv.DeleteFunc
+	}{
+		func(key, val []byte) error {
+			data, err := e.seal(key, val)
+			if err != nil {
+				return err
+			}
+			return putter.Put(key, data)
+		},
+		putter.Delete,
+	}
+}
+
+func (e *encryptedEngine) Get(key []byte) ([]byte, error) {
+	return e.proxyGetter(e.engine).Get(key)
+}
+
+func (e *encryptedEngine) Put(key, val []byte) error {
+	return e.proxyPutter(e.engine).Put(key, val)
+}
+
+func (e *encryptedEngine) Snapshot(fn func(kv.Getter) error) error {
+	return e.engine.Snapshot(func(getter kv.Getter) error {
+		return fn(e.proxyGetter(getter))
+	})
+}
+
+func (e *encryptedEngine) Batch(fn func(kv.PutFlusher) error) error {
+	return e.engine.Batch(func(putter kv.PutFlusher) error {
+		return fn(struct {
+			kv.Putter
+			kv.FlushFunc
+		}{
+			e.proxyPutter(putter),
+			putter.Flush,
+		})
+	})
+}
+
+func (e *encryptedEngine) Iterate(r kv.Range, fn func(kv.Pair) bool) error {
+	var openErr error
+	if err := e.engine.Iterate(r, func(pair kv.Pair) bool {
+		var val []byte
+		if val, openErr = e.open(pair.Key(), pair.Value()); openErr != nil {
+			return false
+		}
+		return fn(&struct {
+			kv.KeyFunc
+			kv.ValueFunc
+		}{
+			pair.Key,
+			func() []byte { return val },
+		})
+	}); err != nil {
+		return err
+	}
+	return openErr
+}
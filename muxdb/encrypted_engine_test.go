@@ -0,0 +1,60 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/kv"
+)
+
+func TestEncryptedEngine(t *testing.T) {
+	src := newMemEngine()
+	key := bytes.Repeat([]byte{1}, 32)
+	db, err := newEncryptedEngine(src, key)
+	assert.Nil(t, err)
+
+	assert.Nil(t, db.Put([]byte("k1"), []byte("v1")))
+	assert.Nil(t, db.Batch(func(putter kv.PutFlusher) error {
+		putter.Put([]byte("k2"), []byte("v2"))
+		putter.Delete([]byte("k1"))
+		return nil
+	}))
+
+	_, err = db.Get([]byte("k1"))
+	assert.True(t, db.IsNotFound(err))
+	assert.Equal(t, M([]byte("v2"), nil), M(db.Get([]byte("k2"))))
+	assert.Nil(t, db.Snapshot(func(getter kv.Getter) error {
+		assert.Equal(t, M([]byte("v2"), nil), M(getter.Get([]byte("k2"))))
+		return nil
+	}))
+
+	var vals []string
+	assert.Nil(t, db.Iterate(kv.Range{Start: []byte("k")}, func(pair kv.Pair) bool {
+		vals = append(vals, string(pair.Value()))
+		return true
+	}))
+	assert.Equal(t, []string{"v2"}, vals)
+
+	// stored value is encrypted
+	raw, _ := src.Get([]byte("k2"))
+	assert.False(t, bytes.Contains(raw, []byte("v2")))
+
+	// value can't be moved to another key
+	src.Put([]byte("k3"), raw)
+	_, err = db.Get([]byte("k3"))
+	assert.NotNil(t, err)
+
+	// wrong key
+	wrong, _ := newEncryptedEngine(src, bytes.Repeat([]byte{2}, 32))
+	_, err = wrong.Get([]byte("k2"))
+	assert.NotNil(t, err)
+
+	_, err = newEncryptedEngine(src, []byte("short"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/vechain/thor/kv"
+)
+
+// memEngine is the pure in-memory engine, which has no background routines like
+// compaction. It's lightweight for tests and ephemeral nodes.
+type memEngine struct {
+	lock sync.RWMutex // to make snapshot consistent
+	db   *memdb.DB
+}
+
+func newMemEngine() engine {
+	return &memEngine{db: memdb.New(comparer.DefaultComparer, 0)}
+}
+
+func (m *memEngine) Close() error {
+	return nil
+}
+
+func (m *memEngine) IsNotFound(err error) bool {
+	return err == leveldb.ErrNotFound
+}
+
+func (m *memEngine) Get(key []byte) ([]byte, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.get(key)
+}
+
+func (m *memEngine) get(key []byte) ([]byte, error) {
+	val, err := m.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	// returned value refers to internal buffer
+	return append([]byte(nil), val...), nil
+}
+
+func (m *memEngine) Has(key []byte) (bool, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.db.Contains(key), nil
+}
+
+func (m *memEngine) Put(key, val []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.db.Put(key, val)
+}
+
+func (m *memEngine) Delete(key []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.db.Delete(key)
+}
+
+// Snapshot holds the read lock while fn runs, to keep reads consistent.
+// So fn must not write.
+func (m *memEngine) Snapshot(fn func(kv.Getter) error) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return fn(&struct {
+		kv.GetFunc
+		kv.HasFunc
+	}{
+		m.get,
+		func(key []byte) (bool, error) { return m.db.Contains(key), nil },
+	})
+}
+
+func (m *memEngine) Batch(fn func(kv.PutFlusher) error) error {
+	batch := &leveldb.Batch{}
+
+	flush := func() error {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		if err := batch.Replay(memBatchReplay{m.db}); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	}
+
+	if err := fn(&struct {
+		kv.PutFunc
+		kv.DeleteFunc
+		kv.FlushFunc
+	}{
+		func(key, val []byte) error {
+			batch.Put(key, val)
+			return nil
+		},
+		func(key []byte) error {
+			batch.Delete(key)
+			return nil
+		},
+		flush,
+	}); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// Iterate iterates over a copy of pairs in the range, so the lock is not held
+// while fn runs, and fn is free to write.
+func (m *memEngine) Iterate(rng kv.Range, fn func(kv.Pair) bool) error {
+	pairs, err := m.rangePairs(rng)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		if !fn(pair) {
+			break
+		}
+	}
+	return nil
+}
+
+// rangePairs returns copies of sorted pairs in the range.
+func (m *memEngine) rangePairs(rng kv.Range) ([]memPair, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	it := m.db.NewIterator((*util.Range)(&rng))
+	defer it.Release()
+
+	var pairs []memPair
+	for it.Next() {
+		pairs = append(pairs, memPair{
+			append([]byte(nil), it.Key()...),
+			append([]byte(nil), it.Value()...),
+		})
+	}
+	return pairs, it.Error()
+}
+
+type memPair struct {
+	key, val []byte
+}
+
+func (p memPair) Key() []byte   { return p.key }
+func (p memPair) Value() []byte { return p.val }
+
+// memBatchReplay implements leveldb.BatchReplay to apply batch onto memdb.
+type memBatchReplay struct {
+	db *memdb.DB
+}
+
+func (r memBatchReplay) Put(key, val []byte) {
+	// memdb.Put never fails
+	_ = r.db.Put(key, val)
+}
+
+func (r memBatchReplay) Delete(key []byte) {
+	// error returned only if key not found
+	_ = r.db.Delete(key)
+}
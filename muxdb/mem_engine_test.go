@@ -0,0 +1,58 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/kv"
+)
+
+func TestMemEngine(t *testing.T) {
+	db := newMemEngine()
+
+	assert.Nil(t, db.Put([]byte("k1"), []byte("v1")))
+	assert.Nil(t, db.Batch(func(putter kv.PutFlusher) error {
+		putter.Put([]byte("k2"), []byte("v2"))
+		putter.Delete([]byte("k1"))
+		return nil
+	}))
+
+	_, err := db.Get([]byte("k1"))
+	assert.True(t, db.IsNotFound(err))
+	assert.Nil(t, db.Snapshot(func(getter kv.Getter) error {
+		assert.Equal(t, M([]byte("v2"), nil), M(getter.Get([]byte("k2"))))
+		return nil
+	}))
+
+	var keys []string
+	assert.Nil(t, db.Iterate(kv.Range{Start: []byte("k")}, func(pair kv.Pair) bool {
+		keys = append(keys, string(pair.Key()))
+		return true
+	}))
+	assert.Equal(t, []string{"k2"}, keys)
+}
+
+func TestMemEngineIterateWrite(t *testing.T) {
+	db := newMemEngine()
+	for _, k := range []string{"k1", "k2", "k3"} {
+		assert.Nil(t, db.Put([]byte(k), []byte("v")))
+	}
+
+	// writes in callback neither block nor affect the iteration
+	var keys []string
+	assert.Nil(t, db.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+		keys = append(keys, string(pair.Key()))
+		assert.Nil(t, db.Delete(pair.Key()))
+		assert.Nil(t, db.Put([]byte("k4"), []byte("v")))
+		return true
+	}))
+	assert.Equal(t, []string{"k1", "k2", "k3"}, keys)
+
+	has, _ := db.Has([]byte("k1"))
+	assert.False(t, has)
+}
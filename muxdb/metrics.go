@@ -0,0 +1,128 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/vechain/thor/kv"
+)
+
+var (
+	kvGetTimer         = metrics.NewRegisteredTimer("muxdb/kv/get", nil)
+	kvGetMissMeter     = metrics.NewRegisteredMeter("muxdb/kv/get/miss", nil)
+	kvPutTimer         = metrics.NewRegisteredTimer("muxdb/kv/put", nil)
+	kvBatchTimer       = metrics.NewRegisteredTimer("muxdb/kv/batch", nil)
+	kvBatchSizeHisto   = metrics.NewRegisteredHistogram("muxdb/kv/batch/size", nil, metrics.NewExpDecaySample(1028, 0.015))
+	kvIterateTimer     = metrics.NewRegisteredTimer("muxdb/kv/iterate", nil)
+	kvIterateNextMeter = metrics.NewRegisteredMeter("muxdb/kv/iterate/next", nil)
+
+	trieCacheEncodedHitMeter  = metrics.NewRegisteredMeter("muxdb/trie/cache/encoded/hit", nil)
+	trieCacheEncodedMissMeter = metrics.NewRegisteredMeter("muxdb/trie/cache/encoded/miss", nil)
+	trieCacheDecodedHitMeter  = metrics.NewRegisteredMeter("muxdb/trie/cache/decoded/hit", nil)
+	trieCacheDecodedMissMeter = metrics.NewRegisteredMeter("muxdb/trie/cache/decoded/miss", nil)
+)
+
+// meteredEngine collects metrics of engine operations.
+type meteredEngine struct {
+	engine
+}
+
+func (m *meteredEngine) proxyGetter(getter kv.Getter) kv.Getter {
+	return &struct {
+		kv.GetFunc
+		kv.HasFunc
+	}{
+		func(key []byte) ([]byte, error) {
+			start := time.Now()
+			val, err := getter.Get(key)
+			kvGetTimer.UpdateSince(start)
+			if err != nil && m.engine.IsNotFound(err) {
+				kvGetMissMeter.Mark(1)
+			}
+			return val, err
+		},
+		func(key []byte) (bool, error) {
+			start := time.Now()
+			has, err := getter.Has(key)
+			kvGetTimer.UpdateSince(start)
+			if err == nil && !has {
+				kvGetMissMeter.Mark(1)
+			}
+			return has, err
+		},
+	}
+}
+
+func (m *meteredEngine) Get(key []byte) ([]byte, error) {
+	return m.proxyGetter(m.engine).Get(key)
+}
+
+func (m *meteredEngine) Has(key []byte) (bool, error) {
+	return m.proxyGetter(m.engine).Has(key)
+}
+
+func (m *meteredEngine) Put(key, val []byte) error {
+	defer kvPutTimer.UpdateSince(time.Now())
+	return m.engine.Put(key, val)
+}
+
+func (m *meteredEngine) Delete(key []byte) error {
+	defer kvPutTimer.UpdateSince(time.Now())
+	return m.engine.Delete(key)
+}
+
+func (m *meteredEngine) Snapshot(fn func(kv.Getter) error) error {
+	return m.engine.Snapshot(func(getter kv.Getter) error {
+		return fn(m.proxyGetter(getter))
+	})
+}
+
+func (m *meteredEngine) Batch(fn func(kv.PutFlusher) error) error {
+	var size int64
+	defer func(start time.Time) {
+		kvBatchTimer.UpdateSince(start)
+		if size > 0 {
+			kvBatchSizeHisto.Update(size)
+		}
+	}(time.Now())
+
+	return m.engine.Batch(func(putter kv.PutFlusher) error {
+		return fn(&struct {
+			kv.PutFunc
+			kv.DeleteFunc
+			kv.FlushFunc
+		}{
+			func(key, val []byte) error {
+				size++
+				return putter.Put(key, val)
+			},
+			func(key []byte) error {
+				size++
+				return putter.Delete(key)
+			},
+			func() error {
+				if size > 0 {
+					kvBatchSizeHisto.Update(size)
+					size = 0
+				}
+				return putter.Flush()
+			},
+		})
+	})
+}
+
+func (m *meteredEngine) Iterate(r kv.Range, fn func(kv.Pair) bool) error {
+	defer kvIterateTimer.UpdateSince(time.Now())
+	var n int64
+	defer func() { kvIterateNextMeter.Mark(n) }()
+
+	return m.engine.Iterate(r, func(pair kv.Pair) bool {
+		n++
+		return fn(pair)
+	})
+}
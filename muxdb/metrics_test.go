@@ -0,0 +1,39 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/kv"
+)
+
+func TestMeteredEngine(t *testing.T) {
+	db := &meteredEngine{newMemEngine()}
+
+	assert.Nil(t, db.Put([]byte("k1"), []byte("v1")))
+	assert.Nil(t, db.Batch(func(putter kv.PutFlusher) error {
+		putter.Put([]byte("k2"), []byte("v2"))
+		putter.Delete([]byte("k1"))
+		return putter.Flush()
+	}))
+
+	_, err := db.Get([]byte("k1"))
+	assert.True(t, db.IsNotFound(err))
+	assert.Equal(t, M(false, nil), M(db.Has([]byte("k1"))))
+	assert.Nil(t, db.Snapshot(func(getter kv.Getter) error {
+		assert.Equal(t, M([]byte("v2"), nil), M(getter.Get([]byte("k2"))))
+		return nil
+	}))
+
+	var keys []string
+	assert.Nil(t, db.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+		keys = append(keys, string(pair.Key()))
+		return true
+	}))
+	assert.Equal(t, []string{"k2"}, keys)
+}
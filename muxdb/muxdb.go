@@ -8,13 +8,14 @@
 package muxdb
 
 import (
+	"errors"
 	"io"
 
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/syndtr/goleveldb/leveldb"
 	dberrors "github.com/syndtr/goleveldb/leveldb/errors"
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 	"github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/vechain/thor/kv"
 	"github.com/vechain/thor/thor"
@@ -30,13 +31,26 @@ const (
 	propsStoreName = "muxdb.props"
 )
 
+// Supported database engines.
+const (
+	EngineLevelDB = "leveldb"
+	EngineBadger  = "badger"
+	EngineRocksDB = "rocksdb" // requires build tag 'rocksdb'
+)
+
 type engine interface {
 	kv.Store
 	Close() error
 }
 
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
 // Options optional parameters for MuxDB.
 type Options struct {
+	// Engine is the underlying database engine, EngineLevelDB if empty.
+	Engine string
 	// EncodedTrieNodeCacheSizeMB is the size of encoded trie node cache.
 	EncodedTrieNodeCacheSizeMB int
 	// DecodedTrieNodeCacheCapacity is max count of cached decoded trie nodes.
@@ -47,6 +61,14 @@ type Options struct {
 	ReadCacheMB int
 	// WriteBufferMB is the size of write buffer for underlying database.
 	WriteBufferMB int
+	// WriteRateLimitMB limits IO rate(MB/s) of flush and compaction. No limit if 0.
+	// It's only for EngineRocksDB.
+	WriteRateLimitMB int
+	// EncryptionKey is the AES key to encrypt values at rest, which should be 16, 24 or 32 bytes long.
+	// Encryption is disabled if empty.
+	EncryptionKey []byte
+	// ReadOnly opens the database without write access. Any write fails.
+	ReadOnly bool
 	// PermanentTrie if set to true, tries always commit nodes into permanent space, so pruner
 	// will have no effect.
 	PermanentTrie bool
@@ -66,6 +88,28 @@ type MuxDB struct {
 
 // Open opens or creates DB at the given path.
 func Open(path string, options *Options) (*MuxDB, error) {
+	switch options.Engine {
+	case "", EngineLevelDB:
+		return openLevelDB(path, options)
+	case EngineBadger:
+		engine, err := openBadgerEngine(path, options)
+		if err != nil {
+			return nil, err
+		}
+		// badger has no separated storage to close
+		return newMuxDB(engine, closerFunc(func() error { return nil }), options)
+	case EngineRocksDB:
+		engine, err := openRocksEngine(path, options)
+		if err != nil {
+			return nil, err
+		}
+		return newMuxDB(engine, closerFunc(func() error { return nil }), options)
+	default:
+		return nil, errors.New("unsupported engine: " + options.Engine)
+	}
+}
+
+func openLevelDB(path string, options *Options) (*MuxDB, error) {
 	// prepare leveldb options
 	ldbOpts := opt.Options{
 		OpenFilesCacheCapacity:        options.OpenFilesCacheCapacity,
@@ -75,6 +119,7 @@ func Open(path string, options *Options) (*MuxDB, error) {
 		BlockSize:                     1024 * 32, // balance performance of point reads and compression ratio.
 		DisableSeeksCompaction:        true,
 		CompactionTableSizeMultiplier: 2,
+		ReadOnly:                      options.ReadOnly,
 		VibrantKeys: []*util.Range{
 			util.BytesPrefix([]byte{trieSpaceA}),
 			util.BytesPrefix([]byte{trieSpaceB}),
@@ -82,14 +127,14 @@ func Open(path string, options *Options) (*MuxDB, error) {
 		},
 	}
 
-	storage, err := openLevelFileStorage(path, false, options.DisablePageCache)
+	storage, err := openLevelFileStorage(path, options.ReadOnly, options.DisablePageCache)
 	if err != nil {
 		return nil, err
 	}
 
 	// open leveldb
 	ldb, err := leveldb.Open(storage, &ldbOpts)
-	if _, corrupted := err.(*dberrors.ErrCorrupted); corrupted {
+	if _, corrupted := err.(*dberrors.ErrCorrupted); corrupted && !options.ReadOnly {
 		ldb, err = leveldb.Recover(storage, &ldbOpts)
 	}
 	if err != nil {
@@ -98,12 +143,29 @@ func Open(path string, options *Options) (*MuxDB, error) {
 	}
 
 	// as engine
-	engine := newLevelEngine(ldb)
+	return newMuxDB(newLevelEngine(ldb), storage, options)
+}
+
+func newMuxDB(engine engine, storageCloser io.Closer, options *Options) (*MuxDB, error) {
+	// metrics measure the backend, so applied beneath encryption
+	if metrics.Enabled {
+		engine = &meteredEngine{engine}
+	}
+	if len(options.EncryptionKey) > 0 {
+		encrypted, err := newEncryptedEngine(engine, options.EncryptionKey)
+		if err != nil {
+			engine.Close()
+			storageCloser.Close()
+			return nil, err
+		}
+		engine = encrypted
+	}
 
 	propsStore := newNamedStore(engine, propsStoreName)
 	trieLiveSpace, err := newTrieLiveSpace(propsStore)
 	if err != nil {
 		engine.Close()
+		storageCloser.Close()
 		return nil, err
 	}
 
@@ -113,25 +175,22 @@ func Open(path string, options *Options) (*MuxDB, error) {
 			options.EncodedTrieNodeCacheSizeMB,
 			options.DecodedTrieNodeCacheCapacity),
 		trieLiveSpace: trieLiveSpace,
-		storageCloser: storage,
+		storageCloser: storageCloser,
 		permanentTrie: options.PermanentTrie,
 	}, nil
 }
 
 // NewMem creates a memory-backed DB.
 func NewMem() *MuxDB {
-	storage := storage.NewMemStorage()
-	ldb, _ := leveldb.Open(storage, nil)
-
-	engine := newLevelEngine(ldb)
+	engine := newMemEngine()
 	propsStore := newNamedStore(engine, propsStoreName)
 	trieLiveSpace, _ := newTrieLiveSpace(propsStore)
 
 	return &MuxDB{
-		engine:        newLevelEngine(ldb),
+		engine:        engine,
 		trieCache:     newTrieCache(0, 8192),
 		trieLiveSpace: trieLiveSpace,
-		storageCloser: storage,
+		storageCloser: closerFunc(func() error { return nil }),
 	}
 }
 
@@ -195,38 +254,5 @@ func (db *MuxDB) IsNotFound(err error) bool {
 }
 
 func newNamedStore(src kv.Store, name string) kv.Store {
-	bkt := bucket(append([]byte{namedStoreSpace}, name...))
-	return &struct {
-		kv.Getter
-		kv.Putter
-		kv.SnapshotFunc
-		kv.BatchFunc
-		kv.IterateFunc
-		kv.IsNotFoundFunc
-	}{
-		bkt.ProxyGetter(src),
-		bkt.ProxyPutter(src),
-		func(fn func(kv.Getter) error) error {
-			return src.Snapshot(func(getter kv.Getter) error {
-				return fn(bkt.ProxyGetter(getter))
-			})
-		},
-		func(fn func(kv.PutFlusher) error) error {
-			return src.Batch(func(putter kv.PutFlusher) error {
-				return fn(struct {
-					kv.Putter
-					kv.FlushFunc
-				}{
-					bkt.ProxyPutter(putter),
-					putter.Flush,
-				})
-			})
-		},
-		func(r kv.Range, fn func(kv.Pair) bool) error {
-			return src.Iterate(bkt.MakeRange(r), func(pair kv.Pair) bool {
-				return fn(bkt.MakePair(pair))
-			})
-		},
-		src.IsNotFound,
-	}
+	return kv.Bucket(append([]byte{namedStoreSpace}, name...)).ProxyStore(src)
 }
@@ -0,0 +1,66 @@
+// Copyright (c) 2019 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package muxdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/vechain/thor/kv"
+)
+
+func M(args ...interface{}) []interface{} {
+	return args
+}
+
+func newMemDB() engine {
+	ldb, _ := leveldb.Open(storage.NewMemStorage(), nil)
+	return newLevelEngine(ldb)
+}
+
+func TestNamedStore(t *testing.T) {
+	db := newMemDB()
+	s1 := newNamedStore(db, "s1")
+	s2 := newNamedStore(db, "s2")
+
+	assert.Nil(t, s1.Put([]byte("k"), []byte("v1")))
+	assert.Nil(t, s2.Put([]byte("k"), []byte("v2")))
+
+	assert.Equal(t, M([]byte("v1"), nil), M(s1.Get([]byte("k"))))
+	assert.Equal(t, M([]byte("v2"), nil), M(s2.Get([]byte("k"))))
+
+	var keys []string
+	assert.Nil(t, s1.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+		keys = append(keys, string(pair.Key()))
+		return true
+	}))
+	assert.Equal(t, []string{"k"}, keys)
+}
+
+func TestReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "muxdb-readonly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := Open(dir, &Options{})
+	assert.Nil(t, err)
+	assert.Nil(t, db.NewStore("s").Put([]byte("k"), []byte("v")))
+	db.Close()
+
+	db, err = Open(dir, &Options{ReadOnly: true})
+	assert.Nil(t, err)
+	defer db.Close()
+
+	store := db.NewStore("s")
+	assert.Equal(t, M([]byte("v"), nil), M(store.Get([]byte("k"))))
+	assert.NotNil(t, store.Put([]byte("k"), []byte("v2")))
+}
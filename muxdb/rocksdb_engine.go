@@ -0,0 +1,252 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// +build rocksdb
+
+package muxdb
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/tecbot/gorocksdb"
+	"github.com/vechain/thor/kv"
+)
+
+// column families, each holds a key space of muxdb.
+var rocksColumnFamilies = []string{
+	"default",    // named stores
+	"trie.live",  // trieSpaceA and trieSpaceB
+	"trie.perm",  // trieSpaceP
+	"trie.skeys", // trieSecureKeySpace
+}
+
+var errRocksNotFound = errors.New("rocksdb: not found")
+
+type rocksEngine struct {
+	db       *gorocksdb.DB
+	cfs      []*gorocksdb.ColumnFamilyHandle
+	readOpt  *gorocksdb.ReadOptions
+	scanOpt  *gorocksdb.ReadOptions
+	writeOpt *gorocksdb.WriteOptions
+	opts     []*gorocksdb.Options
+}
+
+// openRocksEngine opens rocksdb at the given path, with key spaces separated into column families.
+func openRocksEngine(path string, options *Options) (engine, error) {
+	bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
+	bbto.SetBlockSize(32 * 1024)
+	bbto.SetFilterPolicy(gorocksdb.NewBloomFilter(10))
+	if options.ReadCacheMB > 0 {
+		bbto.SetBlockCache(gorocksdb.NewLRUCache(uint64(options.ReadCacheMB) << 20))
+	}
+
+	newOpts := func() *gorocksdb.Options {
+		opts := gorocksdb.NewDefaultOptions()
+		opts.SetCreateIfMissing(true)
+		opts.SetCreateIfMissingColumnFamilies(true)
+		opts.SetBlockBasedTableFactory(bbto)
+		if options.WriteBufferMB > 0 {
+			opts.SetWriteBufferSize(options.WriteBufferMB << 20)
+		}
+		if options.OpenFilesCacheCapacity > 0 {
+			opts.SetMaxOpenFiles(options.OpenFilesCacheCapacity)
+		}
+		if options.WriteRateLimitMB > 0 {
+			// limits the IO of flush and compaction
+			opts.SetRateLimiter(gorocksdb.NewRateLimiter(int64(options.WriteRateLimitMB)<<20, 100*1000, 10))
+		}
+		return opts
+	}
+
+	dbOpts := newOpts()
+	cfOpts := make([]*gorocksdb.Options, len(rocksColumnFamilies))
+	for i := range cfOpts {
+		cfOpts[i] = newOpts()
+	}
+	var (
+		db  *gorocksdb.DB
+		cfs []*gorocksdb.ColumnFamilyHandle
+		err error
+	)
+	if options.ReadOnly {
+		db, cfs, err = gorocksdb.OpenDbForReadOnlyColumnFamilies(dbOpts, path, rocksColumnFamilies, cfOpts, false)
+	} else {
+		db, cfs, err = gorocksdb.OpenDbColumnFamilies(dbOpts, path, rocksColumnFamilies, cfOpts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scanOpt := gorocksdb.NewDefaultReadOptions()
+	scanOpt.SetFillCache(false)
+	return &rocksEngine{
+		db:       db,
+		cfs:      cfs,
+		readOpt:  gorocksdb.NewDefaultReadOptions(),
+		scanOpt:  scanOpt,
+		writeOpt: gorocksdb.NewDefaultWriteOptions(),
+		opts:     append(cfOpts, dbOpts),
+	}, nil
+}
+
+// cf returns the column family for the key.
+func (r *rocksEngine) cf(key []byte) *gorocksdb.ColumnFamilyHandle {
+	if len(key) > 0 {
+		switch key[0] {
+		case trieSpaceA, trieSpaceB:
+			return r.cfs[1]
+		case trieSpaceP:
+			return r.cfs[2]
+		case trieSecureKeySpace:
+			return r.cfs[3]
+		}
+	}
+	return r.cfs[0]
+}
+
+func (r *rocksEngine) Close() error {
+	for _, cf := range r.cfs {
+		cf.Destroy()
+	}
+	r.db.Close()
+	r.readOpt.Destroy()
+	r.scanOpt.Destroy()
+	r.writeOpt.Destroy()
+	for _, opts := range r.opts {
+		opts.Destroy()
+	}
+	return nil
+}
+
+func (r *rocksEngine) IsNotFound(err error) bool {
+	return err == errRocksNotFound
+}
+
+func (r *rocksEngine) get(opts *gorocksdb.ReadOptions, key []byte) ([]byte, error) {
+	slice, err := r.db.GetCF(opts, r.cf(key), key)
+	if err != nil {
+		return nil, err
+	}
+	defer slice.Free()
+	if !slice.Exists() {
+		return nil, errRocksNotFound
+	}
+	return append([]byte(nil), slice.Data()...), nil
+}
+
+func (r *rocksEngine) has(opts *gorocksdb.ReadOptions, key []byte) (bool, error) {
+	slice, err := r.db.GetCF(opts, r.cf(key), key)
+	if err != nil {
+		return false, err
+	}
+	defer slice.Free()
+	return slice.Exists(), nil
+}
+
+func (r *rocksEngine) Get(key []byte) ([]byte, error) {
+	return r.get(r.readOpt, key)
+}
+
+func (r *rocksEngine) Has(key []byte) (bool, error) {
+	return r.has(r.readOpt, key)
+}
+
+func (r *rocksEngine) Put(key, val []byte) error {
+	return r.db.PutCF(r.writeOpt, r.cf(key), key, val)
+}
+
+func (r *rocksEngine) Delete(key []byte) error {
+	return r.db.DeleteCF(r.writeOpt, r.cf(key), key)
+}
+
+func (r *rocksEngine) Snapshot(fn func(kv.Getter) error) error {
+	snapshot := r.db.NewSnapshot()
+	defer r.db.ReleaseSnapshot(snapshot)
+
+	opts := gorocksdb.NewDefaultReadOptions()
+	defer opts.Destroy()
+	opts.SetSnapshot(snapshot)
+
+	return fn(&struct {
+		kv.GetFunc
+		kv.HasFunc
+	}{
+		func(key []byte) ([]byte, error) { return r.get(opts, key) },
+		func(key []byte) (bool, error) { return r.has(opts, key) },
+	})
+}
+
+func (r *rocksEngine) Batch(fn func(kv.PutFlusher) error) error {
+	batch := gorocksdb.NewWriteBatch()
+	defer batch.Destroy()
+
+	flush := func() error {
+		if batch.Count() == 0 {
+			return nil
+		}
+		if err := r.db.Write(r.writeOpt, batch); err != nil {
+			return err
+		}
+		batch.Clear()
+		return nil
+	}
+
+	if err := fn(&struct {
+		kv.PutFunc
+		kv.DeleteFunc
+		kv.FlushFunc
+	}{
+		func(key, val []byte) error {
+			batch.PutCF(r.cf(key), key, val)
+			return nil
+		},
+		func(key []byte) error {
+			batch.DeleteCF(r.cf(key), key)
+			return nil
+		},
+		flush,
+	}); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// Iterate iterates over the given range. The range should not span column families,
+// which is always true for ranges of muxdb key spaces.
+func (r *rocksEngine) Iterate(rng kv.Range, fn func(kv.Pair) bool) error {
+	it := r.db.NewIteratorCF(r.scanOpt, r.cf(rng.Start))
+	defer it.Close()
+
+	var key, val []byte
+	pair := &struct {
+		kv.KeyFunc
+		kv.ValueFunc
+	}{
+		func() []byte { return key },
+		func() []byte { return val },
+	}
+
+	if rng.Start != nil {
+		it.Seek(rng.Start)
+	} else {
+		it.SeekToFirst()
+	}
+	for ; it.Valid(); it.Next() {
+		k, v := it.Key(), it.Value()
+		key = append(key[:0], k.Data()...)
+		val = append(val[:0], v.Data()...)
+		k.Free()
+		v.Free()
+
+		if rng.Limit != nil && bytes.Compare(key, rng.Limit) >= 0 {
+			break
+		}
+		if !fn(pair) {
+			break
+		}
+	}
+	return it.Err()
+}
@@ -0,0 +1,14 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// +build !rocksdb
+
+package muxdb
+
+import "errors"
+
+func openRocksEngine(path string, options *Options) (engine, error) {
+	return nil, errors.New("rocksdb engine not available, rebuild with '-tags rocksdb'")
+}
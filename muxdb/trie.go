@@ -165,6 +165,15 @@ func (t *Trie) NodeIterator(start []byte) trie.NodeIterator {
 	return obj.NodeIterator(start)
 }
 
+// Prove constructs a merkle proof for key, see trie.Trie.Prove.
+func (t *Trie) Prove(key []byte, proofDb trie.DatabaseWriter) error {
+	obj, err := t.lazyInit()
+	if err != nil {
+		return err
+	}
+	return obj.Prove(t.hashKey(key, false), 0, proofDb)
+}
+
 // GetKeyPreimage returns the blake2b preimage of a hashed key that was
 // previously used to store a value.
 func (t *Trie) GetKeyPreimage(hash thor.Bytes32) []byte {
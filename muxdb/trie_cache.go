@@ -51,6 +51,11 @@ func (c *trieCache) GetEncoded(key []byte, pathLen int, peek bool) (val []byte)
 			val, _ = enc.Get(key)
 		}
 	}
+	if len(val) > 0 {
+		trieCacheEncodedHitMeter.Mark(1)
+	} else {
+		trieCacheEncodedMissMeter.Mark(1)
+	}
 	return
 }
 func (c *trieCache) SetEncoded(key, val []byte, pathLen int) {
@@ -76,6 +81,11 @@ func (c *trieCache) GetDecoded(key []byte, pathLen int, peek bool) (val interfac
 			val, _ = dec.Get(string(key))
 		}
 	}
+	if val != nil {
+		trieCacheDecodedHitMeter.Mark(1)
+	} else {
+		trieCacheDecodedMissMeter.Mark(1)
+	}
 	return
 }
 
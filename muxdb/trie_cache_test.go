@@ -7,8 +7,29 @@ package muxdb
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestTrieCache(t *testing.T) {
+	cache := newTrieCache(1, 64)
+
+	key := []byte("key")
+	assert.Nil(t, cache.GetEncoded(key, 1, false))
+	assert.Nil(t, cache.GetDecoded(key, 1, false))
+
+	cache.SetEncoded(key, []byte("enc"), 1)
+	cache.SetDecoded(key, "dec", 1)
+	assert.Equal(t, []byte("enc"), cache.GetEncoded(key, 1, false))
+	assert.Equal(t, "dec", cache.GetDecoded(key, 1, true))
+	// long paths share the last segment
+	cache.SetEncoded(key, []byte("long"), 100)
+	assert.Equal(t, []byte("long"), cache.GetEncoded(key, trieNodeCacheSeg-1, false))
 
+	// zero sizes disable the cache
+	cache = newTrieCache(0, 0)
+	cache.SetEncoded(key, []byte("enc"), 1)
+	cache.SetDecoded(key, "dec", 1)
+	assert.Nil(t, cache.GetEncoded(key, 1, false))
+	assert.Nil(t, cache.GetDecoded(key, 1, false))
 }
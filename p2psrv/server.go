@@ -105,7 +105,13 @@ func (s *Server) Start(protocols []*Protocol) error {
 		if err := s.listenDiscV5(); err != nil {
 			return err
 		}
+		registered := make(map[string]bool)
 		for _, proto := range protocols {
+			// versions of a protocol may share the topic
+			if registered[proto.DiscTopic] {
+				continue
+			}
+			registered[proto.DiscTopic] = true
 			topicToRegister := discv5.Topic(proto.DiscTopic)
 			log.Debug("registering topic", "topic", topicToRegister)
 			s.goes.Go(func() {
@@ -7,6 +7,7 @@ package packer
 
 import (
 	"crypto/ecdsa"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
@@ -17,6 +18,9 @@ import (
 	"github.com/vechain/thor/tx"
 )
 
+// blockSizeReserve is the part of max block size reserved for the header and list prefixes of the block.
+const blockSizeReserve = 1024
+
 // Flow the flow of packing a new block.
 type Flow struct {
 	packer       *Packer
@@ -24,9 +28,16 @@ type Flow struct {
 	runtime      *runtime.Runtime
 	processedTxs map[thor.Bytes32]bool // txID -> reverted
 	gasUsed      uint64
+	txsSize      uint64
 	txs          tx.Transactions
 	receipts     tx.Receipts
 	features     tx.Features
+	interval     uint64
+	fillStarted  bool
+	deadline     time.Time // of filling, zero if no deadline
+	fillDuration time.Duration
+	considered   int
+	rejected     int
 }
 
 func newFlow(
@@ -34,6 +45,7 @@ func newFlow(
 	parentHeader *block.Header,
 	runtime *runtime.Runtime,
 	features tx.Features,
+	interval uint64,
 ) *Flow {
 	return &Flow{
 		packer:       packer,
@@ -41,6 +53,7 @@ func newFlow(
 		runtime:      runtime,
 		processedTxs: make(map[thor.Bytes32]bool),
 		features:     features,
+		interval:     interval,
 	}
 }
 
@@ -54,11 +67,29 @@ func (f *Flow) When() uint64 {
 	return f.runtime.Context().Time
 }
 
+// Interval returns the block interval which the flow is scheduled with.
+func (f *Flow) Interval() uint64 {
+	return f.interval
+}
+
+// Suppressed returns whether packing should be skipped, since the new block would be empty,
+// and its parent is more recent than the empty block interval.
+func (f *Flow) Suppressed() bool {
+	interval := f.packer.emptyInterval
+	return interval > 0 && len(f.txs) == 0 && f.When() < f.parentHeader.Timestamp()+interval
+}
+
 // TotalScore returns total score of new block.
 func (f *Flow) TotalScore() uint64 {
 	return f.runtime.Context().TotalScore
 }
 
+// Adopted returns whether the tx is adopted by the new block.
+func (f *Flow) Adopted(txID thor.Bytes32) bool {
+	_, ok := f.processedTxs[txID]
+	return ok
+}
+
 func (f *Flow) findTx(txID thor.Bytes32) (found bool, reverted bool, err error) {
 	if reverted, ok := f.processedTxs[txID]; ok {
 		return true, reverted, nil
@@ -73,10 +104,116 @@ func (f *Flow) findTx(txID thor.Bytes32) (found bool, reverted bool, err error)
 	return true, txMeta.Reverted, nil
 }
 
+// startFill records the start of the first filling, and returns the deadline of filling,
+// or zero time if no deadline. Later fillings extending the flow share the deadline.
+func (f *Flow) startFill() time.Time {
+	if !f.fillStarted {
+		f.fillStarted = true
+		if f.packer.deadline > 0 {
+			f.deadline = time.Now().Add(time.Duration(f.interval*f.packer.deadline) * time.Second / 100)
+		}
+	}
+	return f.deadline
+}
+
+// Deadline returns the deadline of filling, which is set by the first filling.
+// Zero time is returned if there's no deadline, or filling not started.
+func (f *Flow) Deadline() time.Time {
+	return f.deadline
+}
+
+// fillDone returns whether filling should stop, due to the deadline or the gas target.
+func (f *Flow) fillDone(deadline time.Time) bool {
+	if !deadline.IsZero() && time.Now().After(deadline) {
+		return true
+	}
+	if target := f.packer.gasTarget; target > 0 && target < 100 {
+		return f.gasUsed >= f.runtime.Context().GasLimit*target/100
+	}
+	return false
+}
+
+// Extendable returns whether more txs may be adopted by filling, i.e. there's space for
+// the minimum tx, and neither the gas target nor the deadline is reached.
+func (f *Flow) Extendable() bool {
+	if f.gasUsed+thor.TxGas+thor.ClauseGas > f.runtime.Context().GasLimit {
+		return false
+	}
+	return !f.fillDone(f.deadline)
+}
+
+// Fill adopts candidates in the order decided by the strategy, until the gas limit or the gas target
+// is reached, or the deadline hits. It can be called again to extend the flow with more candidates,
+// and the deadline is counted from the first call.
+// It returns txs which are never adoptable, and should be removed from the pool.
+func (f *Flow) Fill(candidates []*Candidate, strategy SelectionStrategy) (rejected tx.Transactions) {
+	deadline := f.startFill()
+	defer func(start time.Time) { f.fillDuration += time.Since(start) }(time.Now())
+
+	for _, c := range strategy.Order(candidates) {
+		if f.fillDone(deadline) {
+			break
+		}
+		if err := f.adopt(c.Tx, c.EstimatedGas); err != nil {
+			if IsGasLimitReached(err) {
+				break
+			}
+			if IsTxNotAdoptableNow(err) {
+				continue
+			}
+			rejected = append(rejected, c.Tx)
+		}
+	}
+	return
+}
+
 // Adopt try to execute the given transaction.
 // If the tx is valid and can be executed on current state (regardless of VM error),
 // it will be adopted by the new block.
 func (f *Flow) Adopt(tx *tx.Transaction) error {
+	return f.adopt(tx, 0)
+}
+
+// adopt adopts the tx. If the estimated gas is known, it's used to plan whether the tx fits,
+// and the tx is reverted if it turns out not to fit.
+func (f *Flow) adopt(tx *tx.Transaction, estimatedGas uint64) (err error) {
+	defer func() { f.record(err) }()
+
+	if err := f.check(tx, estimatedGas); err != nil {
+		return err
+	}
+
+	checkpoint := f.runtime.State().NewCheckpoint()
+	receipt, err := f.runtime.ExecuteTransaction(tx)
+	if err != nil {
+		// skip and revert state
+		f.runtime.State().RevertTo(checkpoint)
+		return badTxError{err.Error()}
+	}
+	if !f.fits(receipt) {
+		f.runtime.State().RevertTo(checkpoint)
+		return errTxNotAdoptableNow
+	}
+	f.adopted(tx, receipt)
+	return nil
+}
+
+// fits returns whether the gas used by the executed tx fits in the block.
+func (f *Flow) fits(receipt *tx.Receipt) bool {
+	return f.gasUsed+receipt.GasUsed <= f.runtime.Context().GasLimit
+}
+
+func (f *Flow) adopted(tx *tx.Transaction, receipt *tx.Receipt) {
+	f.processedTxs[tx.ID()] = receipt.Reverted
+	f.gasUsed += receipt.GasUsed
+	f.txsSize += uint64(tx.Size())
+	f.receipts = append(f.receipts, receipt)
+	f.txs = append(f.txs, tx)
+}
+
+// check checks whether the tx can be adopted, before executing it.
+// The gas needed is planned by the estimated gas if it's known and less than the declared.
+func (f *Flow) check(tx *tx.Transaction, estimatedGas uint64) error {
 	origin, _ := tx.Origin()
 	if f.runtime.Context().Number >= f.packer.forkConfig.BLOCKLIST && thor.IsOriginBlocked(origin) {
 		return badTxError{"tx origin blocked"}
@@ -93,7 +230,7 @@ func (f *Flow) Adopt(tx *tx.Transaction) error {
 		return errTxNotAdoptableNow
 	case tx.IsExpired(f.runtime.Context().Number):
 		return badTxError{"expired"}
-	case f.gasUsed+tx.Gas() > f.runtime.Context().GasLimit:
+	case f.gasUsed+planGas(tx, estimatedGas) > f.runtime.Context().GasLimit:
 		// has enough space to adopt minimum tx
 		if f.gasUsed+thor.TxGas+thor.ClauseGas <= f.runtime.Context().GasLimit {
 			// try to find a lower gas tx
@@ -102,6 +239,17 @@ func (f *Flow) Adopt(tx *tx.Transaction) error {
 		return errGasLimitReached
 	}
 
+	if f.runtime.Context().Number >= f.packer.forkConfig.BLOCKSIZE {
+		size := uint64(tx.Size())
+		if size > thor.MaxBlockSize-blockSizeReserve {
+			return badTxError{"tx size exceeds block size limit"}
+		}
+		if f.txsSize+size > thor.MaxBlockSize-blockSizeReserve {
+			// try to find a smaller tx
+			return errTxNotAdoptableNow
+		}
+	}
+
 	// check if tx already there
 	if found, _, err := f.findTx(tx.ID()); err != nil {
 		return err
@@ -122,19 +270,14 @@ func (f *Flow) Adopt(tx *tx.Transaction) error {
 			return errTxNotAdoptableForever
 		}
 	}
+	return nil
+}
 
-	checkpoint := f.runtime.State().NewCheckpoint()
-	receipt, err := f.runtime.ExecuteTransaction(tx)
-	if err != nil {
-		// skip and revert state
-		f.runtime.State().RevertTo(checkpoint)
-		return badTxError{err.Error()}
+func planGas(tx *tx.Transaction, estimatedGas uint64) uint64 {
+	if estimatedGas > 0 && estimatedGas < tx.Gas() {
+		return estimatedGas
 	}
-	f.processedTxs[tx.ID()] = receipt.Reverted
-	f.gasUsed += receipt.GasUsed
-	f.receipts = append(f.receipts, receipt)
-	f.txs = append(f.txs, tx)
-	return nil
+	return tx.Gas()
 }
 
 // Pack build and sign the new block.
@@ -142,32 +285,19 @@ func (f *Flow) Pack(privateKey *ecdsa.PrivateKey) (*block.Block, *state.Stage, t
 	if f.packer.nodeMaster != thor.Address(crypto.PubkeyToAddress(privateKey.PublicKey)) {
 		return nil, nil, nil, errors.New("private key mismatch")
 	}
+	return f.PackWithSealer(block.NewKeySealer(privateKey))
+}
 
-	stage, err := f.runtime.State().Stage()
+// PackWithSealer build the new block and sign it by the sealer.
+// The signer recovered from the signature should be the node master.
+func (f *Flow) PackWithSealer(sealer block.Sealer) (*block.Block, *state.Stage, tx.Receipts, error) {
+	tmpl, err := f.BuildTemplate()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	stateRoot := stage.Hash()
-
-	builder := new(block.Builder).
-		Beneficiary(f.runtime.Context().Beneficiary).
-		GasLimit(f.runtime.Context().GasLimit).
-		ParentID(f.parentHeader.ID()).
-		Timestamp(f.runtime.Context().Time).
-		TotalScore(f.runtime.Context().TotalScore).
-		GasUsed(f.gasUsed).
-		ReceiptsRoot(f.receipts.RootHash()).
-		StateRoot(stateRoot).
-		TransactionFeatures(f.features)
-
-	for _, tx := range f.txs {
-		builder.Transaction(tx)
-	}
-	newBlock := builder.Build()
-
-	sig, err := crypto.Sign(newBlock.Header().SigningHash().Bytes(), privateKey)
+	sig, err := sealer.Seal(tmpl.SigningHash())
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	return newBlock.WithSignature(sig), stage, f.receipts, nil
+	return tmpl.Submit(sig)
 }
@@ -0,0 +1,86 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/pkg/errors"
+)
+
+var (
+	considerMeter = metrics.NewRegisteredMeter("packer/tx/considered", nil)
+	includeMeter  = metrics.NewRegisteredMeter("packer/tx/included", nil)
+	rejectMeters  = map[string]metrics.Meter{}
+
+	blockConsideredHisto = metrics.NewRegisteredHistogram("packer/block/considered", nil, metrics.NewExpDecaySample(1028, 0.015))
+	blockIncludedHisto   = metrics.NewRegisteredHistogram("packer/block/included", nil, metrics.NewExpDecaySample(1028, 0.015))
+	blockRejectedHisto   = metrics.NewRegisteredHistogram("packer/block/rejected", nil, metrics.NewExpDecaySample(1028, 0.015))
+	blockGasUsageHisto   = metrics.NewRegisteredHistogram("packer/block/gas-usage", nil, metrics.NewExpDecaySample(1028, 0.015))
+	blockFillTimer       = metrics.NewRegisteredTimer("packer/block/fill", nil)
+)
+
+// rejection reasons
+const (
+	reasonGasLimitReached = "gas-limit-reached"
+	reasonNotAdoptableNow = "not-adoptable-now"
+	reasonNotAdoptable    = "not-adoptable-forever"
+	reasonKnown           = "known"
+	reasonBad             = "bad"
+	reasonError           = "error"
+)
+
+func init() {
+	for _, reason := range []string{
+		reasonGasLimitReached,
+		reasonNotAdoptableNow,
+		reasonNotAdoptable,
+		reasonKnown,
+		reasonBad,
+		reasonError,
+	} {
+		rejectMeters[reason] = metrics.NewRegisteredMeter("packer/tx/rejected/"+reason, nil)
+	}
+}
+
+func rejectionReason(err error) string {
+	switch {
+	case IsGasLimitReached(err):
+		return reasonGasLimitReached
+	case IsTxNotAdoptableNow(err):
+		return reasonNotAdoptableNow
+	case errors.Cause(err) == errTxNotAdoptableForever:
+		return reasonNotAdoptable
+	case IsKnownTx(err):
+		return reasonKnown
+	case IsBadTx(err):
+		return reasonBad
+	default:
+		return reasonError
+	}
+}
+
+// record counts the tx considered by the flow, and the reason if it's not adopted.
+func (f *Flow) record(err error) {
+	f.considered++
+	considerMeter.Mark(1)
+	if err != nil {
+		f.rejected++
+		rejectMeters[rejectionReason(err)].Mark(1)
+	} else {
+		includeMeter.Mark(1)
+	}
+}
+
+// recordBlock records stats of the flow when the block is packed.
+func (f *Flow) recordBlock() {
+	blockConsideredHisto.Update(int64(f.considered))
+	blockIncludedHisto.Update(int64(len(f.txs)))
+	blockRejectedHisto.Update(int64(f.rejected))
+	if gasLimit := f.runtime.Context().GasLimit; gasLimit > 0 {
+		blockGasUsageHisto.Update(int64(f.gasUsed * 100 / gasLimit))
+	}
+	blockFillTimer.Update(f.fillDuration)
+}
@@ -25,6 +25,10 @@ type Packer struct {
 	beneficiary    *thor.Address
 	targetGasLimit uint64
 	forkConfig     thor.ForkConfig
+	rewardPolicy   runtime.RewardPolicy
+	deadline       uint64 // in percent of block interval
+	gasTarget      uint64 // in percent of block gas limit
+	emptyInterval  uint64 // min seconds between empty blocks
 }
 
 // New create a new Packer instance.
@@ -43,6 +47,10 @@ func New(
 		beneficiary,
 		0,
 		forkConfig,
+		runtime.DefaultRewardPolicy,
+		0,
+		0,
+		0,
 	}
 }
 
@@ -65,6 +73,9 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 	if parent.Number()+1 >= vip191 {
 		features |= tx.DelegationFeature
 	}
+	if parent.Number()+1 >= p.forkConfig.NON_ATOMIC {
+		features |= tx.NonAtomicFeature
+	}
 
 	authority := builtin.Authority.Native(state)
 	endorsement, err := builtin.Params.Native(state).Get(thor.KeyProposerEndorsement)
@@ -94,8 +105,28 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 		})
 	}
 
+	if parent.Number()+1 >= p.forkConfig.ROTATION {
+		members, err := poa.EpochMembers(p.repo.NewChain(parent.ID()), p.stater, parent.Number()+1)
+		if err != nil {
+			return nil, err
+		}
+		proposers = poa.EpochProposers(members, proposers)
+	}
+
+	interval, err := poa.BlockIntervalOf(state, p.forkConfig, parent.Number()+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var weights map[thor.Address]uint64
+	if parent.Number()+1 >= p.forkConfig.WEIGHTING {
+		if weights, err = poa.Weights(state, proposers); err != nil {
+			return nil, err
+		}
+	}
+
 	// calc the time when it's turn to produce block
-	sched, err := poa.NewScheduler(p.nodeMaster, proposers, parent.Number(), parent.Timestamp())
+	sched, err := poa.NewWeightedScheduler(p.nodeMaster, proposers, weights, parent.Number(), parent.Timestamp(), interval)
 	if err != nil {
 		return nil, err
 	}
@@ -120,9 +151,9 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 			GasLimit:    p.gasLimit(parent.GasLimit()),
 			TotalScore:  parent.TotalScore() + score,
 		},
-		p.forkConfig)
+		p.forkConfig).SetRewardPolicy(p.rewardPolicy)
 
-	return newFlow(p, parent, rt, features), nil
+	return newFlow(p, parent, rt, features, interval), nil
 }
 
 // Mock create a packing flow upon given parent, but with a designated timestamp.
@@ -131,6 +162,11 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 func (p *Packer) Mock(parent *block.Header, targetTime uint64, gasLimit uint64) (*Flow, error) {
 	state := p.stater.NewState(parent.StateRoot())
 
+	interval, err := poa.BlockIntervalOf(state, p.forkConfig, parent.Number()+1)
+	if err != nil {
+		return nil, err
+	}
+
 	// Before process hook of VIP-191, update builtin extension contract's code to V2
 	vip191 := p.forkConfig.VIP191
 	if vip191 == 0 {
@@ -147,6 +183,9 @@ func (p *Packer) Mock(parent *block.Header, targetTime uint64, gasLimit uint64)
 	if parent.Number()+1 >= vip191 {
 		features |= tx.DelegationFeature
 	}
+	if parent.Number()+1 >= p.forkConfig.NON_ATOMIC {
+		features |= tx.NonAtomicFeature
+	}
 
 	gl := gasLimit
 	if gasLimit == 0 {
@@ -164,9 +203,9 @@ func (p *Packer) Mock(parent *block.Header, targetTime uint64, gasLimit uint64)
 			GasLimit:    gl,
 			TotalScore:  parent.TotalScore() + 1,
 		},
-		p.forkConfig)
+		p.forkConfig).SetRewardPolicy(p.rewardPolicy)
 
-	return newFlow(p, parent, rt, features), nil
+	return newFlow(p, parent, rt, features, interval), nil
 }
 
 func (p *Packer) gasLimit(parentGasLimit uint64) uint64 {
@@ -176,6 +215,31 @@ func (p *Packer) gasLimit(parentGasLimit uint64) uint64 {
 	return parentGasLimit
 }
 
+// SetRewardPolicy sets the policy to reward block beneficiaries, runtime.DefaultRewardPolicy if not set.
+func (p *Packer) SetRewardPolicy(policy runtime.RewardPolicy) {
+	p.rewardPolicy = policy
+}
+
+// SetDeadline sets the time filling txs may take, in percent of the block interval.
+// When the deadline hits, filling stops, and the block is packed with txs adopted so far.
+// A tx being executed is not interrupted. Zero means no deadline.
+func (p *Packer) SetDeadline(percent uint64) {
+	p.deadline = percent
+}
+
+// SetGasTarget sets the target gas utilization, in percent of the block gas limit.
+// Filling stops once the gas used reaches it. Zero means 100.
+func (p *Packer) SetGasTarget(percent uint64) {
+	p.gasTarget = percent
+}
+
+// SetEmptyBlockInterval sets the min interval in seconds between an empty block and its parent,
+// to suppress empty blocks, which just waste disk on dev networks. Zero means no suppression.
+// It's for solo mode only, since PoA counts a suppressed slot as missed by the proposer.
+func (p *Packer) SetEmptyBlockInterval(interval uint64) {
+	p.emptyInterval = interval
+}
+
 // SetTargetGasLimit set target gas limit, the Packer will adjust block gas limit close to
 // it as it can.
 func (p *Packer) SetTargetGasLimit(gl uint64) {
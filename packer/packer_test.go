@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/consensus"
@@ -116,6 +117,32 @@ func TestP(t *testing.T) {
 	//	fmt.Println(best)
 }
 
+func TestPackWithSealer(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a1 := genesis.DevAccounts()[0]
+	p := packer.New(repo, stater, a1.Address, &a1.Address, thor.NoFork)
+	flow, err := p.Schedule(b0.Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sealed thor.Bytes32
+	blk, _, _, err := flow.PackWithSealer(block.SealerFunc(func(sigHash thor.Bytes32) ([]byte, error) {
+		sealed = sigHash
+		return crypto.Sign(sigHash.Bytes(), a1.PrivateKey)
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, blk.Header().SigningHash(), sealed)
+	assert.Equal(t, M(a1.Address, nil), M(blk.Header().Signer()))
+
+	_, _, _, err = flow.PackWithSealer(block.NewKeySealer(genesis.DevAccounts()[1].PrivateKey))
+	assert.EqualError(t, err, "sealer mismatch")
+}
+
 func TestForkVIP191(t *testing.T) {
 	db := muxdb.NewMem()
 
@@ -224,3 +251,230 @@ func TestBlocklist(t *testing.T) {
 		t.Fatal("adopt tx from non-blocked origin should not return error")
 	}
 }
+
+func TestGasTarget(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	var txs tx.Transactions
+	for i := 0; i < 10; i++ {
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Clause(tx.NewClause(&a0.Address)).
+			Gas(21000).Nonce(uint64(i)).Expiration(math.MaxUint32).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+		txs = append(txs, trx.WithSignature(sig))
+	}
+
+	p := packer.New(repo, stater, a0.Address, &a0.Address, thor.NoFork)
+	p.SetGasTarget(50)
+	p.SetDeadline(80)
+	flow, err := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, 100000)
+	assert.Nil(t, err)
+	assert.Nil(t, flow.Fill(packer.NewCandidates(txs, nil), packer.ByGasPrice))
+
+	blk, _, _, err := flow.Pack(a0.PrivateKey)
+	assert.Nil(t, err)
+	// stops once 50000 is reached
+	assert.Equal(t, 3, len(blk.Transactions()))
+	assert.Equal(t, uint64(63000), blk.Header().GasUsed())
+}
+
+type estimates map[thor.Bytes32]uint64
+
+func (e estimates) TimeAdded(txID thor.Bytes32) int64     { return 0 }
+func (e estimates) EstimatedGas(txID thor.Bytes32) uint64 { return e[txID] }
+
+func TestFillByEstimatedGas(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	newTx := func(nonce uint64, clause *tx.Clause) *tx.Transaction {
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Clause(clause).
+			Gas(100000).Nonce(nonce).Expiration(math.MaxUint32).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+	transfer, _ := builtin.Energy.ABI.MethodByName("transfer")
+	data, _ := transfer.EncodeInput(a0.Address, big.NewInt(1))
+	// uses far less gas than declared
+	cheap := newTx(1, tx.NewClause(&a0.Address))
+	// estimated too low
+	costly := newTx(2, tx.NewClause(&builtin.Energy.Address).WithData(data))
+
+	p := packer.New(repo, stater, a0.Address, &a0.Address, thor.NoFork)
+	fill := func(info packer.TxInfo) *block.Block {
+		flow, err := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, 30000)
+		assert.Nil(t, err)
+		assert.Nil(t, flow.Fill(packer.NewCandidates(tx.Transactions{costly, cheap}, info), packer.ByGasPrice))
+		blk, _, _, err := flow.Pack(a0.PrivateKey)
+		assert.Nil(t, err)
+		return blk
+	}
+
+	// declared gas doesn't fit
+	assert.Equal(t, 0, len(fill(nil).Transactions()))
+
+	blk := fill(estimates{cheap.ID(): 21000, costly.ID(): 21000})
+	assert.Equal(t, tx.Transactions{cheap}, blk.Transactions())
+	assert.Equal(t, uint64(21000), blk.Header().GasUsed())
+}
+
+func TestBuildTemplate(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	p := packer.New(repo, stater, a0.Address, &a0.Address, thor.NoFork)
+	now := uint64(time.Now().Unix())
+	flow, err := p.Schedule(b0.Header(), now)
+	assert.Nil(t, err)
+
+	tmpl, err := flow.BuildTemplate()
+	assert.Nil(t, err)
+	assert.Equal(t, tmpl.Block().Header().SigningHash(), tmpl.SigningHash())
+	assert.Equal(t, 0, len(tmpl.Block().Header().Signature()))
+
+	// signed by others
+	sig, _ := crypto.Sign(tmpl.SigningHash().Bytes(), genesis.DevAccounts()[1].PrivateKey)
+	_, _, _, err = tmpl.Submit(sig)
+	assert.EqualError(t, err, "sealer mismatch")
+
+	sig, _ = crypto.Sign(tmpl.SigningHash().Bytes(), a0.PrivateKey)
+	blk, stage, _, err := tmpl.Submit(sig)
+	assert.Nil(t, err)
+	assert.Equal(t, M(a0.Address, nil), M(blk.Header().Signer()))
+
+	_, err = stage.Commit()
+	assert.Nil(t, err)
+	_, _, err = consensus.New(repo, stater, thor.NoFork).Process(blk, now)
+	assert.Nil(t, err)
+}
+
+func TestEmptyBlockSuppression(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	p := packer.New(repo, stater, a0.Address, &a0.Address, thor.NoFork)
+	mock := func(elapsed uint64) *packer.Flow {
+		flow, err := p.Mock(b0.Header(), b0.Header().Timestamp()+elapsed, b0.Header().GasLimit())
+		assert.Nil(t, err)
+		return flow
+	}
+
+	assert.False(t, mock(thor.BlockInterval).Suppressed())
+
+	p.SetEmptyBlockInterval(60)
+	assert.True(t, mock(thor.BlockInterval).Suppressed())
+	assert.False(t, mock(60).Suppressed())
+
+	trx := new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		Clause(tx.NewClause(&a0.Address)).
+		Gas(21000).Expiration(math.MaxUint32).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+	flow := mock(thor.BlockInterval)
+	assert.Nil(t, flow.Adopt(trx.WithSignature(sig)))
+	assert.False(t, flow.Suppressed())
+}
+
+func TestExtendFlow(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	newTx := func(nonce uint64) *tx.Transaction {
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Clause(tx.NewClause(&a0.Address)).
+			Gas(21000).Nonce(nonce).Expiration(math.MaxUint32).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+
+	p := packer.New(repo, stater, a0.Address, &a0.Address, thor.NoFork)
+	p.SetDeadline(80)
+	flow, err := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, 50000)
+	assert.Nil(t, err)
+	assert.True(t, flow.Deadline().IsZero())
+
+	tx1 := newTx(1)
+	assert.False(t, flow.Adopted(tx1.ID()))
+	assert.Nil(t, flow.Fill(packer.NewCandidates(tx.Transactions{tx1}, nil), packer.ByGasPrice))
+	assert.True(t, flow.Adopted(tx1.ID()))
+	assert.True(t, flow.Extendable())
+	deadline := flow.Deadline()
+	assert.True(t, deadline.After(time.Now()))
+
+	// late arrivals
+	assert.Nil(t, flow.Fill(packer.NewCandidates(tx.Transactions{newTx(2)}, nil), packer.ByGasPrice))
+	assert.False(t, flow.Extendable())
+	// shared by fillings
+	assert.Equal(t, deadline, flow.Deadline())
+
+	blk, _, _, err := flow.Pack(a0.PrivateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(blk.Transactions()))
+}
+
+func TestBlockSize(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	newTx := func(nonce uint64, dataSize int) *tx.Transaction {
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Clause(tx.NewClause(&a0.Address).WithData(make([]byte, dataSize))).
+			Gas(9000000).Nonce(nonce).Expiration(math.MaxUint32).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+	var (
+		big1     = newTx(1, 1200*1024)
+		big2     = newTx(2, 1200*1024)
+		small    = newTx(3, 0)
+		oversize = newTx(4, int(thor.MaxBlockSize))
+	)
+
+	forkConfig := thor.NoFork
+	forkConfig.BLOCKSIZE = 0
+	p := packer.New(repo, stater, a0.Address, &a0.Address, forkConfig)
+	flow, err := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, 40000000)
+	assert.Nil(t, err)
+
+	rejected := flow.Fill(packer.NewCandidates(tx.Transactions{big1, big2, small, oversize}, nil), packer.ByGasPrice)
+	assert.Equal(t, tx.Transactions{oversize}, rejected)
+
+	blk, _, _, err := flow.Pack(a0.PrivateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(blk.Transactions()))
+	assert.Contains(t, blk.Transactions(), small)
+	assert.True(t, uint64(blk.Size()) <= thor.MaxBlockSize)
+
+	// no limit before fork
+	flow, err = packer.New(repo, stater, a0.Address, &a0.Address, thor.NoFork).
+		Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, 40000000)
+	assert.Nil(t, err)
+	assert.Nil(t, flow.Fill(packer.NewCandidates(tx.Transactions{big1, big2, small}, nil), packer.ByGasPrice))
+	blk, _, _, err = flow.Pack(a0.PrivateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(blk.Transactions()))
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/energy"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/xenv"
+)
+
+// roundSizePerWorker is the count of candidates executed speculatively per worker in a round.
+const roundSizePerWorker = 4
+
+// speculation is the result of a tx executed against an overlay of the flow state.
+type speculation struct {
+	overlay *state.Overlay
+	receipt *tx.Receipt
+	rewards []runtime.Reward
+}
+
+// capturedRewards computes rewards by the policy, but captures them instead of applying,
+// so that they are applied when the speculation is committed.
+type capturedRewards struct {
+	policy  runtime.RewardPolicy
+	rewards []runtime.Reward
+}
+
+func (c *capturedRewards) Rewards(st *state.State, ctx *xenv.BlockContext, gasUsed uint64, overallGasPrice *big.Int) ([]runtime.Reward, error) {
+	rewards, err := c.policy.Rewards(st, ctx, gasUsed, overallGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	c.rewards = append(c.rewards, rewards...)
+	return nil, nil
+}
+
+// FillParallel is like Fill, but executes candidates speculatively by concurrent workers, in rounds.
+// Each tx of a round is executed against its own overlay of the flow state, and results are committed
+// in order. A tx which read anything written by txs committed before it in the same round is
+// re-executed serially, so the packed block is the same as the one packed by Fill.
+func (f *Flow) FillParallel(candidates []*Candidate, strategy SelectionStrategy, workers int) (rejected tx.Transactions) {
+	if workers < 2 {
+		return f.Fill(candidates, strategy)
+	}
+
+	var (
+		deadline = f.startFill()
+		ordered  = strategy.Order(candidates)
+	)
+	defer func(start time.Time) { f.fillDuration += time.Since(start) }(time.Now())
+	for len(ordered) > 0 {
+		n := workers * roundSizePerWorker
+		if n > len(ordered) {
+			n = len(ordered)
+		}
+		round := ordered[:n]
+		ordered = ordered[n:]
+
+		specs := f.speculate(round, workers)
+		written, werr := newWrittenSet(f.runtime.State(), f.runtime.Context().Time)
+		for i, c := range round {
+			if f.fillDone(deadline) {
+				return
+			}
+			var err error
+			if werr != nil {
+				err = f.adopt(c.Tx, c.EstimatedGas)
+			} else {
+				if err = f.check(c.Tx, c.EstimatedGas); err == nil {
+					if spec := specs[i]; spec != nil && !written.conflicts(spec.overlay.Reads()) {
+						err = f.commit(c.Tx, spec, written)
+					} else {
+						err = f.execute(c.Tx, written)
+					}
+				}
+				f.record(err)
+			}
+			if err != nil {
+				if IsGasLimitReached(err) {
+					return
+				}
+				if !IsTxNotAdoptableNow(err) {
+					rejected = append(rejected, c.Tx)
+				}
+			}
+		}
+	}
+	return
+}
+
+// speculate executes txs concurrently. The result is nil for a tx not executed, or failed to execute.
+func (f *Flow) speculate(txs []*Candidate, workers int) []*speculation {
+	var (
+		specs = make([]*speculation, len(txs))
+		lock  sync.Mutex
+		next  = int32(-1)
+		wg    sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt32(&next, 1))
+				if i >= len(txs) {
+					return
+				}
+				// the dependency may be adopted in the same round
+				if txs[i].Tx.DependsOn() != nil {
+					continue
+				}
+				overlay := state.NewConcurrentOverlay(f.runtime.State(), &lock)
+				rewards := &capturedRewards{policy: f.packer.rewardPolicy}
+				rt := runtime.New(
+					f.packer.repo.NewChain(f.parentHeader.ID()),
+					overlay.State,
+					f.runtime.Context(),
+					f.packer.forkConfig).SetRewardPolicy(rewards)
+				if receipt, err := rt.ExecuteTransaction(txs[i].Tx); err == nil {
+					specs[i] = &speculation{overlay, receipt, rewards.rewards}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return specs
+}
+
+// commit applies the speculation to the flow state.
+func (f *Flow) commit(tx *tx.Transaction, spec *speculation, written *writtenSet) error {
+	if !f.fits(spec.receipt) {
+		return errTxNotAdoptableNow
+	}
+	var (
+		st     = f.runtime.State()
+		writes = spec.overlay.Writes()
+	)
+	if writes.HasStorage(builtin.Energy.Address, energy.TotalAddSubKey) {
+		// the overlay accumulated totals onto those at the round start, and
+		// accumulation by txs committed since then should be kept
+		add, sub, err := written.totalsSinceStart()
+		if err != nil {
+			return err
+		}
+		spec.overlay.Flatten()
+		if err := builtin.Energy.Native(st, f.runtime.Context().Time).AccumulateTotalAddSub(add, sub); err != nil {
+			return err
+		}
+	} else {
+		spec.overlay.Flatten()
+	}
+	written.merge(writes)
+
+	reward := new(big.Int)
+	for _, r := range spec.rewards {
+		if err := builtin.Energy.Native(st, f.runtime.Context().Time).Add(r.Recipient, r.Amount); err != nil {
+			return err
+		}
+		if r.Amount.Sign() != 0 {
+			written.addReward(r)
+		}
+		reward.Add(reward, r.Amount)
+	}
+	spec.receipt.Reward = reward
+
+	f.adopted(tx, spec.receipt)
+	return nil
+}
+
+// execute executes the tx serially on the flow state.
+func (f *Flow) execute(tx *tx.Transaction, written *writtenSet) error {
+	overlay := state.NewOverlay(f.runtime.State())
+	rt := runtime.New(
+		f.runtime.Chain(),
+		overlay.State,
+		f.runtime.Context(),
+		f.packer.forkConfig).SetRewardPolicy(f.packer.rewardPolicy)
+	receipt, err := rt.ExecuteTransaction(tx)
+	if err != nil {
+		return badTxError{err.Error()}
+	}
+	if !f.fits(receipt) {
+		return errTxNotAdoptableNow
+	}
+	written.merge(overlay.Writes())
+	overlay.Flatten()
+
+	f.adopted(tx, receipt)
+	return nil
+}
+
+// writtenSet keeps keys written by txs committed in a round.
+// Energy totals are kept apart, since accumulating them doesn't conflict.
+type writtenSet struct {
+	keys          state.KeySet
+	energy        *energy.Energy
+	startAdd      *big.Int
+	startSub      *big.Int
+	totalsChanged bool
+}
+
+func newWrittenSet(st *state.State, blockTime uint64) (*writtenSet, error) {
+	eng := builtin.Energy.Native(st, blockTime)
+	add, sub, err := eng.TotalAddSub()
+	if err != nil {
+		return nil, err
+	}
+	return &writtenSet{
+		keys:     make(state.KeySet),
+		energy:   eng,
+		startAdd: add,
+		startSub: sub,
+	}, nil
+}
+
+func (w *writtenSet) merge(writes state.KeySet) {
+	if writes.HasStorage(builtin.Energy.Address, energy.TotalAddSubKey) {
+		w.totalsChanged = true
+		writes.RemoveStorage(builtin.Energy.Address, energy.TotalAddSubKey)
+	}
+	w.keys.Merge(writes)
+}
+
+func (w *writtenSet) addReward(r runtime.Reward) {
+	w.keys.AddAccount(r.Recipient)
+	w.totalsChanged = true
+}
+
+// conflicts returns whether the reads are affected by the writes.
+func (w *writtenSet) conflicts(reads state.KeySet) bool {
+	if w.totalsChanged && reads.HasStorage(builtin.Energy.Address, energy.TotalsReadKey) {
+		return true
+	}
+	return reads.Intersects(w.keys)
+}
+
+// totalsSinceStart returns energy totals accumulated since the round start.
+func (w *writtenSet) totalsSinceStart() (add *big.Int, sub *big.Int, err error) {
+	if add, sub, err = w.energy.TotalAddSub(); err != nil {
+		return
+	}
+	return new(big.Int).Sub(add, w.startAdd), new(big.Int).Sub(sub, w.startSub), nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestFillParallel(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, _ := genesis.NewDevnet().Build(stater)
+	repo, _ := chain.NewRepository(db, b0)
+
+	accs := genesis.DevAccounts()
+	transfer, _ := builtin.Energy.ABI.MethodByName("transfer")
+	totalBurned, _ := builtin.Energy.ABI.MethodByName("totalBurned")
+
+	var nonce uint64
+	newTx := func(from int, clause *tx.Clause) *tx.Transaction {
+		nonce++
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Clause(clause).
+			Gas(100000).Nonce(nonce).Expiration(math.MaxUint32).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), accs[from].PrivateKey)
+		return trx.WithSignature(sig)
+	}
+	newTransfer := func(from, to int) *tx.Transaction {
+		data, _ := transfer.EncodeInput(accs[to].Address, big.NewInt(1))
+		return newTx(from, tx.NewClause(&builtin.Energy.Address).WithData(data))
+	}
+	burned, _ := totalBurned.EncodeInput()
+
+	var txs tx.Transactions
+	// independent
+	for i := 1; i < 5; i++ {
+		txs = append(txs, newTransfer(i, i+5))
+	}
+	// conflicting on accounts, and on the beneficiary accs[0]
+	txs = append(txs, newTransfer(1, 2), newTransfer(2, 1), newTransfer(0, 3), newTransfer(4, 0))
+	// reading energy totals
+	txs = append(txs, newTx(5, tx.NewClause(&builtin.Energy.Address).WithData(burned)))
+	// depending on one in the same round
+	dep := newTransfer(3, 4)
+	id := txs[0].ID()
+	txs = append(txs, new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		Clause(tx.NewClause(&accs[9].Address)).
+		Gas(100000).Nonce(100).Expiration(math.MaxUint32).DependsOn(&id).Build())
+	sig, _ := crypto.Sign(txs[len(txs)-1].SigningHash().Bytes(), accs[6].PrivateKey)
+	txs[len(txs)-1] = txs[len(txs)-1].WithSignature(sig)
+	txs = append(txs, dep)
+
+	p := packer.New(repo, stater, accs[0].Address, &accs[0].Address, thor.NoFork)
+	now := uint64(time.Now().Unix())
+
+	serial, err := p.Schedule(b0.Header(), now)
+	assert.Nil(t, err)
+	assert.Nil(t, serial.Fill(packer.NewCandidates(txs, nil), packer.ByGasPrice))
+	serialBlk, _, serialReceipts, err := serial.Pack(accs[0].PrivateKey)
+	assert.Nil(t, err)
+
+	for _, workers := range []int{2, 4} {
+		flow, err := p.Schedule(b0.Header(), now)
+		assert.Nil(t, err)
+		assert.Nil(t, flow.FillParallel(packer.NewCandidates(txs, nil), packer.ByGasPrice, workers))
+		blk, stage, receipts, err := flow.Pack(accs[0].PrivateKey)
+		assert.Nil(t, err)
+
+		assert.Equal(t, len(txs), len(blk.Transactions()))
+		assert.Equal(t, serialBlk.Header().StateRoot(), blk.Header().StateRoot())
+		assert.Equal(t, serialBlk.Header().ReceiptsRoot(), blk.Header().ReceiptsRoot())
+		assert.Equal(t, serialReceipts, receipts)
+
+		_, err = stage.Commit()
+		assert.Nil(t, err)
+		_, _, err = consensus.New(repo, stater, thor.NoFork).Process(blk, now)
+		assert.Nil(t, err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"sort"
+
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Candidate is a tx to be adopted, with info for selection.
+type Candidate struct {
+	Tx           *tx.Transaction
+	Origin       thor.Address
+	TimeAdded    int64  // when the tx entered the pool, in unix nano
+	EstimatedGas uint64 // gas used by a trial execution, 0 if unknown
+}
+
+// TxInfo provides info of txs in the pool.
+type TxInfo interface {
+	TimeAdded(txID thor.Bytes32) int64
+	EstimatedGas(txID thor.Bytes32) uint64
+}
+
+// NewCandidates creates candidates from txs. The info is optional.
+func NewCandidates(txs tx.Transactions, info TxInfo) []*Candidate {
+	candidates := make([]*Candidate, 0, len(txs))
+	for _, t := range txs {
+		origin, err := t.Origin()
+		if err != nil {
+			continue
+		}
+		c := &Candidate{Tx: t, Origin: origin}
+		if info != nil {
+			c.TimeAdded = info.TimeAdded(t.ID())
+			c.EstimatedGas = info.EstimatedGas(t.ID())
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// SelectionStrategy decides the order in which candidates are adopted.
+type SelectionStrategy interface {
+	// Order returns candidates in the order to be adopted. The input is not modified.
+	Order(candidates []*Candidate) []*Candidate
+}
+
+// SelectionStrategies by name.
+var SelectionStrategies = map[string]SelectionStrategy{
+	"price":    ByGasPrice,
+	"fifo":     FIFO,
+	"fairness": OriginFairness,
+}
+
+// ByGasPrice keeps the order of candidates, which the tx pool already sorts by overall gas price.
+var ByGasPrice SelectionStrategy = byGasPrice{}
+
+// FIFO orders candidates by the time they entered the pool.
+var FIFO SelectionStrategy = fifo{}
+
+// OriginFairness takes candidates of each origin in turn, so a busy origin can't crowd out others.
+// Candidates of the same origin keep their order.
+var OriginFairness SelectionStrategy = originFairness{}
+
+type byGasPrice struct{}
+
+func (byGasPrice) Order(candidates []*Candidate) []*Candidate {
+	return candidates
+}
+
+type fifo struct{}
+
+func (fifo) Order(candidates []*Candidate) []*Candidate {
+	sorted := append([]*Candidate(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TimeAdded < sorted[j].TimeAdded
+	})
+	return sorted
+}
+
+type originFairness struct{}
+
+func (originFairness) Order(candidates []*Candidate) []*Candidate {
+	var (
+		origins []thor.Address
+		queues  = make(map[thor.Address][]*Candidate)
+	)
+	for _, c := range candidates {
+		if _, ok := queues[c.Origin]; !ok {
+			origins = append(origins, c.Origin)
+		}
+		queues[c.Origin] = append(queues[c.Origin], c)
+	}
+
+	result := make([]*Candidate, 0, len(candidates))
+	for round := 0; len(result) < len(candidates); round++ {
+		for _, origin := range origins {
+			if q := queues[origin]; round < len(q) {
+				result = append(result, q[round])
+			}
+		}
+	}
+	return result
+}
+
+type prioritized struct {
+	strategy SelectionStrategy
+	origins  map[thor.Address]bool
+}
+
+// Prioritize returns a strategy which takes candidates from the priority origins first, regardless of
+// gas price, and then the others. Both parts are ordered by the given strategy.
+func Prioritize(strategy SelectionStrategy, origins []thor.Address) SelectionStrategy {
+	p := &prioritized{strategy, make(map[thor.Address]bool, len(origins))}
+	for _, origin := range origins {
+		p.origins[origin] = true
+	}
+	return p
+}
+
+func (p *prioritized) Order(candidates []*Candidate) []*Candidate {
+	var first, rest []*Candidate
+	for _, c := range candidates {
+		if p.origins[c.Origin] {
+			first = append(first, c)
+		} else {
+			rest = append(rest, c)
+		}
+	}
+	return append(p.strategy.Order(first), p.strategy.Order(rest)...)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+type txInfo map[thor.Bytes32]int64
+
+func (ti txInfo) TimeAdded(txID thor.Bytes32) int64     { return ti[txID] }
+func (ti txInfo) EstimatedGas(txID thor.Bytes32) uint64 { return 0 }
+
+func TestSelectionStrategies(t *testing.T) {
+	accs := genesis.DevAccounts()
+	newTx := func(nonce uint64, from int) *tx.Transaction {
+		trx := new(tx.Builder).Nonce(nonce).Gas(21000).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), accs[from].PrivateKey)
+		return trx.WithSignature(sig)
+	}
+
+	// pool order, with txs of origin 0 crowding
+	txs := tx.Transactions{newTx(1, 0), newTx(2, 0), newTx(3, 0), newTx(4, 1), newTx(5, 2)}
+	added := txInfo{
+		txs[0].ID(): 50,
+		txs[1].ID(): 40,
+		txs[2].ID(): 30,
+		txs[3].ID(): 10,
+		txs[4].ID(): 20,
+	}
+	candidates := packer.NewCandidates(txs, added)
+	assert.Equal(t, accs[1].Address, candidates[3].Origin)
+
+	order := func(s packer.SelectionStrategy) (result tx.Transactions) {
+		for _, c := range s.Order(candidates) {
+			result = append(result, c.Tx)
+		}
+		return
+	}
+
+	assert.Equal(t, txs, order(packer.ByGasPrice))
+	assert.Equal(t, tx.Transactions{txs[3], txs[4], txs[2], txs[1], txs[0]}, order(packer.FIFO))
+	assert.Equal(t, tx.Transactions{txs[0], txs[3], txs[4], txs[1], txs[2]}, order(packer.OriginFairness))
+
+	// input not modified
+	assert.Equal(t, txs[0], candidates[0].Tx)
+}
+
+func TestPrioritize(t *testing.T) {
+	accs := genesis.DevAccounts()
+	newTx := func(nonce uint64, from int) *tx.Transaction {
+		trx := new(tx.Builder).Nonce(nonce).Gas(21000).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), accs[from].PrivateKey)
+		return trx.WithSignature(sig)
+	}
+	txs := tx.Transactions{newTx(1, 0), newTx(2, 1), newTx(3, 2), newTx(4, 1)}
+	added := txInfo{
+		txs[0].ID(): 10,
+		txs[1].ID(): 40,
+		txs[2].ID(): 20,
+		txs[3].ID(): 30,
+	}
+	candidates := packer.NewCandidates(txs, added)
+
+	order := func(s packer.SelectionStrategy) (result tx.Transactions) {
+		for _, c := range s.Order(candidates) {
+			result = append(result, c.Tx)
+		}
+		return
+	}
+
+	priority := []thor.Address{accs[1].Address}
+	assert.Equal(t, tx.Transactions{txs[1], txs[3], txs[0], txs[2]}, order(packer.Prioritize(packer.ByGasPrice, priority)))
+	assert.Equal(t, tx.Transactions{txs[3], txs[1], txs[0], txs[2]}, order(packer.Prioritize(packer.FIFO, priority)))
+	assert.Equal(t, txs, order(packer.Prioritize(packer.ByGasPrice, nil)))
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Template is an unsealed block built by a flow, to be signed by an external process,
+// e.g. a remote signer, or parties of a multi-party proposer.
+type Template struct {
+	flow     *Flow
+	block    *block.Block
+	stage    *state.Stage
+	receipts tx.Receipts
+}
+
+// BuildTemplate builds the new block with txs adopted so far, without sealing it.
+func (f *Flow) BuildTemplate() (*Template, error) {
+	stage, err := f.runtime.State().Stage()
+	if err != nil {
+		return nil, err
+	}
+	stateRoot := stage.Hash()
+
+	builder := new(block.Builder).
+		Beneficiary(f.runtime.Context().Beneficiary).
+		GasLimit(f.runtime.Context().GasLimit).
+		ParentID(f.parentHeader.ID()).
+		Timestamp(f.runtime.Context().Time).
+		TotalScore(f.runtime.Context().TotalScore).
+		GasUsed(f.gasUsed).
+		ReceiptsRoot(f.receipts.RootHash()).
+		StateRoot(stateRoot).
+		TransactionFeatures(f.features)
+
+	for _, tx := range f.txs {
+		builder.Transaction(tx)
+	}
+	newBlock, err := builder.BuildValidated()
+	if err != nil {
+		return nil, err
+	}
+	return &Template{f, newBlock, stage, f.receipts}, nil
+}
+
+// Block returns the unsealed block.
+func (t *Template) Block() *block.Block {
+	return t.block
+}
+
+// SigningHash returns the hash to be signed by the node master.
+func (t *Template) SigningHash() thor.Bytes32 {
+	return t.block.Header().SigningHash()
+}
+
+// Submit completes the block with the signature of the signing hash, which must be signed by the node master.
+func (t *Template) Submit(sig []byte) (*block.Block, *state.Stage, tx.Receipts, error) {
+	newBlock := t.block.WithSignature(sig)
+	if signer, err := newBlock.Header().Signer(); err != nil {
+		return nil, nil, nil, err
+	} else if signer != t.flow.packer.nodeMaster {
+		return nil, nil, nil, errors.New("sealer mismatch")
+	}
+	t.flow.recordBlock()
+	return newBlock, t.stage, t.receipts, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package poa
+
+import (
+	"encoding/binary"
+
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// EpochStart returns number of the first block of the epoch, which the block belongs to.
+func EpochStart(blockNum uint32) uint32 {
+	return blockNum / thor.CheckpointInterval * thor.CheckpointInterval
+}
+
+// EpochAnchor returns number of the block, whose state decides members of the epoch which the block belongs to.
+// It's the last block of the previous epoch, or genesis for the first epoch.
+func EpochAnchor(blockNum uint32) uint32 {
+	if start := EpochStart(blockNum); start > 0 {
+		return start - 1
+	}
+	return 0
+}
+
+// EpochSeed returns number of the block, whose id is the seed to shuffle members of the epoch
+// which the block belongs to. It's the first block of the previous epoch, or genesis for the first epoch.
+func EpochSeed(blockNum uint32) uint32 {
+	if start := EpochStart(blockNum); start > 0 {
+		return start - thor.CheckpointInterval
+	}
+	return 0
+}
+
+// EpochMembers returns addresses of proposers of the epoch, which the block numbered blockNum belongs to.
+//
+// Members are picked from the state at the end of the previous epoch, and shuffled with ID of the first
+// block of the previous epoch as seed. The seed is fixed one epoch ahead, so the last proposer can't bias it.
+// The chain c must contain the parent of the block.
+func EpochMembers(c *chain.Chain, stater *state.Stater, blockNum uint32) ([]thor.Address, error) {
+	header, err := c.GetBlockHeader(EpochAnchor(blockNum))
+	if err != nil {
+		return nil, err
+	}
+	seed, err := c.GetBlockID(EpochSeed(blockNum))
+	if err != nil {
+		return nil, err
+	}
+
+	st := stater.NewState(header.StateRoot())
+	list, err := builtin.Authority.Native(st).AllCandidates()
+	if err != nil {
+		return nil, err
+	}
+	proposers, err := NewCandidates(list).Pick(st)
+	if err != nil {
+		return nil, err
+	}
+	return ShuffleMembers(proposers, seed), nil
+}
+
+// ShuffleMembers returns addresses of proposers, shuffled with the seed.
+func ShuffleMembers(proposers []Proposer, seed thor.Bytes32) []thor.Address {
+	members := make([]thor.Address, 0, len(proposers))
+	for _, p := range proposers {
+		members = append(members, p.Address)
+	}
+	shuffle(members, seed)
+	return members
+}
+
+// EpochProposers returns proposers which are epoch members, in order of members.
+// Proposers not in members have to wait for the next epoch, while members no longer
+// eligible to propose are removed immediately.
+// If none of members remains, proposers are returned as is, to keep the chain going.
+func EpochProposers(members []thor.Address, proposers []Proposer) []Proposer {
+	indices := make(map[thor.Address]int, len(proposers))
+	for i, p := range proposers {
+		indices[p.Address] = i
+	}
+
+	result := make([]Proposer, 0, len(members))
+	for _, m := range members {
+		if i, ok := indices[m]; ok {
+			result = append(result, proposers[i])
+		}
+	}
+	if len(result) == 0 {
+		return proposers
+	}
+	return result
+}
+
+// shuffle shuffles addresses deterministically with the seed, using Fisher-Yates.
+func shuffle(addrs []thor.Address, seed thor.Bytes32) {
+	var b4 [4]byte
+	for i := len(addrs) - 1; i > 0; i-- {
+		binary.BigEndian.PutUint32(b4[:], uint32(i))
+		r := binary.BigEndian.Uint64(thor.Blake2b(seed[:], b4[:]).Bytes())
+		j := r % uint64(i+1)
+		addrs[i], addrs[j] = addrs[j], addrs[i]
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package poa_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+func TestEpochStart(t *testing.T) {
+	assert.Equal(t, uint32(0), poa.EpochStart(1))
+	assert.Equal(t, uint32(0), poa.EpochAnchor(1))
+	assert.Equal(t, thor.CheckpointInterval, poa.EpochStart(thor.CheckpointInterval*2-1))
+	assert.Equal(t, thor.CheckpointInterval-1, poa.EpochAnchor(thor.CheckpointInterval*2-1))
+}
+
+func TestEpochMembers(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			for _, acc := range genesis.DevAccounts() {
+				state.SetBalance(acc.Address, new(big.Int).Set(thor.InitialProposerEndorsement))
+				builtin.Authority.Native(state).Add(acc.Address, acc.Address, thor.Bytes32{})
+			}
+			return nil
+		}).Build(stater)
+	assert.Nil(t, err)
+	repo, err := chain.NewRepository(db, b0)
+	assert.Nil(t, err)
+
+	members, err := poa.EpochMembers(repo.NewBestChain(), stater, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, len(genesis.DevAccounts()), len(members))
+
+	// deterministic
+	again, err := poa.EpochMembers(repo.NewBestChain(), stater, thor.CheckpointInterval-1)
+	assert.Nil(t, err)
+	assert.Equal(t, members, again)
+
+	// shuffled
+	var ordered []thor.Address
+	for _, acc := range genesis.DevAccounts() {
+		ordered = append(ordered, acc.Address)
+	}
+	assert.ElementsMatch(t, ordered, members)
+	assert.NotEqual(t, ordered, members)
+}
+
+func TestEpochProposers(t *testing.T) {
+	proposers := []poa.Proposer{{p1, true}, {p2, false}, {p3, true}}
+
+	// p4 not eligible, and p3 waits for the next epoch
+	assert.Equal(t,
+		[]poa.Proposer{{p2, false}, {p1, true}},
+		poa.EpochProposers([]thor.Address{p4, p2, p1}, proposers))
+
+	// none of members remains
+	assert.Equal(t, proposers, poa.EpochProposers([]thor.Address{p4, p5}, proposers))
+}
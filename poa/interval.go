@@ -0,0 +1,33 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package poa
+
+import (
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// BlockInterval returns the block interval set in the Params builtin.
+// It falls back to thor.BlockInterval if not set or out of range (0, thor.MaxBlockInterval].
+func BlockInterval(st *state.State) (uint64, error) {
+	v, err := builtin.Params.Native(st).Get(thor.KeyBlockInterval)
+	if err != nil {
+		return 0, err
+	}
+	if v.Sign() <= 0 || !v.IsUint64() || v.Uint64() > thor.MaxBlockInterval {
+		return thor.BlockInterval, nil
+	}
+	return v.Uint64(), nil
+}
+
+// BlockIntervalOf returns the interval of the block numbered num, read from the parent state st since the INTERVAL fork.
+func BlockIntervalOf(st *state.State, forkConfig thor.ForkConfig, num uint32) (uint64, error) {
+	if num < forkConfig.INTERVAL {
+		return thor.BlockInterval, nil
+	}
+	return BlockInterval(st)
+}
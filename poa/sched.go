@@ -8,6 +8,7 @@ package poa
 import (
 	"encoding/binary"
 	"errors"
+	"sort"
 
 	"github.com/vechain/thor/thor"
 )
@@ -18,6 +19,8 @@ type Scheduler struct {
 	actives           []Proposer
 	parentBlockNumber uint32
 	parentBlockTime   uint64
+	interval          uint64
+	bounds            []uint64 // accumulated weights of actives, nil if equally weighted
 }
 
 // NewScheduler create a Scheduler object.
@@ -28,6 +31,28 @@ func NewScheduler(
 	proposers []Proposer,
 	parentBlockNumber uint32,
 	parentBlockTime uint64) (*Scheduler, error) {
+	return NewSchedulerWithInterval(addr, proposers, parentBlockNumber, parentBlockTime, thor.BlockInterval)
+}
+
+// NewSchedulerWithInterval create a Scheduler object with the given block interval.
+func NewSchedulerWithInterval(
+	addr thor.Address,
+	proposers []Proposer,
+	parentBlockNumber uint32,
+	parentBlockTime uint64,
+	interval uint64) (*Scheduler, error) {
+	return NewWeightedScheduler(addr, proposers, nil, parentBlockNumber, parentBlockTime, interval)
+}
+
+// NewWeightedScheduler create a Scheduler object, which allocates time slots to proposers in proportion to weights.
+// Proposers absent from weights have weight 1. Nil weights means equally weighted.
+func NewWeightedScheduler(
+	addr thor.Address,
+	proposers []Proposer,
+	weights map[thor.Address]uint64,
+	parentBlockNumber uint32,
+	parentBlockTime uint64,
+	interval uint64) (*Scheduler, error) {
 
 	actives := make([]Proposer, 0, len(proposers))
 	listed := false
@@ -46,23 +71,44 @@ func NewScheduler(
 		return nil, errors.New("unauthorized block proposer")
 	}
 
+	var bounds []uint64
+	if weights != nil {
+		bounds = make([]uint64, 0, len(actives))
+		var sum uint64
+		for _, p := range actives {
+			if w, ok := weights[p.Address]; ok && w > 0 {
+				sum += w
+			} else {
+				sum++
+			}
+			bounds = append(bounds, sum)
+		}
+	}
+
 	return &Scheduler{
 		proposer,
 		actives,
 		parentBlockNumber,
 		parentBlockTime,
+		interval,
+		bounds,
 	}, nil
 }
 
 func (s *Scheduler) whoseTurn(t uint64) Proposer {
-	index := dprp(s.parentBlockNumber, t) % uint64(len(s.actives))
+	if s.bounds == nil {
+		index := dprp(s.parentBlockNumber, t) % uint64(len(s.actives))
+		return s.actives[index]
+	}
+	r := dprp(s.parentBlockNumber, t) % s.bounds[len(s.bounds)-1]
+	index := sort.Search(len(s.bounds), func(i int) bool { return s.bounds[i] > r })
 	return s.actives[index]
 }
 
 // Schedule to determine time of the proposer to produce a block, according to `nowTime`.
 // `newBlockTime` is promised to be >= nowTime and > parentBlockTime
 func (s *Scheduler) Schedule(nowTime uint64) (newBlockTime uint64) {
-	const T = thor.BlockInterval
+	T := s.interval
 
 	newBlockTime = s.parentBlockTime + T
 
@@ -89,7 +135,7 @@ func (s *Scheduler) IsTheTime(newBlockTime uint64) bool {
 		return false
 	}
 
-	if (newBlockTime-s.parentBlockTime)%thor.BlockInterval != 0 {
+	if (newBlockTime-s.parentBlockTime)%s.interval != 0 {
 		// invalid block time
 		return false
 	}
@@ -97,18 +143,26 @@ func (s *Scheduler) IsTheTime(newBlockTime uint64) bool {
 	return s.whoseTurn(newBlockTime).Address == s.proposer.Address
 }
 
-// Updates returns proposers whose status are change, and the score when new block time is assumed to be newBlockTime.
-func (s *Scheduler) Updates(newBlockTime uint64) (updates []Proposer, score uint64) {
-
-	toDeactivate := make(map[thor.Address]Proposer)
-
-	t := newBlockTime - thor.BlockInterval
+// Missed returns proposers who missed their time slots, when new block time is assumed to be newBlockTime.
+// A proposer is listed once for each slot missed, and at most thor.MaxBlockProposers recent slots are checked.
+func (s *Scheduler) Missed(newBlockTime uint64) (missed []Proposer) {
+	t := newBlockTime - s.interval
 	for i := uint64(0); i < thor.MaxBlockProposers && t > s.parentBlockTime; i++ {
 		p := s.whoseTurn(t)
 		if p.Address != s.proposer.Address {
-			toDeactivate[p.Address] = p
+			missed = append(missed, p)
 		}
-		t -= thor.BlockInterval
+		t -= s.interval
+	}
+	return
+}
+
+// Updates returns proposers whose status are change, and the score when new block time is assumed to be newBlockTime.
+func (s *Scheduler) Updates(newBlockTime uint64) (updates []Proposer, score uint64) {
+
+	toDeactivate := make(map[thor.Address]Proposer)
+	for _, p := range s.Missed(newBlockTime) {
+		toDeactivate[p.Address] = p
 	}
 
 	updates = make([]Proposer, 0, len(toDeactivate)+1)
@@ -80,3 +80,53 @@ func TestUpdates(t *testing.T) {
 		assert.Equal(t, tt.want, score)
 	}
 }
+
+func TestWeightedSchedule(t *testing.T) {
+	actives := []poa.Proposer{{p1, true}, {p2, true}}
+	weights := map[thor.Address]uint64{p1: 9}
+
+	sched, err := poa.NewWeightedScheduler(p1, actives, weights, 1, parentTime, thor.BlockInterval)
+	assert.Nil(t, err)
+
+	// p2 has the default weight 1
+	var count int
+	for i := uint64(1); i <= 1000; i++ {
+		if sched.IsTheTime(parentTime + i*thor.BlockInterval) {
+			count++
+		}
+	}
+	assert.True(t, count > 850 && count < 950, "count %v", count)
+
+	// equal weights behave as round-robin
+	s1, _ := poa.NewWeightedScheduler(p2, actives, map[thor.Address]uint64{}, 1, parentTime, thor.BlockInterval)
+	s2, _ := poa.NewScheduler(p2, actives, 1, parentTime)
+	for i := uint64(1); i <= 100; i++ {
+		nbt := parentTime + i*thor.BlockInterval
+		assert.Equal(t, s2.IsTheTime(nbt), s1.IsTheTime(nbt))
+	}
+}
+
+func TestMissed(t *testing.T) {
+	// p2 is the only active proposer, so skipped slots are its own
+	sched, _ := poa.NewScheduler(p2, proposers, 1, parentTime)
+	assert.Empty(t, sched.Missed(parentTime+thor.BlockInterval))
+	assert.Empty(t, sched.Missed(parentTime+thor.BlockInterval*3))
+
+	sched, _ = poa.NewScheduler(p1, []poa.Proposer{{p1, true}, {p2, true}}, 1, parentTime)
+	nbt := sched.Schedule(parentTime + thor.BlockInterval*10)
+	missed := sched.Missed(nbt)
+	assert.Equal(t, int((nbt-parentTime)/thor.BlockInterval)-1-countTurns(sched, nbt), len(missed))
+	for _, p := range missed {
+		assert.Equal(t, p2, p.Address)
+	}
+}
+
+// countTurns counts slots of the scheduled proposer before newBlockTime.
+func countTurns(sched *poa.Scheduler, newBlockTime uint64) (n int) {
+	for t := parentTime + thor.BlockInterval; t < newBlockTime; t += thor.BlockInterval {
+		if sched.IsTheTime(t) {
+			n++
+		}
+	}
+	return
+}
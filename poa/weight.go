@@ -0,0 +1,57 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package poa
+
+import (
+	"math/big"
+
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// Weights returns scheduling weights of proposers, by endorsement above the minimum.
+// The base weight is 1, and every weight unit of extra endorsement adds 1, up to thor.MaxProposerWeight.
+// The unit is read from the Params builtin. If it's not set, nil is returned, which means equal weights.
+func Weights(st *state.State, proposers []Proposer) (map[thor.Address]uint64, error) {
+	params := builtin.Params.Native(st)
+	unit, err := params.Get(thor.KeyWeightUnit)
+	if err != nil {
+		return nil, err
+	}
+	if unit.Sign() <= 0 {
+		return nil, nil
+	}
+	endorsement, err := params.Get(thor.KeyProposerEndorsement)
+	if err != nil {
+		return nil, err
+	}
+
+	authority := builtin.Authority.Native(st)
+	weights := make(map[thor.Address]uint64, len(proposers))
+	for _, p := range proposers {
+		_, endorsor, _, _, err := authority.Get(p.Address)
+		if err != nil {
+			return nil, err
+		}
+		bal, err := st.GetBalance(endorsor)
+		if err != nil {
+			return nil, err
+		}
+
+		weight := uint64(1)
+		if extra := new(big.Int).Sub(bal, endorsement); extra.Sign() > 0 {
+			extra.Div(extra, unit)
+			if extra.IsUint64() && extra.Uint64() < thor.MaxProposerWeight-1 {
+				weight += extra.Uint64()
+			} else {
+				weight = thor.MaxProposerWeight
+			}
+		}
+		weights[p.Address] = weight
+	}
+	return weights, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package poa_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+func TestWeights(t *testing.T) {
+	st := state.New(muxdb.NewMem(), thor.Bytes32{})
+	st.SetCode(builtin.Params.Address, builtin.Params.RuntimeBytecodes())
+	st.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+	params := builtin.Params.Native(st)
+	params.Set(thor.KeyProposerEndorsement, big.NewInt(100))
+
+	balances := []int64{100, 150, 350, 100000}
+	var list []poa.Proposer
+	for i, bal := range balances {
+		master := thor.BytesToAddress([]byte{'m', byte(i)})
+		endorsor := thor.BytesToAddress([]byte{'e', byte(i)})
+		builtin.Authority.Native(st).Add(master, endorsor, thor.Bytes32{})
+		st.SetBalance(endorsor, big.NewInt(bal))
+		list = append(list, poa.Proposer{Address: master, Active: true})
+	}
+
+	// unit not set
+	weights, err := poa.Weights(st, list)
+	assert.Nil(t, err)
+	assert.Nil(t, weights)
+
+	params.Set(thor.KeyWeightUnit, big.NewInt(100))
+	weights, err = poa.Weights(st, list)
+	assert.Nil(t, err)
+	assert.Equal(t, map[thor.Address]uint64{
+		list[0].Address: 1,
+		list[1].Address: 1,
+		list[2].Address: 3,
+		list[3].Address: thor.MaxProposerWeight,
+	}, weights)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/xenv"
+)
+
+// MaxRewardShare is the total of shares in a reward split.
+const MaxRewardShare = 10000
+
+// Reward is an amount of energy rewarded to the recipient.
+type Reward struct {
+	Recipient thor.Address
+	Amount    *big.Int
+}
+
+// RewardPolicy computes rewards for a transaction executed in the block.
+type RewardPolicy interface {
+	// Rewards returns rewards for the tx, which used gasUsed at the overall gas price.
+	Rewards(st *state.State, ctx *xenv.BlockContext, gasUsed uint64, overallGasPrice *big.Int) ([]Reward, error)
+}
+
+// DefaultRewardPolicy rewards the block beneficiary with the reward ratio of the paid gas,
+// where the ratio is set in the Params builtin.
+var DefaultRewardPolicy RewardPolicy = defaultRewardPolicy{}
+
+// NoRewardPolicy never rewards.
+var NoRewardPolicy RewardPolicy = noRewardPolicy{}
+
+type defaultRewardPolicy struct{}
+
+func (defaultRewardPolicy) Rewards(st *state.State, ctx *xenv.BlockContext, gasUsed uint64, overallGasPrice *big.Int) ([]Reward, error) {
+	rewardRatio, err := builtin.Params.Native(st).Get(thor.KeyRewardRatio)
+	if err != nil {
+		return nil, err
+	}
+	amount := new(big.Int).SetUint64(gasUsed)
+	amount.Mul(amount, overallGasPrice)
+	amount.Mul(amount, rewardRatio)
+	amount.Div(amount, big.NewInt(1e18))
+	return []Reward{{ctx.Beneficiary, amount}}, nil
+}
+
+type noRewardPolicy struct{}
+
+func (noRewardPolicy) Rewards(*state.State, *xenv.BlockContext, uint64, *big.Int) ([]Reward, error) {
+	return nil, nil
+}
+
+// RewardSplit is the share of rewards for the recipient, out of MaxRewardShare.
+type RewardSplit struct {
+	Recipient thor.Address
+	Share     uint32
+}
+
+type splitRewardPolicy struct {
+	splits []RewardSplit
+}
+
+// NewSplitRewardPolicy creates a policy which computes rewards as DefaultRewardPolicy, but splits them
+// among recipients by shares, with the rest to the block beneficiary.
+func NewSplitRewardPolicy(splits []RewardSplit) (RewardPolicy, error) {
+	var total uint64
+	for _, s := range splits {
+		total += uint64(s.Share)
+	}
+	if total > MaxRewardShare {
+		return nil, errors.New("total reward share exceeds the max")
+	}
+	return &splitRewardPolicy{append([]RewardSplit(nil), splits...)}, nil
+}
+
+func (p *splitRewardPolicy) Rewards(st *state.State, ctx *xenv.BlockContext, gasUsed uint64, overallGasPrice *big.Int) ([]Reward, error) {
+	rewards, err := DefaultRewardPolicy.Rewards(st, ctx, gasUsed, overallGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	total := rewards[0].Amount
+
+	result := make([]Reward, 0, len(p.splits)+1)
+	rest := new(big.Int).Set(total)
+	for _, s := range p.splits {
+		amount := new(big.Int).Mul(total, big.NewInt(int64(s.Share)))
+		amount.Div(amount, big.NewInt(MaxRewardShare))
+		rest.Sub(rest, amount)
+		result = append(result, Reward{s.Recipient, amount})
+	}
+	return append(result, Reward{ctx.Beneficiary, rest}), nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/xenv"
+)
+
+func TestRewardPolicy(t *testing.T) {
+	st := state.New(muxdb.NewMem(), thor.Bytes32{})
+	st.SetCode(builtin.Params.Address, builtin.Params.RuntimeBytecodes())
+	// 30% of the paid gas
+	builtin.Params.Native(st).Set(thor.KeyRewardRatio, big.NewInt(3e17))
+
+	var (
+		beneficiary = thor.BytesToAddress([]byte("beneficiary"))
+		a1          = thor.BytesToAddress([]byte("a1"))
+		ctx         = &xenv.BlockContext{Beneficiary: beneficiary}
+		price       = big.NewInt(1000)
+	)
+
+	assert.Equal(t,
+		M([]runtime.Reward{{beneficiary, big.NewInt(3000)}}, nil),
+		M(runtime.DefaultRewardPolicy.Rewards(st, ctx, 10, price)))
+
+	assert.Equal(t,
+		M([]runtime.Reward(nil), nil),
+		M(runtime.NoRewardPolicy.Rewards(st, ctx, 10, price)))
+
+	_, err := runtime.NewSplitRewardPolicy([]runtime.RewardSplit{{a1, runtime.MaxRewardShare + 1}})
+	assert.NotNil(t, err)
+
+	policy, err := runtime.NewSplitRewardPolicy([]runtime.RewardSplit{{a1, 2500}})
+	assert.Nil(t, err)
+	assert.Equal(t,
+		M([]runtime.Reward{{a1, big.NewInt(750)}, {beneficiary, big.NewInt(2250)}}, nil),
+		M(policy.Rewards(st, ctx, 10, price)))
+}
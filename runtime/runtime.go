@@ -75,12 +75,13 @@ type TransactionExecutor struct {
 
 // Runtime bases on EVM and VeChain Thor builtins.
 type Runtime struct {
-	vmConfig    vm.Config
-	chain       *chain.Chain
-	state       *state.State
-	ctx         *xenv.BlockContext
-	forkConfig  thor.ForkConfig
-	chainConfig params.ChainConfig
+	vmConfig     vm.Config
+	chain        *chain.Chain
+	state        *state.State
+	ctx          *xenv.BlockContext
+	forkConfig   thor.ForkConfig
+	chainConfig  params.ChainConfig
+	rewardPolicy RewardPolicy
 }
 
 // New create a Runtime object.
@@ -93,11 +94,12 @@ func New(
 	currentChainConfig := baseChainConfig
 	currentChainConfig.ConstantinopleBlock = big.NewInt(int64(forkConfig.ETH_CONST))
 	rt := Runtime{
-		chain:       chain,
-		state:       state,
-		ctx:         ctx,
-		forkConfig:  forkConfig,
-		chainConfig: currentChainConfig,
+		chain:        chain,
+		state:        state,
+		ctx:          ctx,
+		forkConfig:   forkConfig,
+		chainConfig:  currentChainConfig,
+		rewardPolicy: DefaultRewardPolicy,
 	}
 	return &rt
 }
@@ -113,6 +115,13 @@ func (rt *Runtime) SetVMConfig(config vm.Config) *Runtime {
 	return rt
 }
 
+// SetRewardPolicy sets the policy to reward block beneficiaries, DefaultRewardPolicy if not set.
+// Returns this runtime.
+func (rt *Runtime) SetRewardPolicy(policy RewardPolicy) *Runtime {
+	rt.rewardPolicy = policy
+	return rt
+}
+
 func (rt *Runtime) newEVM(stateDB *statedb.StateDB, clauseIndex uint32, txCtx *xenv.TransactionContext) *vm.EVM {
 	var lastNonNativeCallGas uint64
 	return vm.NewEVM(vm.Context{
@@ -367,6 +376,9 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 	txOutputs := make([]*Tx.Output, 0, len(resolvedTx.Clauses))
 	reverted := false
 	finalized := false
+	// clauses of non-atomic tx are reverted individually
+	nonAtomic := tx.Features().IsNonAtomic()
+	var revertedClauses []uint32
 
 	hasNext := func() bool {
 		return !reverted && len(txOutputs) < len(resolvedTx.Clauses)
@@ -376,6 +388,10 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 		HasNextClause: hasNext,
 		NextClause: func() (gasUsed uint64, output *Output, err error) {
 			nextClauseIndex := uint32(len(txOutputs))
+			var clauseCheckpoint int
+			if nonAtomic {
+				clauseCheckpoint = rt.state.NewCheckpoint()
+			}
 			exec, _ := rt.PrepareClause(resolvedTx.Clauses[nextClauseIndex], nextClauseIndex, leftOverGas, txCtx)
 			output, _, err = exec()
 			if err != nil {
@@ -393,6 +409,14 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 			// won't overflow
 			leftOverGas += refund
 
+			if output.VMErr != nil && nonAtomic {
+				// revert only this clause, and leave its output empty
+				rt.state.RevertTo(clauseCheckpoint)
+				revertedClauses = append(revertedClauses, nextClauseIndex)
+				txOutputs = append(txOutputs, &Tx.Output{})
+				return
+			}
+
 			if output.VMErr != nil {
 				// vm exception here
 				// revert all executed clauses
@@ -419,6 +443,15 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 				GasUsed:  tx.Gas() - leftOverGas,
 				GasPayer: payer,
 			}
+			if len(revertedClauses) > 0 {
+				if len(revertedClauses) == len(txOutputs) {
+					// all clauses reverted, same as atomic tx
+					receipt.Reverted = true
+					receipt.Outputs = nil
+				} else {
+					receipt.RevertedClauses = revertedClauses
+				}
+			}
 
 			receipt.Paid = new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), gasPrice)
 
@@ -427,23 +460,23 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 			}
 
 			// reward
-			rewardRatio, err := builtin.Params.Native(rt.state).Get(thor.KeyRewardRatio)
-			if err != nil {
-				return nil, err
-			}
 			provedWork, err := tx.ProvedWork(rt.ctx.Number-1, rt.chain.GetBlockID)
 			if err != nil {
 				return nil, err
 			}
 			overallGasPrice := tx.OverallGasPrice(baseGasPrice, provedWork)
 
-			reward := new(big.Int).SetUint64(receipt.GasUsed)
-			reward.Mul(reward, overallGasPrice)
-			reward.Mul(reward, rewardRatio)
-			reward.Div(reward, big.NewInt(1e18))
-			if err := builtin.Energy.Native(rt.state, rt.ctx.Time).Add(rt.ctx.Beneficiary, reward); err != nil {
+			rewards, err := rt.rewardPolicy.Rewards(rt.state, rt.ctx, receipt.GasUsed, overallGasPrice)
+			if err != nil {
 				return nil, err
 			}
+			reward := new(big.Int)
+			for _, r := range rewards {
+				if err := builtin.Energy.Native(rt.state, rt.ctx.Time).Add(r.Recipient, r.Amount); err != nil {
+					return nil, err
+				}
+				reward.Add(reward, r.Amount)
+			}
 
 			receipt.Reward = reward
 			return receipt, nil
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/builtin"
@@ -191,3 +192,38 @@ func TestExecuteTransaction(t *testing.T) {
 	// _ = receipt
 	// assert.Equal(t, state.GetBalance(addr1), new(big.Int).Sub(balance1, big.NewInt(10)))
 }
+
+func TestExecuteNonAtomicTransaction(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, err := genesis.NewDevnet().Build(stater)
+	assert.Nil(t, err)
+	repo, _ := chain.NewRepository(db, b0)
+
+	acc := genesis.DevAccounts()[1]
+	to := thor.BytesToAddress([]byte("to"))
+	method, _ := builtin.Params.ABI.MethodByName("set")
+	data, _ := method.EncodeInput(thor.Bytes32{}, big.NewInt(1))
+
+	var nonAtomic tx.Features
+	nonAtomic.SetNonAtomic(true)
+
+	trx := new(tx.Builder).ChainTag(repo.ChainTag()).Gas(200000).Expiration(10).Features(nonAtomic).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(10))).
+		Clause(tx.NewClause(&builtin.Params.Address).WithData(data)).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(20))).
+		Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+
+	st := stater.NewState(b0.Header().StateRoot())
+	rt := runtime.New(repo.NewChain(b0.Header().ID()), st, &xenv.BlockContext{Number: 1, Time: b0.Header().Timestamp()}, thor.NoFork)
+	receipt, err := rt.ExecuteTransaction(trx.WithSignature(sig))
+	assert.Nil(t, err)
+
+	assert.False(t, receipt.Reverted)
+	assert.Equal(t, 3, len(receipt.Outputs))
+	assert.Equal(t, &tx.Output{}, receipt.Outputs[1])
+	assert.Equal(t, []uint32{1}, receipt.RevertedClauses)
+	assert.Equal(t, big.NewInt(20), receipt.Outputs[2].Transfers[0].Amount)
+	assert.Equal(t, M(big.NewInt(30), nil), M(st.GetBalance(to)))
+}
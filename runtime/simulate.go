@@ -0,0 +1,103 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"bytes"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/xenv"
+)
+
+// selector of Error(string), which is used by solidity to encode revert reason.
+var revertReasonSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+// SimulatedClause is the result of a clause executed in simulation.
+type SimulatedClause struct {
+	Data         []byte
+	Events       tx.Events
+	Transfers    tx.Transfers
+	GasUsed      uint64
+	VMErr        error
+	RevertReason string // decoded from Data if reverted with reason
+}
+
+// SimulationResult is the result of a simulated tx.
+// Clauses are executed until the first failure, so Clauses may shorter than clause count.
+type SimulationResult struct {
+	Clauses  []*SimulatedClause
+	GasUsed  uint64
+	Reverted bool
+}
+
+// Simulate executes a signed tx as if it's packed in the block next to head, without committing any state.
+func Simulate(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfig, trx *tx.Transaction, head *block.Header) (*SimulationResult, error) {
+	st := stater.NewState(head.StateRoot())
+	interval, err := poa.BlockIntervalOf(st, forkConfig, head.Number()+1)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := New(
+		repo.NewChain(head.ID()),
+		st,
+		&xenv.BlockContext{
+			Beneficiary: head.Beneficiary(),
+			Number:      head.Number() + 1,
+			Time:        head.Timestamp() + interval,
+			GasLimit:    head.GasLimit(),
+			TotalScore:  head.TotalScore(),
+		},
+		forkConfig)
+
+	executor, err := rt.PrepareTransaction(trx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SimulationResult
+	for executor.HasNextClause() {
+		gasUsed, output, err := executor.NextClause()
+		if err != nil {
+			return nil, err
+		}
+		result.Clauses = append(result.Clauses, &SimulatedClause{
+			Data:         output.Data,
+			Events:       output.Events,
+			Transfers:    output.Transfers,
+			GasUsed:      gasUsed,
+			VMErr:        output.VMErr,
+			RevertReason: decodeRevertReason(output),
+		})
+	}
+
+	receipt, err := executor.Finalize()
+	if err != nil {
+		return nil, err
+	}
+	result.GasUsed = receipt.GasUsed
+	result.Reverted = receipt.Reverted
+	return &result, nil
+}
+
+func decodeRevertReason(output *Output) string {
+	if output.VMErr == nil || !bytes.HasPrefix(output.Data, revertReasonSelector) {
+		return ""
+	}
+	stringTy, _ := ethabi.NewType("string")
+	values, err := ethabi.Arguments{{Type: stringTy}}.UnpackValues(output.Data[len(revertReasonSelector):])
+	if err != nil {
+		return ""
+	}
+	reason, _ := values[0].(string)
+	return reason
+}
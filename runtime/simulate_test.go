@@ -0,0 +1,67 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestSimulate(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b0, _, _, err := genesis.NewDevnet().Build(stater)
+	assert.Nil(t, err)
+	repo, _ := chain.NewRepository(db, b0)
+
+	acc := genesis.DevAccounts()[1]
+	to := thor.BytesToAddress([]byte("to"))
+	setData, _ := builtin.Params.ABI.MethodByName("set")
+	data, _ := setData.EncodeInput(thor.Bytes32{}, big.NewInt(1))
+
+	newTx := func(clauses ...*tx.Clause) *tx.Transaction {
+		builder := new(tx.Builder).ChainTag(repo.ChainTag()).Gas(100000).Expiration(10)
+		for _, c := range clauses {
+			builder.Clause(c)
+		}
+		trx := builder.Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+
+	result, err := runtime.Simulate(repo, stater, thor.NoFork,
+		newTx(tx.NewClause(&to).WithValue(big.NewInt(10)), tx.NewClause(&to).WithValue(big.NewInt(20))),
+		b0.Header())
+	assert.Nil(t, err)
+	assert.False(t, result.Reverted)
+	assert.Equal(t, 2, len(result.Clauses))
+	assert.Equal(t, big.NewInt(20), result.Clauses[1].Transfers[0].Amount)
+	assert.True(t, result.GasUsed > 0)
+
+	result, err = runtime.Simulate(repo, stater, thor.NoFork,
+		newTx(tx.NewClause(&to).WithValue(big.NewInt(10)), tx.NewClause(&builtin.Params.Address).WithData(data)),
+		b0.Header())
+	assert.Nil(t, err)
+	assert.True(t, result.Reverted)
+	assert.Equal(t, 2, len(result.Clauses))
+	assert.NotNil(t, result.Clauses[1].VMErr)
+	assert.Equal(t, "builtin: executor required", result.Clauses[1].RevertReason)
+
+	// state not committed
+	st := stater.NewState(b0.Header().StateRoot())
+	assert.Equal(t, M(&big.Int{}, nil), M(st.GetBalance(to)))
+}
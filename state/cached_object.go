@@ -20,6 +20,9 @@ type cachedObject struct {
 	addr thor.Address
 	data Account
 
+	snap      *Snapshot    // optional flat snapshot
+	stateRoot thor.Bytes32 // root of the state which the account belongs to
+
 	cache struct {
 		code        []byte
 		storageTrie *muxdb.Trie
@@ -57,6 +60,18 @@ func (co *cachedObject) GetStorage(key thor.Bytes32) (rlp.RawValue, error) {
 	}
 	// not found in cache
 
+	// load from snapshot
+	if co.snap != nil {
+		v, ok, err := co.snap.getStorage(co.stateRoot, co.addr, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			cache.storage[key] = v
+			return v, nil
+		}
+	}
+
 	trie := co.getOrCreateStorageTrie()
 
 	// load from trie
@@ -0,0 +1,169 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// AccountDiff describes changes of an account between two states.
+type AccountDiff struct {
+	Address      thor.Address
+	Before       *Account // empty account if absent
+	After        *Account // empty account if absent
+	BalanceDelta *big.Int
+	EnergyDelta  *big.Int // energies are both calculated at the later block time of the two accounts
+	CodeChanged  bool
+	Storage      []*StorageDiff // sorted by key
+}
+
+// StorageDiff describes change of a storage slot.
+type StorageDiff struct {
+	Key    thor.Bytes32
+	Before rlp.RawValue // nil if absent
+	After  rlp.RawValue // nil if absent
+}
+
+// Diff computes changes from state at rootA to state at rootB.
+// Only different parts of tries are traversed. The result is sorted by address.
+func Diff(db *muxdb.MuxDB, rootA, rootB thor.Bytes32) ([]*AccountDiff, error) {
+	trieA := db.NewSecureTrie(AccountTrieName, rootA)
+	trieB := db.NewSecureTrie(AccountTrieName, rootB)
+
+	keys, err := changedKeys(trieA, trieB)
+	if err != nil {
+		return nil, &Error{err}
+	}
+
+	var diffs []*AccountDiff
+	for _, key := range keys {
+		addr := thor.BytesToAddress(key)
+		before, err := loadAccount(trieA, addr)
+		if err != nil {
+			return nil, &Error{err}
+		}
+		after, err := loadAccount(trieB, addr)
+		if err != nil {
+			return nil, &Error{err}
+		}
+
+		encA, err := rlp.EncodeToBytes(before)
+		if err != nil {
+			return nil, &Error{err}
+		}
+		encB, err := rlp.EncodeToBytes(after)
+		if err != nil {
+			return nil, &Error{err}
+		}
+		if bytes.Equal(encA, encB) {
+			continue
+		}
+
+		blockTime := before.BlockTime
+		if after.BlockTime > blockTime {
+			blockTime = after.BlockTime
+		}
+		diff := &AccountDiff{
+			Address:      addr,
+			Before:       before,
+			After:        after,
+			BalanceDelta: new(big.Int).Sub(after.Balance, before.Balance),
+			EnergyDelta:  new(big.Int).Sub(after.CalcEnergy(blockTime), before.CalcEnergy(blockTime)),
+			CodeChanged:  !bytes.Equal(before.CodeHash, after.CodeHash),
+		}
+
+		if !isSameStorageRoot(before.StorageRoot, after.StorageRoot) {
+			name := StorageTrieName(thor.Blake2b(addr[:]))
+			if diff.Storage, err = diffStorage(
+				db.NewSecureTrie(name, thor.BytesToBytes32(before.StorageRoot)),
+				db.NewSecureTrie(name, thor.BytesToBytes32(after.StorageRoot)),
+			); err != nil {
+				return nil, &Error{err}
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].Address[:], diffs[j].Address[:]) < 0
+	})
+	return diffs, nil
+}
+
+func diffStorage(trieA, trieB *muxdb.Trie) ([]*StorageDiff, error) {
+	keys, err := changedKeys(trieA, trieB)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []*StorageDiff
+	for _, key := range keys {
+		k := thor.BytesToBytes32(key)
+		before, err := loadStorage(trieA, k)
+		if err != nil {
+			return nil, err
+		}
+		after, err := loadStorage(trieB, k)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(before, after) {
+			continue
+		}
+		diffs = append(diffs, &StorageDiff{k, before, after})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].Key[:], diffs[j].Key[:]) < 0
+	})
+	return diffs, nil
+}
+
+// changedKeys returns the keys of leaves which are in only one of the two secure tries.
+// It's a superset of keys with changed values, since a leaf node may be re-encoded without
+// value change.
+func changedKeys(trieA, trieB *muxdb.Trie) ([][]byte, error) {
+	var (
+		seen = make(map[thor.Bytes32]bool)
+		keys [][]byte
+	)
+	collect := func(from, to *muxdb.Trie) error {
+		it, _ := trie.NewDifferenceIterator(from.NodeIterator(nil), to.NodeIterator(nil))
+		for it.Next(true) {
+			if !it.Leaf() {
+				continue
+			}
+			hash := thor.BytesToBytes32(it.LeafKey())
+			if seen[hash] {
+				continue
+			}
+			seen[hash] = true
+
+			key := to.GetKeyPreimage(hash)
+			if len(key) == 0 {
+				return errors.New("missing key preimage")
+			}
+			keys = append(keys, key)
+		}
+		return it.Error()
+	}
+
+	if err := collect(trieA, trieB); err != nil {
+		return nil, err
+	}
+	if err := collect(trieB, trieA); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
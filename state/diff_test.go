@@ -0,0 +1,98 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestDiff(t *testing.T) {
+	db := muxdb.NewMem()
+
+	var (
+		addr1     = thor.BytesToAddress([]byte("addr1"))
+		addr2     = thor.BytesToAddress([]byte("addr2"))
+		addr3     = thor.BytesToAddress([]byte("addr3"))
+		unchanged = thor.BytesToAddress([]byte("unchanged"))
+		key1      = thor.BytesToBytes32([]byte("key1"))
+		key2      = thor.BytesToBytes32([]byte("key2"))
+		v1        = thor.BytesToBytes32([]byte("v1"))
+		v2        = thor.BytesToBytes32([]byte("v2"))
+	)
+
+	commit := func(st *State) thor.Bytes32 {
+		stage, err := st.Stage()
+		assert.Nil(t, err)
+		root, err := stage.Commit()
+		assert.Nil(t, err)
+		return root
+	}
+	rawStorage := func(root thor.Bytes32, key thor.Bytes32) rlp.RawValue {
+		v, err := New(db, root).GetRawStorage(addr1, key)
+		assert.Nil(t, err)
+		return v
+	}
+
+	st := New(db, thor.Bytes32{})
+	st.SetBalance(addr1, big.NewInt(100))
+	st.SetStorage(addr1, key1, v1)
+	st.SetStorage(addr1, key2, v2)
+	st.SetBalance(addr2, big.NewInt(200))
+	st.SetBalance(unchanged, big.NewInt(1))
+	rootA := commit(st)
+
+	st = New(db, rootA)
+	st.SetBalance(addr1, big.NewInt(150))
+	st.SetStorage(addr1, key1, v2)
+	st.SetStorage(addr1, key2, thor.Bytes32{})
+	st.Delete(addr2)
+	st.SetCode(addr3, []byte{1, 2, 3})
+	rootB := commit(st)
+
+	diffs, err := Diff(db, rootA, rootB)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(diffs))
+
+	got := make(map[thor.Address]*AccountDiff)
+	for _, d := range diffs {
+		got[d.Address] = d
+	}
+
+	d1 := got[addr1]
+	assert.Equal(t, big.NewInt(50), d1.BalanceDelta)
+	assert.False(t, d1.CodeChanged)
+	assert.Equal(t, []*StorageDiff{
+		{key1, rawStorage(rootA, key1), rawStorage(rootB, key1)},
+		{key2, rawStorage(rootA, key2), nil},
+	}, d1.Storage)
+
+	d2 := got[addr2]
+	assert.Equal(t, big.NewInt(-200), d2.BalanceDelta)
+	assert.True(t, d2.After.IsEmpty())
+
+	d3 := got[addr3]
+	assert.True(t, d3.CodeChanged)
+	assert.Equal(t, big.NewInt(0), d3.BalanceDelta)
+
+	_, ok := got[unchanged]
+	assert.False(t, ok)
+
+	// reversed
+	diffs, err = Diff(db, rootB, rootA)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(diffs))
+
+	// same root
+	diffs, err = Diff(db, rootA, rootA)
+	assert.Nil(t, err)
+	assert.Empty(t, diffs)
+}
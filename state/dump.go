@@ -0,0 +1,216 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// AccountIterator iterates over all accounts at a state root, in order of hashed address.
+type AccountIterator struct {
+	db   *muxdb.MuxDB
+	trie *muxdb.Trie
+	it   trie.NodeIterator
+	addr thor.Address
+	acc  *Account
+	err  error
+}
+
+// NewAccountIterator creates an iterator over accounts at the given state root.
+func NewAccountIterator(db *muxdb.MuxDB, root thor.Bytes32) *AccountIterator {
+	t := db.NewSecureTrie(AccountTrieName, root)
+	return &AccountIterator{db: db, trie: t, it: t.NodeIterator(nil)}
+}
+
+// Next moves to the next account. It returns false if no more accounts or error occurred.
+func (i *AccountIterator) Next() bool {
+	if i.err != nil {
+		return false
+	}
+	for i.it.Next(true) {
+		if !i.it.Leaf() {
+			continue
+		}
+		key := i.trie.GetKeyPreimage(thor.BytesToBytes32(i.it.LeafKey()))
+		if len(key) != thor.AddressLength {
+			i.err = &Error{errors.New("missing account key preimage")}
+			return false
+		}
+		var acc Account
+		if err := rlp.DecodeBytes(i.it.LeafBlob(), &acc); err != nil {
+			i.err = &Error{err}
+			return false
+		}
+		i.addr = thor.BytesToAddress(key)
+		i.acc = &acc
+		return true
+	}
+	if err := i.it.Error(); err != nil {
+		i.err = &Error{err}
+	}
+	return false
+}
+
+// Address returns address of the current account.
+func (i *AccountIterator) Address() thor.Address {
+	return i.addr
+}
+
+// Account returns the current account.
+func (i *AccountIterator) Account() *Account {
+	return i.acc
+}
+
+// Code returns code of the current account.
+func (i *AccountIterator) Code() ([]byte, error) {
+	if len(i.acc.CodeHash) == 0 {
+		return nil, nil
+	}
+	code, err := i.db.NewStore(codeStoreName).Get(i.acc.CodeHash)
+	if err != nil {
+		return nil, &Error{err}
+	}
+	return code, nil
+}
+
+// Storage returns an iterator over storage of the current account.
+func (i *AccountIterator) Storage() *StorageIterator {
+	t := i.db.NewSecureTrie(StorageTrieName(thor.Blake2b(i.addr[:])), thor.BytesToBytes32(i.acc.StorageRoot))
+	return &StorageIterator{trie: t, it: t.NodeIterator(nil)}
+}
+
+// Error returns error occurred during iteration.
+func (i *AccountIterator) Error() error {
+	return i.err
+}
+
+// StorageIterator iterates over storage slots of an account, in order of hashed key.
+type StorageIterator struct {
+	trie  *muxdb.Trie
+	it    trie.NodeIterator
+	key   thor.Bytes32
+	value rlp.RawValue
+	err   error
+}
+
+// Next moves to the next slot. It returns false if no more slots or error occurred.
+func (i *StorageIterator) Next() bool {
+	if i.err != nil {
+		return false
+	}
+	for i.it.Next(true) {
+		if !i.it.Leaf() {
+			continue
+		}
+		key := i.trie.GetKeyPreimage(thor.BytesToBytes32(i.it.LeafKey()))
+		if len(key) != len(i.key) {
+			i.err = &Error{errors.New("missing storage key preimage")}
+			return false
+		}
+		i.key = thor.BytesToBytes32(key)
+		i.value = i.it.LeafBlob()
+		return true
+	}
+	if err := i.it.Error(); err != nil {
+		i.err = &Error{err}
+	}
+	return false
+}
+
+// Key returns key of the current slot.
+func (i *StorageIterator) Key() thor.Bytes32 {
+	return i.key
+}
+
+// Value returns the raw value of the current slot.
+func (i *StorageIterator) Value() rlp.RawValue {
+	return i.value
+}
+
+// Error returns error occurred during iteration.
+func (i *StorageIterator) Error() error {
+	return i.err
+}
+
+// DumpAccount is the JSON form of an account in state dump.
+type DumpAccount struct {
+	Address   thor.Address             `json:"address"`
+	Balance   *math.HexOrDecimal256    `json:"balance"`
+	Energy    *math.HexOrDecimal256    `json:"energy"`
+	BlockTime uint64                   `json:"blockTime"`
+	Master    *thor.Address            `json:"master,omitempty"`
+	Code      hexutil.Bytes            `json:"code,omitempty"`
+	Storage   map[string]hexutil.Bytes `json:"storage,omitempty"` // key to rlp encoded value
+}
+
+// Dump writes all accounts at the state root to w, as a JSON object {"root":..., "accounts":[...]}.
+// Accounts are written one by one, so the whole state is never held in memory.
+func Dump(db *muxdb.MuxDB, root thor.Bytes32, withStorage bool, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	if _, err := bw.WriteString(`{"root":"` + root.String() + `","accounts":[`); err != nil {
+		return err
+	}
+
+	it := NewAccountIterator(db, root)
+	for n := 0; it.Next(); n++ {
+		if n > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		acc := it.Account()
+		da := DumpAccount{
+			Address:   it.Address(),
+			Balance:   (*math.HexOrDecimal256)(acc.Balance),
+			Energy:    (*math.HexOrDecimal256)(acc.Energy),
+			BlockTime: acc.BlockTime,
+		}
+		if len(acc.Master) > 0 {
+			master := thor.BytesToAddress(acc.Master)
+			da.Master = &master
+		}
+		code, err := it.Code()
+		if err != nil {
+			return err
+		}
+		da.Code = code
+
+		if withStorage {
+			sit := it.Storage()
+			for sit.Next() {
+				if da.Storage == nil {
+					da.Storage = make(map[string]hexutil.Bytes)
+				}
+				da.Storage[sit.Key().String()] = hexutil.Bytes(sit.Value())
+			}
+			if err := sit.Error(); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(&da); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString("]}\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestAccountIterator(t *testing.T) {
+	db := muxdb.NewMem()
+	st := New(db, thor.Bytes32{})
+
+	var (
+		addr1 = thor.BytesToAddress([]byte("addr1"))
+		addr2 = thor.BytesToAddress([]byte("addr2"))
+		key1  = thor.BytesToBytes32([]byte("key1"))
+		key2  = thor.BytesToBytes32([]byte("key2"))
+	)
+	st.SetBalance(addr1, big.NewInt(1))
+	st.SetStorage(addr1, key1, thor.BytesToBytes32([]byte("v1")))
+	st.SetStorage(addr1, key2, thor.BytesToBytes32([]byte("v2")))
+	st.SetBalance(addr2, big.NewInt(2))
+	st.SetCode(addr2, []byte{1, 2, 3})
+
+	stage, err := st.Stage()
+	assert.Nil(t, err)
+	root, err := stage.Commit()
+	assert.Nil(t, err)
+
+	balances := make(map[thor.Address]*big.Int)
+	storage := make(map[thor.Bytes32]bool)
+	it := NewAccountIterator(db, root)
+	for it.Next() {
+		balances[it.Address()] = it.Account().Balance
+		sit := it.Storage()
+		for sit.Next() {
+			storage[sit.Key()] = true
+		}
+		assert.Nil(t, sit.Error())
+		if it.Address() == addr2 {
+			code, err := it.Code()
+			assert.Nil(t, err)
+			assert.Equal(t, []byte{1, 2, 3}, code)
+		}
+	}
+	assert.Nil(t, it.Error())
+	assert.Equal(t, map[thor.Address]*big.Int{addr1: big.NewInt(1), addr2: big.NewInt(2)}, balances)
+	assert.Equal(t, map[thor.Bytes32]bool{key1: true, key2: true}, storage)
+
+	var buf bytes.Buffer
+	assert.Nil(t, Dump(db, root, true, &buf))
+
+	var dump struct {
+		Root     thor.Bytes32
+		Accounts []*DumpAccount
+	}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &dump))
+	assert.Equal(t, root, dump.Root)
+	assert.Equal(t, 2, len(dump.Accounts))
+	for _, acc := range dump.Accounts {
+		switch acc.Address {
+		case addr1:
+			assert.Equal(t, 2, len(acc.Storage))
+			assert.Empty(t, acc.Code)
+		case addr2:
+			assert.Empty(t, acc.Storage)
+			assert.Equal(t, []byte{1, 2, 3}, []byte(acc.Code))
+		default:
+			t.Errorf("unexpected account %v", acc.Address)
+		}
+	}
+
+	// empty state
+	buf.Reset()
+	assert.Nil(t, Dump(db, thor.Bytes32{}, false, &buf))
+	assert.Equal(t, `{"root":"`+thor.Bytes32{}.String()+`","accounts":[]}`+"\n", buf.String())
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"sync"
+
+	"github.com/vechain/thor/stackedmap"
+	"github.com/vechain/thor/thor"
+)
+
+// Overlay is a state on top of a base state. Reads fall through to the base state,
+// while writes are buffered in memory, until discarded or flattened into the base state.
+//
+// It's cheap to create, and shares loaded accounts with the base state, so it suits
+// tx simulation and trial execution. The base state should not be modified while the
+// overlay is in use, and neither of them is safe for concurrent use.
+//
+// Keys read from the base state are recorded, to detect conflicts with writes of others.
+type Overlay struct {
+	*State
+	lock  sync.Locker // guards reads from the base state if not nil
+	reads KeySet
+}
+
+// NewOverlay creates an overlay on the base state.
+func NewOverlay(base *State) *Overlay {
+	return NewConcurrentOverlay(base, nil)
+}
+
+// NewConcurrentOverlay creates an overlay whose reads from the base state are guarded by the lock.
+// Overlays sharing the lock can be used concurrently, as long as the base state is not modified meanwhile.
+func NewConcurrentOverlay(base *State, lock sync.Locker) *Overlay {
+	o := &Overlay{State: &State{
+		db:       base.db,
+		snap:     base.snap,
+		root:     base.root,
+		trie:     base.trie,
+		cache:    base.cache,
+		recorder: base.recorder,
+		base:     base,
+	}, lock: lock}
+	o.reset()
+	return o
+}
+
+func (o *Overlay) reset() {
+	var (
+		base  = o.base
+		lock  = o.lock
+		reads = make(KeySet)
+	)
+	o.reads = reads
+	o.sm = stackedmap.New(func(key interface{}) (interface{}, bool, error) {
+		if lock != nil {
+			lock.Lock()
+			defer lock.Unlock()
+		}
+		reads[key] = struct{}{}
+		return base.sm.Get(key)
+	})
+}
+
+// Reads returns keys read from the base state since the overlay was created or reset.
+func (o *Overlay) Reads() KeySet {
+	return o.reads
+}
+
+// Writes returns keys of buffered writes.
+func (o *Overlay) Writes() KeySet {
+	writes := make(KeySet)
+	o.sm.Journal(func(k, _ interface{}) bool {
+		writes[k] = struct{}{}
+		return true
+	})
+	return writes
+}
+
+// Discard drops all buffered writes. Checkpoints made before are invalidated.
+func (o *Overlay) Discard() {
+	o.reset()
+}
+
+// Flatten applies all buffered writes to the base state, and then clears them.
+// Checkpoints made before are invalidated.
+func (o *Overlay) Flatten() {
+	o.sm.Journal(func(k, v interface{}) bool {
+		o.base.sm.Put(k, v)
+		return true
+	})
+	o.reset()
+}
+
+// KeySet is a set of keys of accounts, codes and storage slots.
+type KeySet map[interface{}]struct{}
+
+// AddAccount adds the key of the account.
+func (ks KeySet) AddAccount(addr thor.Address) {
+	ks[addr] = struct{}{}
+}
+
+// HasStorage returns whether the key of the storage slot is in the set.
+func (ks KeySet) HasStorage(addr thor.Address, key thor.Bytes32) bool {
+	_, ok := ks[storageKey{addr, key}]
+	return ok
+}
+
+// RemoveStorage removes the key of the storage slot.
+func (ks KeySet) RemoveStorage(addr thor.Address, key thor.Bytes32) {
+	delete(ks, storageKey{addr, key})
+}
+
+// Merge adds all keys of other into the set.
+func (ks KeySet) Merge(other KeySet) {
+	for k := range other {
+		ks[k] = struct{}{}
+	}
+}
+
+// Intersects returns whether any key is in both sets.
+func (ks KeySet) Intersects(other KeySet) bool {
+	if len(other) < len(ks) {
+		ks, other = other, ks
+	}
+	for k := range ks {
+		if _, ok := other[k]; ok {
+			return true
+		}
+	}
+	return false
+}
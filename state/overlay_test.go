@@ -0,0 +1,100 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestOverlay(t *testing.T) {
+	db := muxdb.NewMem()
+	base := New(db, thor.Bytes32{})
+
+	var (
+		addr1 = thor.BytesToAddress([]byte("addr1"))
+		addr2 = thor.BytesToAddress([]byte("addr2"))
+		key   = thor.BytesToBytes32([]byte("key"))
+	)
+	base.SetBalance(addr1, big.NewInt(1))
+
+	o := NewOverlay(base)
+	// reads fall through
+	assert.Equal(t, M(big.NewInt(1), nil), M(o.GetBalance(addr1)))
+
+	o.SetBalance(addr1, big.NewInt(10))
+	o.SetStorage(addr2, key, thor.BytesToBytes32([]byte("v")))
+	assert.Equal(t, M(big.NewInt(10), nil), M(o.GetBalance(addr1)))
+	// base untouched
+	assert.Equal(t, M(big.NewInt(1), nil), M(base.GetBalance(addr1)))
+	assert.Equal(t, M(thor.Bytes32{}, nil), M(base.GetStorage(addr2, key)))
+
+	// stage of overlay covers writes of both
+	stage, err := o.Stage()
+	assert.Nil(t, err)
+	expected := New(db, thor.Bytes32{})
+	expected.SetBalance(addr1, big.NewInt(10))
+	expected.SetStorage(addr2, key, thor.BytesToBytes32([]byte("v")))
+	expectedStage, err := expected.Stage()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStage.Hash(), stage.Hash())
+
+	o.Discard()
+	assert.Equal(t, M(big.NewInt(1), nil), M(o.GetBalance(addr1)))
+	assert.Equal(t, M(thor.Bytes32{}, nil), M(o.GetStorage(addr2, key)))
+
+	// nested overlay
+	o.SetBalance(addr1, big.NewInt(10))
+	nested := NewOverlay(o.State)
+	nested.SetStorage(addr2, key, thor.BytesToBytes32([]byte("v")))
+	nested.Flatten()
+	assert.Equal(t, M(thor.BytesToBytes32([]byte("v")), nil), M(o.GetStorage(addr2, key)))
+
+	o.Flatten()
+	assert.Equal(t, M(big.NewInt(10), nil), M(base.GetBalance(addr1)))
+	assert.Equal(t, M(thor.BytesToBytes32([]byte("v")), nil), M(base.GetStorage(addr2, key)))
+	stage, err = base.Stage()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStage.Hash(), stage.Hash())
+}
+
+func TestOverlayReadsWrites(t *testing.T) {
+	db := muxdb.NewMem()
+	base := New(db, thor.Bytes32{})
+
+	var (
+		addr1 = thor.BytesToAddress([]byte("addr1"))
+		addr2 = thor.BytesToAddress([]byte("addr2"))
+		key   = thor.BytesToBytes32([]byte("key"))
+	)
+
+	o := NewConcurrentOverlay(base, &sync.Mutex{})
+	o.GetBalance(addr1)
+	o.SetStorage(addr2, key, thor.BytesToBytes32([]byte("v")))
+	// read from the overlay itself
+	o.GetStorage(addr2, key)
+
+	reads := o.Reads()
+	assert.Equal(t, KeySet{addr1: {}}, reads)
+	writes := o.Writes()
+	assert.True(t, writes.HasStorage(addr2, key))
+	assert.False(t, reads.Intersects(writes))
+
+	written := make(KeySet)
+	written.AddAccount(addr1)
+	assert.True(t, reads.Intersects(written))
+
+	writes.RemoveStorage(addr2, key)
+	assert.Equal(t, 0, len(writes))
+
+	o.Discard()
+	assert.Equal(t, 0, len(o.Reads()))
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// AccountProof is the merkle proof of an account and some of its storage slots.
+type AccountProof struct {
+	Address      thor.Address
+	Account      *Account // empty account if absent
+	Proof        [][]byte // encoded trie nodes from the accounts trie root
+	StorageProof []*StorageProof
+}
+
+// StorageProof is the merkle proof of a storage slot.
+type StorageProof struct {
+	Key   thor.Bytes32
+	Value rlp.RawValue // nil if absent
+	Proof [][]byte     // encoded trie nodes from the storage trie root
+}
+
+var emptyTrieRoot = thor.Blake2b(rlp.EmptyString)
+
+// proofList collects encoded trie nodes.
+type proofList [][]byte
+
+func (l *proofList) Put(key []byte, value []byte) error {
+	*l = append(*l, append([]byte(nil), value...))
+	return nil
+}
+
+// proofNodes maps hashes of proof nodes to nodes.
+type proofNodes map[thor.Bytes32][]byte
+
+func newProofNodes(list [][]byte) proofNodes {
+	nodes := make(proofNodes, len(list))
+	for _, enc := range list {
+		nodes[thor.Blake2b(enc)] = enc
+	}
+	return nodes
+}
+
+func (n proofNodes) Get(key []byte) ([]byte, error) {
+	if enc, ok := n[thor.BytesToBytes32(key)]; ok {
+		return enc, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (n proofNodes) Has(key []byte) (bool, error) {
+	_, ok := n[thor.BytesToBytes32(key)]
+	return ok, nil
+}
+
+// Prove returns the proof of account at addr and its storage slots at keys.
+// It's proved against the root which the state is created with, so changes not committed are not reflected.
+func (s *State) Prove(addr thor.Address, keys ...thor.Bytes32) (*AccountProof, error) {
+	acc, err := loadAccount(s.trie, addr)
+	if err != nil {
+		return nil, &Error{err}
+	}
+
+	proof := &AccountProof{Address: addr, Account: acc}
+	var list proofList
+	if err := s.trie.Prove(addr[:], &list); err != nil {
+		return nil, &Error{err}
+	}
+	proof.Proof = list
+
+	storageTrie := s.db.NewSecureTrie(StorageTrieName(thor.Blake2b(addr[:])), thor.BytesToBytes32(acc.StorageRoot))
+	for _, key := range keys {
+		value, err := loadStorage(storageTrie, key)
+		if err != nil {
+			return nil, &Error{err}
+		}
+		var list proofList
+		if err := storageTrie.Prove(key[:], &list); err != nil {
+			return nil, &Error{err}
+		}
+		proof.StorageProof = append(proof.StorageProof, &StorageProof{key, value, list})
+	}
+	return proof, nil
+}
+
+// VerifyProof verifies the account proof, including its storage proofs, against the state root.
+func VerifyProof(root thor.Bytes32, proof *AccountProof) error {
+	if proof.Account == nil {
+		return errors.New("account missing")
+	}
+	data, err := verifyTrieProof(root, proof.Address[:], proof.Proof)
+	if err != nil {
+		return err
+	}
+
+	var want []byte
+	if !proof.Account.IsEmpty() {
+		if want, err = rlp.EncodeToBytes(proof.Account); err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(data, want) {
+		return errors.New("account mismatch")
+	}
+
+	storageRoot := thor.BytesToBytes32(proof.Account.StorageRoot)
+	for _, sp := range proof.StorageProof {
+		value, err := verifyTrieProof(storageRoot, sp.Key[:], sp.Proof)
+		if err != nil {
+			return fmt.Errorf("storage %v: %v", sp.Key, err)
+		}
+		if !bytes.Equal(value, sp.Value) {
+			return fmt.Errorf("storage %v: value mismatch", sp.Key)
+		}
+	}
+	return nil
+}
+
+// verifyTrieProof verifies proof of key in secure trie, and returns the value.
+func verifyTrieProof(root thor.Bytes32, key []byte, proof [][]byte) ([]byte, error) {
+	if root.IsZero() || root == emptyTrieRoot {
+		// nothing in empty trie
+		return nil, nil
+	}
+	hashedKey := thor.Blake2b(key)
+	value, err, _ := trie.VerifyProof(root, hashedKey[:], newProofNodes(proof))
+	return value, err
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestProve(t *testing.T) {
+	db := muxdb.NewMem()
+	st := New(db, thor.Bytes32{})
+
+	var (
+		addr1   = thor.BytesToAddress([]byte("addr1"))
+		addr2   = thor.BytesToAddress([]byte("addr2"))
+		absent  = thor.BytesToAddress([]byte("absent"))
+		key     = thor.BytesToBytes32([]byte("key"))
+		noValue = thor.BytesToBytes32([]byte("noValue"))
+	)
+	st.SetBalance(addr1, big.NewInt(100))
+	st.SetStorage(addr1, key, thor.BytesToBytes32([]byte("value")))
+	st.SetBalance(addr2, big.NewInt(200))
+
+	stage, err := st.Stage()
+	assert.Nil(t, err)
+	root, err := stage.Commit()
+	assert.Nil(t, err)
+
+	st = New(db, root)
+	proof, err := st.Prove(addr1, key, noValue)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), proof.Account.Balance)
+	assert.NotEmpty(t, proof.StorageProof[0].Value)
+	assert.Empty(t, proof.StorageProof[1].Value)
+	assert.Nil(t, VerifyProof(root, proof))
+
+	// tampered
+	proof.Account.Balance = big.NewInt(101)
+	assert.NotNil(t, VerifyProof(root, proof))
+	proof.Account.Balance = big.NewInt(100)
+	proof.StorageProof[0].Value = proof.StorageProof[1].Value
+	assert.NotNil(t, VerifyProof(root, proof))
+	assert.NotNil(t, VerifyProof(thor.Bytes32{1}, proof))
+
+	// absent account and storage of account without storage
+	proof, err = st.Prove(absent, key)
+	assert.Nil(t, err)
+	assert.True(t, proof.Account.IsEmpty())
+	assert.Nil(t, VerifyProof(root, proof))
+
+	proof, err = st.Prove(addr2, key)
+	assert.Nil(t, err)
+	assert.Nil(t, VerifyProof(root, proof))
+}
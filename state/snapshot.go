@@ -0,0 +1,421 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	snapshotStoreName        = "state.snapshot"
+	snapshotRebuildFlushSize = 4096
+	snapshotMaxDiffs         = 256 // max count of diffs of committed stages kept to move the snapshot forward
+	snapshotMaxUndos         = 128 // max count of steps the snapshot can be rewound
+)
+
+var (
+	snapshotRootKey       = []byte("r")
+	snapshotAccountBucket = kv.Bucket("a")
+	snapshotStorageBucket = kv.Bucket("s")
+)
+
+// Snapshot is the flattened form of the state at a single root, which maps address to account,
+// and address plus key to storage value. Reads from snapshot avoid trie traversal.
+//
+// The snapshot follows the state of the best block, see MoveTo. Committed stages leave their
+// diffs to the snapshot, which are applied once their roots become the best. States at any other
+// root fall back to read tries.
+type Snapshot struct {
+	store kv.Store
+	lock  sync.RWMutex
+	root  thor.Bytes32
+	ready bool // false if rebuilding was interrupted, or the snapshot failed to move
+
+	diffs *lru.Cache      // diffs of committed stages, indexed by root
+	undos []*snapshotDiff // diffs to rewind the snapshot, the last one reverts the current root
+}
+
+// snapshotDiff is the changes to be applied on snapshot, to move it from parent root to root.
+type snapshotDiff struct {
+	parent   thor.Bytes32
+	root     thor.Bytes32
+	accounts map[thor.Address]*Account // nil value for deleted account
+	storage  map[thor.Address]map[thor.Bytes32]rlp.RawValue
+	wiped    map[thor.Address]bool // accounts whose storage should be cleared before applying changes
+}
+
+// OpenSnapshot opens the snapshot persisted in db.
+// A fresh snapshot is at the zero root, which is the empty state.
+func OpenSnapshot(db *muxdb.MuxDB) (*Snapshot, error) {
+	store := db.NewStore(snapshotStoreName)
+	diffs, _ := lru.New(snapshotMaxDiffs)
+	snap := &Snapshot{store: store, diffs: diffs}
+
+	data, err := store.Get(snapshotRootKey)
+	if err != nil {
+		if !store.IsNotFound(err) {
+			return nil, err
+		}
+		snap.ready = true
+	} else if len(data) == len(snap.root) {
+		snap.root = thor.BytesToBytes32(data)
+		snap.ready = true
+	}
+	return snap, nil
+}
+
+// Root returns the state root the snapshot is at.
+// ok is false if the snapshot is incomplete and needs to be rebuilt.
+func (s *Snapshot) Root() (root thor.Bytes32, ok bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.root, s.ready
+}
+
+// getAccount returns the account at the given state root.
+// ok is false if the snapshot is not at root.
+func (s *Snapshot) getAccount(root thor.Bytes32, addr thor.Address) (acc *Account, ok bool, err error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.ready || s.root != root {
+		return nil, false, nil
+	}
+
+	data, err := snapshotAccountBucket.ProxyGetter(s.store).Get(addr[:])
+	if err != nil {
+		if s.store.IsNotFound(err) {
+			return emptyAccount(), true, nil
+		}
+		return nil, false, err
+	}
+	var a Account
+	if err := rlp.DecodeBytes(data, &a); err != nil {
+		return nil, false, err
+	}
+	return &a, true, nil
+}
+
+// getStorage returns the storage value at the given state root.
+// ok is false if the snapshot is not at root.
+func (s *Snapshot) getStorage(root thor.Bytes32, addr thor.Address, key thor.Bytes32) (value rlp.RawValue, ok bool, err error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.ready || s.root != root {
+		return nil, false, nil
+	}
+
+	data, err := snapshotStorageBucket.ProxyGetter(s.store).Get(snapshotStorageKey(addr, key))
+	if err != nil {
+		if s.store.IsNotFound(err) {
+			return nil, true, nil
+		}
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// addDiff keeps the diff of a committed stage, to move the snapshot forward later.
+func (s *Snapshot) addDiff(parent, root thor.Bytes32, diff *snapshotDiff) {
+	if parent == root {
+		return
+	}
+	diff.parent, diff.root = parent, root
+	s.diffs.Add(root, diff)
+}
+
+// MoveTo moves the snapshot to the given root, which should be the state root of the new best block.
+// The snapshot is rewound along roots it passed, and then moved forward by diffs of committed stages.
+// If there's no such path, e.g. reorg too deep, the snapshot is marked as incomplete, and reads fall back
+// to tries until it's rebuilt.
+func (s *Snapshot) MoveTo(root thor.Bytes32) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.ready || s.root == root {
+		return nil
+	}
+
+	// roots the snapshot can be rewound to, mapped to the count of undos left
+	rewindable := map[thor.Bytes32]int{s.root: len(s.undos)}
+	for i := len(s.undos) - 1; i >= 0; i-- {
+		if _, ok := rewindable[s.undos[i].root]; !ok {
+			rewindable[s.undos[i].root] = i
+		}
+	}
+
+	var forward []*snapshotDiff
+	for target := root; len(forward) <= snapshotMaxDiffs; {
+		if n, ok := rewindable[target]; ok {
+			if err := s.move(n, forward); err != nil {
+				if ierr := s.invalidate(); ierr != nil {
+					return ierr
+				}
+				return err
+			}
+			return nil
+		}
+		v, ok := s.diffs.Get(target)
+		if !ok {
+			break
+		}
+		diff := v.(*snapshotDiff)
+		forward = append(forward, diff)
+		target = diff.parent
+	}
+	return s.invalidate()
+}
+
+// move rewinds the snapshot until n undos left, and then applies forward diffs in reverse order.
+func (s *Snapshot) move(n int, forward []*snapshotDiff) error {
+	for len(s.undos) > n {
+		undo := s.undos[len(s.undos)-1]
+		if _, err := s.apply(undo, false); err != nil {
+			return err
+		}
+		s.undos = s.undos[:len(s.undos)-1]
+	}
+	for i := len(forward) - 1; i >= 0; i-- {
+		undo, err := s.apply(forward[i], true)
+		if err != nil {
+			return err
+		}
+		s.undos = append(s.undos, undo)
+	}
+	if len(s.undos) > snapshotMaxUndos {
+		s.undos = append([]*snapshotDiff(nil), s.undos[len(s.undos)-snapshotMaxUndos:]...)
+	}
+	return nil
+}
+
+// invalidate marks the snapshot as incomplete.
+func (s *Snapshot) invalidate() error {
+	s.ready = false
+	s.undos = nil
+	return s.store.Put(snapshotRootKey, nil)
+}
+
+// apply applies diff to move the snapshot from diff.parent to diff.root.
+// If withUndo is true, the diff to revert the change is returned.
+func (s *Snapshot) apply(diff *snapshotDiff, withUndo bool) (*snapshotDiff, error) {
+	var (
+		undo       *snapshotDiff
+		accountsR  = snapshotAccountBucket.ProxyGetter(s.store)
+		storageR   = snapshotStorageBucket.ProxyGetter(s.store)
+		wipedKeys  [][]byte
+		undoValues = func(addr thor.Address) map[thor.Bytes32]rlp.RawValue {
+			values := undo.storage[addr]
+			if values == nil {
+				values = make(map[thor.Bytes32]rlp.RawValue)
+				undo.storage[addr] = values
+			}
+			return values
+		}
+	)
+	if withUndo {
+		undo = &snapshotDiff{
+			parent:   diff.root,
+			root:     diff.parent,
+			accounts: make(map[thor.Address]*Account, len(diff.accounts)),
+			storage:  make(map[thor.Address]map[thor.Bytes32]rlp.RawValue),
+		}
+	}
+
+	// collect storage to be cleared before entering the batch
+	for addr := range diff.wiped {
+		prefix := append(append([]byte(nil), snapshotStorageBucket...), addr[:]...)
+		it := kv.NewIterator(s.store, prefix, nil)
+		for it.Next() {
+			wipedKeys = append(wipedKeys, append([]byte(nil), it.Key()...))
+			if undo != nil {
+				undoValues(addr)[thor.BytesToBytes32(it.Key()[len(prefix):])] = append(rlp.RawValue(nil), it.Value()...)
+			}
+		}
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	if undo != nil {
+		for addr := range diff.accounts {
+			data, err := accountsR.Get(addr[:])
+			if err != nil {
+				if !s.store.IsNotFound(err) {
+					return nil, err
+				}
+				undo.accounts[addr] = nil
+				continue
+			}
+			var acc Account
+			if err := rlp.DecodeBytes(data, &acc); err != nil {
+				return nil, err
+			}
+			undo.accounts[addr] = &acc
+		}
+		for addr, slots := range diff.storage {
+			values := undoValues(addr)
+			for key := range slots {
+				if _, ok := values[key]; ok {
+					continue
+				}
+				data, err := storageR.Get(snapshotStorageKey(addr, key))
+				if err != nil && !s.store.IsNotFound(err) {
+					return nil, err
+				}
+				// wiped storage values are already collected, so not found means absent
+				values[key] = data
+			}
+		}
+	}
+
+	if err := s.store.Batch(func(w kv.PutFlusher) error {
+		for _, key := range wipedKeys {
+			if err := w.Delete(key); err != nil {
+				return err
+			}
+		}
+		accounts := snapshotAccountBucket.ProxyPutter(w)
+		storage := snapshotStorageBucket.ProxyPutter(w)
+
+		for addr, acc := range diff.accounts {
+			if acc == nil {
+				if err := accounts.Delete(addr[:]); err != nil {
+					return err
+				}
+				continue
+			}
+			data, err := rlp.EncodeToBytes(acc)
+			if err != nil {
+				return err
+			}
+			if err := accounts.Put(addr[:], data); err != nil {
+				return err
+			}
+		}
+		for addr, slots := range diff.storage {
+			for key, value := range slots {
+				if len(value) == 0 {
+					if err := storage.Delete(snapshotStorageKey(addr, key)); err != nil {
+						return err
+					}
+				} else if err := storage.Put(snapshotStorageKey(addr, key), value); err != nil {
+					return err
+				}
+			}
+		}
+		return w.Put(snapshotRootKey, diff.root[:])
+	}); err != nil {
+		return nil, err
+	}
+	s.root = diff.root
+	return undo, nil
+}
+
+// Rebuild regenerates the snapshot from tries at the given state root.
+// It's time consuming for large state, and blocks snapshot reads until done.
+func (s *Snapshot) Rebuild(db *muxdb.MuxDB, root thor.Bytes32) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.ready = false
+	if err := s.store.Batch(func(w kv.PutFlusher) error {
+		// clear all
+		var stale [][]byte
+		if err := s.store.Iterate(kv.Range{}, func(pair kv.Pair) bool {
+			stale = append(stale, append([]byte(nil), pair.Key()...))
+			return true
+		}); err != nil {
+			return err
+		}
+		for _, key := range stale {
+			if err := w.Delete(key); err != nil {
+				return err
+			}
+		}
+		// mark as incomplete, in case of interruption
+		if err := w.Put(snapshotRootKey, nil); err != nil {
+			return err
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		var (
+			accounts = snapshotAccountBucket.ProxyPutter(w)
+			storage  = snapshotStorageBucket.ProxyPutter(w)
+			n        int
+		)
+		// flush periodically to limit memory usage
+		flush := func() error {
+			if n++; n%snapshotRebuildFlushSize == 0 {
+				return w.Flush()
+			}
+			return nil
+		}
+
+		it := NewAccountIterator(db, root)
+		for it.Next() {
+			addr := it.Address()
+			data, err := rlp.EncodeToBytes(it.Account())
+			if err != nil {
+				return err
+			}
+			if err := accounts.Put(addr[:], data); err != nil {
+				return err
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+
+			sit := it.Storage()
+			for sit.Next() {
+				if err := storage.Put(snapshotStorageKey(addr, sit.Key()), sit.Value()); err != nil {
+					return err
+				}
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			if err := sit.Error(); err != nil {
+				return err
+			}
+		}
+		if err := it.Error(); err != nil {
+			return err
+		}
+		return w.Put(snapshotRootKey, root[:])
+	}); err != nil {
+		return err
+	}
+	s.root = root
+	s.ready = true
+	s.undos = nil
+	return nil
+}
+
+func snapshotStorageKey(addr thor.Address, key thor.Bytes32) []byte {
+	return append(append(make([]byte, 0, len(addr)+len(key)), addr[:]...), key[:]...)
+}
+
+// isSameStorageRoot returns whether two storage roots refer to the same storage trie.
+func isSameStorageRoot(a, b []byte) bool {
+	isEmpty := func(r []byte) bool {
+		root := thor.BytesToBytes32(r)
+		return root.IsZero() || root == emptyTrieRoot
+	}
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+	return bytes.Equal(a, b)
+}
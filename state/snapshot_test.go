@@ -0,0 +1,150 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestSnapshot(t *testing.T) {
+	db := muxdb.NewMem()
+	snap, err := OpenSnapshot(db)
+	assert.Nil(t, err)
+	stater := NewStaterWithSnapshot(db, snap)
+
+	var (
+		addr1 = thor.BytesToAddress([]byte("addr1"))
+		addr2 = thor.BytesToAddress([]byte("addr2"))
+		key1  = thor.BytesToBytes32([]byte("key1"))
+		key2  = thor.BytesToBytes32([]byte("key2"))
+		v1    = thor.BytesToBytes32([]byte("v1"))
+		v2    = thor.BytesToBytes32([]byte("v2"))
+	)
+
+	commit := func(st *State) thor.Bytes32 {
+		stage, err := st.Stage()
+		assert.Nil(t, err)
+		root, err := stage.Commit()
+		assert.Nil(t, err)
+		return root
+	}
+
+	// check reads through snapshot equal to reads of tries
+	check := func(root thor.Bytes32) {
+		for _, addr := range []thor.Address{addr1, addr2} {
+			for _, key := range []thor.Bytes32{key1, key2} {
+				want, err := New(db, root).GetStorage(addr, key)
+				assert.Nil(t, err)
+				got, err := stater.NewState(root).GetStorage(addr, key)
+				assert.Nil(t, err)
+				assert.Equal(t, want, got)
+			}
+			want, err := New(db, root).GetBalance(addr)
+			assert.Nil(t, err)
+			got, err := stater.NewState(root).GetBalance(addr)
+			assert.Nil(t, err)
+			assert.Equal(t, want, got)
+		}
+	}
+
+	st := stater.NewState(thor.Bytes32{})
+	st.SetBalance(addr1, big.NewInt(1))
+	st.SetStorage(addr1, key1, v1)
+	st.SetStorage(addr1, key2, v2)
+	st.SetBalance(addr2, big.NewInt(2))
+	// addr2 is right after addr1, its storage should survive wiping of addr1
+	st.SetStorage(addr2, key1, v1)
+	root1 := commit(st)
+
+	root, ok := snap.Root()
+	assert.True(t, ok)
+	assert.Equal(t, thor.Bytes32{}, root, "snapshot should not move until the root becomes the best")
+
+	assert.Nil(t, snap.MoveTo(root1))
+	root, ok = snap.Root()
+	assert.True(t, ok)
+	assert.Equal(t, root1, root)
+	check(root1)
+
+	acc, ok, err := snap.getAccount(root1, addr1)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, big.NewInt(1), acc.Balance)
+
+	// recreate addr1 and clear key2
+	st = stater.NewState(root1)
+	st.Delete(addr1)
+	st.SetBalance(addr1, big.NewInt(10))
+	st.SetStorage(addr1, key1, v2)
+	st.SetStorage(addr2, key2, v1)
+	root2 := commit(st)
+
+	assert.Nil(t, snap.MoveTo(root2))
+	root, _ = snap.Root()
+	assert.Equal(t, root2, root)
+	check(root2)
+
+	value, ok, err := snap.getStorage(root2, addr1, key2)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Empty(t, value, "storage should be wiped on account recreation")
+
+	// fork from root1
+	st = stater.NewState(root1)
+	st.SetBalance(addr2, big.NewInt(20))
+	st.SetStorage(addr1, key2, v1)
+	root3 := commit(st)
+	st = stater.NewState(root3)
+	st.SetStorage(addr2, key1, v2)
+	root4 := commit(st)
+
+	_, ok, _ = snap.getAccount(root4, addr2)
+	assert.False(t, ok, "root not reached")
+
+	// reorg: rewind to root1, then move forward
+	assert.Nil(t, snap.MoveTo(root4))
+	root, ok = snap.Root()
+	assert.True(t, ok)
+	assert.Equal(t, root4, root)
+	check(root4)
+
+	// and back
+	assert.Nil(t, snap.MoveTo(root2))
+	root, ok = snap.Root()
+	assert.True(t, ok)
+	assert.Equal(t, root2, root)
+	check(root2)
+
+	// no path to unknown root
+	assert.Nil(t, snap.MoveTo(thor.Bytes32{1}))
+	_, ok = snap.Root()
+	assert.False(t, ok)
+	check(root2)
+
+	// rebuild
+	assert.Nil(t, snap.Rebuild(db, root3))
+	root, ok = snap.Root()
+	assert.True(t, ok)
+	assert.Equal(t, root3, root)
+	check(root3)
+
+	assert.Nil(t, snap.MoveTo(root4))
+	root, _ = snap.Root()
+	assert.Equal(t, root4, root)
+	check(root4)
+
+	// reopen
+	snap, err = OpenSnapshot(db)
+	assert.Nil(t, err)
+	root, ok = snap.Root()
+	assert.True(t, ok)
+	assert.Equal(t, root4, root)
+}
@@ -6,6 +6,7 @@
 package state
 
 import (
+	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/kv"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/thor"
@@ -17,6 +18,10 @@ type Stage struct {
 	accountTrie  *muxdb.Trie
 	storageTries []*muxdb.Trie
 	codes        map[thor.Bytes32][]byte
+
+	snap       *Snapshot
+	parentRoot thor.Bytes32
+	snapDiff   *snapshotDiff
 }
 
 // Hash computes hash of the main accounts trie.
@@ -40,13 +45,31 @@ func (s *Stage) Commit() (thor.Bytes32, error) {
 		return thor.Bytes32{}, &Error{err}
 	}
 
-	// commit storage tries
-	for _, t := range s.storageTries {
-		if _, err := t.Commit(); err != nil {
+	// commit storage tries in parallel, each in its own batch
+	errs := make([]error, len(s.storageTries))
+	<-co.Parallel(func(queue chan<- func()) {
+		for i, t := range s.storageTries {
+			i, t := i, t
+			queue <- func() {
+				_, errs[i] = t.Commit()
+			}
+		}
+	})
+	for _, err := range errs {
+		if err != nil {
 			return thor.Bytes32{}, &Error{err}
 		}
 	}
 
 	// commit accounts trie
-	return s.accountTrie.Commit()
+	root, err := s.accountTrie.Commit()
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+
+	// the snapshot moves once the root becomes the best
+	if s.snap != nil {
+		s.snap.addDiff(s.parentRoot, root, s.snapDiff)
+	}
+	return root, nil
 }
@@ -12,9 +12,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/stackedmap"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
 )
 
 const (
@@ -40,18 +42,44 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("state: %v", e.cause)
 }
 
+// IsMissingTrieNode returns whether the error is caused by missing trie node,
+// which usually means the state has been pruned.
+func IsMissingTrieNode(err error) bool {
+	for {
+		switch e := err.(type) {
+		case *Error:
+			err = e.cause
+		case *trie.MissingNodeError:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
 // State manages the world state.
 type State struct {
 	db    *muxdb.MuxDB
+	snap  *Snapshot                      // optional flat snapshot
+	root  thor.Bytes32                   // the root which the state is created with
 	trie  *muxdb.Trie                    // the accounts trie reader
 	cache map[thor.Address]*cachedObject // cache of accounts trie
 	sm    *stackedmap.StackedMap         // keeps revisions of accounts state
+
+	recorder *witnessRecorder // records accesses if not nil
+	base     *State           // the underlying state if it's an overlay
 }
 
 // New create state object.
 func New(db *muxdb.MuxDB, root thor.Bytes32) *State {
+	return newState(db, nil, root)
+}
+
+func newState(db *muxdb.MuxDB, snap *Snapshot, root thor.Bytes32) *State {
 	state := State{
 		db:    db,
+		snap:  snap,
+		root:  root,
 		trie:  db.NewSecureTrie(AccountTrieName, root),
 		cache: make(map[thor.Address]*cachedObject),
 	}
@@ -64,7 +92,7 @@ func New(db *muxdb.MuxDB, root thor.Bytes32) *State {
 
 // NewStater create stater object.
 func (s *State) NewStater() *Stater {
-	return NewStater(s.db)
+	return &Stater{s.db, s.snap}
 }
 
 // cacheGetter implements stackedmap.MapGetter.
@@ -81,6 +109,9 @@ func (s *State) cacheGetter(key interface{}) (value interface{}, exist bool, err
 		if err != nil {
 			return nil, false, err
 		}
+		if s.recorder != nil {
+			s.recorder.touchCode(obj.data.CodeHash)
+		}
 		code, err := obj.GetCode()
 		if err != nil {
 			return nil, false, err
@@ -91,6 +122,9 @@ func (s *State) cacheGetter(key interface{}) (value interface{}, exist bool, err
 		if err != nil {
 			return nil, false, err
 		}
+		if s.recorder != nil {
+			s.recorder.touchStorage(k.addr, k.key)
+		}
 		v, err := obj.GetStorage(k.key)
 		if err != nil {
 			return nil, false, err
@@ -104,11 +138,28 @@ func (s *State) getCachedObject(addr thor.Address) (*cachedObject, error) {
 	if co, ok := s.cache[addr]; ok {
 		return co, nil
 	}
-	a, err := loadAccount(s.trie, addr)
-	if err != nil {
-		return nil, err
+	if s.recorder != nil {
+		s.recorder.touchAccount(addr)
+	}
+	var (
+		a   *Account
+		ok  bool
+		err error
+	)
+	if s.snap != nil {
+		if a, ok, err = s.snap.getAccount(s.root, addr); err != nil {
+			return nil, err
+		}
+	}
+	if !ok {
+		if a, err = loadAccount(s.trie, addr); err != nil {
+			return nil, err
+		}
 	}
 	co := newCachedObject(s.db, addr, a)
+	if s.snap != nil {
+		co.snap, co.stateRoot = s.snap, s.root
+	}
 	s.cache[addr] = co
 	return co, nil
 }
@@ -334,6 +385,23 @@ func (s *State) RevertTo(revision int) {
 	s.sm.PopTo(revision)
 }
 
+// journal traverses journal entries of all underlying states and then the state itself.
+// It returns false if the traverse is aborted.
+func (s *State) journal(cb func(key, value interface{}) bool) bool {
+	if s.base != nil && !s.base.journal(cb) {
+		return false
+	}
+	aborted := false
+	s.sm.Journal(func(k, v interface{}) bool {
+		if !cb(k, v) {
+			aborted = true
+			return false
+		}
+		return true
+	})
+	return !aborted
+}
+
 // BuildStorageTrie build up storage trie for given address with cumulative changes.
 func (s *State) BuildStorageTrie(addr thor.Address) (*muxdb.Trie, error) {
 	acc, err := s.getAccount(addr)
@@ -346,7 +414,7 @@ func (s *State) BuildStorageTrie(addr thor.Address) (*muxdb.Trie, error) {
 	trie := s.db.NewSecureTrie(StorageTrieName(thor.Blake2b(addr[:])), root)
 
 	// traverse journal to filter out storage changes for addr
-	s.sm.Journal(func(k, v interface{}) bool {
+	s.journal(func(k, v interface{}) bool {
 		switch key := k.(type) {
 		case storageKey:
 			if key.addr == addr {
@@ -367,6 +435,7 @@ func (s *State) BuildStorageTrie(addr thor.Address) (*muxdb.Trie, error) {
 // Stage makes a stage object to compute hash of trie or commit all changes.
 func (s *State) Stage() (*Stage, error) {
 	type changed struct {
+		orig    Account
 		data    Account
 		storage map[thor.Bytes32]rlp.RawValue
 	}
@@ -386,14 +455,14 @@ func (s *State) Stage() (*Stage, error) {
 			return nil, &Error{err}
 		}
 
-		c := &changed{data: co.data}
+		c := &changed{orig: co.data, data: co.data}
 		changes[addr] = c
 		return c, nil
 	}
 
 	var jerr error
 	// traverse journal to build changes
-	s.sm.Journal(func(k, v interface{}) bool {
+	s.journal(func(k, v interface{}) bool {
 		var c *changed
 		switch key := k.(type) {
 		case thor.Address:
@@ -426,27 +495,77 @@ func (s *State) Stage() (*Stage, error) {
 		accountTrie: s.db.NewSecureTrie(AccountTrieName, s.trie.Hash()),
 		codes:       codes,
 	}
+	if s.snap != nil {
+		stage.snap = s.snap
+		stage.parentRoot = s.root
+		stage.snapDiff = &snapshotDiff{
+			accounts: make(map[thor.Address]*Account, len(changes)),
+			storage:  make(map[thor.Address]map[thor.Bytes32]rlp.RawValue),
+			wiped:    make(map[thor.Address]bool),
+		}
+	}
+
+	type storageJob struct {
+		c    *changed
+		trie *muxdb.Trie
+		err  error
+	}
+	var storageJobs []*storageJob
 
 	for addr, c := range changes {
+		if diff := stage.snapDiff; diff != nil {
+			// storage is reset if the account is deleted or recreated
+			if c.data.IsEmpty() || !isSameStorageRoot(c.orig.StorageRoot, c.data.StorageRoot) {
+				diff.wiped[addr] = true
+			}
+			if !c.data.IsEmpty() && len(c.storage) > 0 {
+				diff.storage[addr] = c.storage
+			}
+		}
 		// skip storage changes if account is empty
-		if !c.data.IsEmpty() {
-			if len(c.storage) > 0 {
-				storageTrie := s.db.NewSecureTrie(
-					StorageTrieName(thor.Blake2b(addr[:])),
-					thor.BytesToBytes32(c.data.StorageRoot))
-
-				stage.storageTries = append(stage.storageTries, storageTrie)
-				for k, v := range c.storage {
-					if err := saveStorage(storageTrie, k, v); err != nil {
-						return nil, &Error{err}
+		if !c.data.IsEmpty() && len(c.storage) > 0 {
+			storageTrie := s.db.NewSecureTrie(
+				StorageTrieName(thor.Blake2b(addr[:])),
+				thor.BytesToBytes32(c.data.StorageRoot))
+
+			stage.storageTries = append(stage.storageTries, storageTrie)
+			storageJobs = append(storageJobs, &storageJob{c, storageTrie, nil})
+		}
+	}
+
+	// storage tries are independent, so update and hash them in parallel
+	<-co.Parallel(func(queue chan<- func()) {
+		for _, job := range storageJobs {
+			job := job
+			queue <- func() {
+				for k, v := range job.c.storage {
+					if err := saveStorage(job.trie, k, v); err != nil {
+						job.err = err
+						return
 					}
 				}
-				c.data.StorageRoot = storageTrie.Hash().Bytes()
+				job.c.data.StorageRoot = job.trie.Hash().Bytes()
 			}
 		}
+	})
+	for _, job := range storageJobs {
+		if job.err != nil {
+			return nil, &Error{job.err}
+		}
+	}
+
+	for addr, c := range changes {
 		if err := saveAccount(stage.accountTrie, addr, &c.data); err != nil {
 			return nil, &Error{err}
 		}
+		if diff := stage.snapDiff; diff != nil {
+			if c.data.IsEmpty() {
+				diff.accounts[addr] = nil
+			} else {
+				data := c.data
+				diff.accounts[addr] = &data
+			}
+		}
 	}
 	return stage, nil
 }
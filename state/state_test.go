@@ -6,6 +6,7 @@
 package state
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -146,3 +147,16 @@ func TestStorage(t *testing.T) {
 
 	assert.Equal(t, M(thor.Blake2b(data), nil), M(st.GetStorage(addr, key)))
 }
+
+func TestIsMissingTrieNode(t *testing.T) {
+	db := muxdb.NewMem()
+
+	// state at a root never committed
+	st := New(db, thor.BytesToBytes32([]byte("pruned")))
+	_, err := st.GetBalance(thor.BytesToAddress([]byte("addr")))
+	assert.NotNil(t, err)
+	assert.True(t, IsMissingTrieNode(err))
+
+	assert.False(t, IsMissingTrieNode(errors.New("other")))
+	assert.False(t, IsMissingTrieNode(nil))
+}
@@ -12,15 +12,22 @@ import (
 
 // Stater is the state creator.
 type Stater struct {
-	db *muxdb.MuxDB
+	db   *muxdb.MuxDB
+	snap *Snapshot
 }
 
 // NewStater create a new stater.
 func NewStater(db *muxdb.MuxDB) *Stater {
-	return &Stater{db}
+	return &Stater{db, nil}
+}
+
+// NewStaterWithSnapshot create a new stater, whose states read through the flat snapshot when possible.
+// The snapshot should be shared among all staters of the db.
+func NewStaterWithSnapshot(db *muxdb.MuxDB, snap *Snapshot) *Stater {
+	return &Stater{db, snap}
 }
 
 // NewState create a new state object.
 func (s *Stater) NewState(root thor.Bytes32) *State {
-	return New(s.db, root)
+	return newState(s.db, s.snap, root)
 }
@@ -0,0 +1,128 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/vechain/thor/thor"
+)
+
+// Witness is the data of a state touched during execution, which is enough to
+// re-execute without the full state.
+//
+// Nodes are those along the read paths of touched accounts and storage slots. Computing
+// the post-state root may additionally require sibling nodes, when deletions collapse branches.
+type Witness struct {
+	Root  thor.Bytes32 // the state root
+	Nodes [][]byte     // encoded nodes of accounts trie and storage tries, sorted by hash
+	Codes [][]byte     // sorted by hash
+}
+
+// witnessRecorder records accounts, storage slots and codes loaded from the underlying tries.
+type witnessRecorder struct {
+	storage map[thor.Address]map[thor.Bytes32]bool
+	codes   map[thor.Bytes32]bool
+}
+
+func (r *witnessRecorder) touchAccount(addr thor.Address) {
+	if _, ok := r.storage[addr]; !ok {
+		r.storage[addr] = make(map[thor.Bytes32]bool)
+	}
+}
+
+func (r *witnessRecorder) touchStorage(addr thor.Address, key thor.Bytes32) {
+	r.touchAccount(addr)
+	r.storage[addr][key] = true
+}
+
+func (r *witnessRecorder) touchCode(codeHash []byte) {
+	if len(codeHash) > 0 {
+		r.codes[thor.BytesToBytes32(codeHash)] = true
+	}
+}
+
+// RecordWitness starts recording accesses to the underlying tries, for building the witness later.
+// It should be called before any access.
+func (s *State) RecordWitness() {
+	s.recorder = &witnessRecorder{
+		storage: make(map[thor.Address]map[thor.Bytes32]bool),
+		codes:   make(map[thor.Bytes32]bool),
+	}
+}
+
+// Witness builds the witness from accesses recorded so far.
+func (s *State) Witness() (*Witness, error) {
+	if s.recorder == nil {
+		return nil, errors.New("witness not recorded")
+	}
+
+	// written keys are also needed to update tries
+	s.journal(func(k, v interface{}) bool {
+		switch key := k.(type) {
+		case thor.Address:
+			s.recorder.touchAccount(key)
+		case storageKey:
+			s.recorder.touchStorage(key.addr, key.key)
+		}
+		return true
+	})
+
+	nodes := make(map[thor.Bytes32][]byte)
+	addNodes := func(list [][]byte) {
+		for _, enc := range list {
+			nodes[thor.Blake2b(enc)] = enc
+		}
+	}
+
+	for addr, keys := range s.recorder.storage {
+		sorted := make([]thor.Bytes32, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		proof, err := s.Prove(addr, sorted...)
+		if err != nil {
+			return nil, err
+		}
+		addNodes(proof.Proof)
+		for _, sp := range proof.StorageProof {
+			addNodes(sp.Proof)
+		}
+	}
+
+	codeStore := s.db.NewStore(codeStoreName)
+	codes := make(map[thor.Bytes32][]byte, len(s.recorder.codes))
+	for hash := range s.recorder.codes {
+		code, err := codeStore.Get(hash[:])
+		if err != nil {
+			return nil, &Error{err}
+		}
+		codes[hash] = code
+	}
+
+	return &Witness{
+		Root:  s.root,
+		Nodes: sortedByHash(nodes),
+		Codes: sortedByHash(codes),
+	}, nil
+}
+
+func sortedByHash(m map[thor.Bytes32][]byte) [][]byte {
+	hashes := make([]thor.Bytes32, 0, len(m))
+	for h := range m {
+		hashes = append(hashes, h)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i][:], hashes[j][:]) < 0
+	})
+	list := make([][]byte, 0, len(hashes))
+	for _, h := range hashes {
+		list = append(list, m[h])
+	}
+	return list
+}
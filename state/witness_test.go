@@ -0,0 +1,82 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestWitness(t *testing.T) {
+	db := muxdb.NewMem()
+
+	var (
+		addrs   []thor.Address
+		key     = thor.BytesToBytes32([]byte("key"))
+		written = thor.BytesToBytes32([]byte("written"))
+		code    = []byte{1, 2, 3}
+	)
+	st := New(db, thor.Bytes32{})
+	for i := 0; i < 100; i++ {
+		addr := thor.BytesToAddress([]byte{byte(i)})
+		addrs = append(addrs, addr)
+		st.SetBalance(addr, big.NewInt(int64(i+1)))
+		st.SetStorage(addr, key, thor.BytesToBytes32([]byte{byte(i)}))
+	}
+	assert.Nil(t, st.SetCode(addrs[0], code))
+	stage, err := st.Stage()
+	assert.Nil(t, err)
+	root, err := stage.Commit()
+	assert.Nil(t, err)
+
+	st = New(db, root)
+	_, err = st.Witness()
+	assert.NotNil(t, err, "should fail if not recording")
+
+	st.RecordWitness()
+	_, err = st.GetBalance(addrs[1])
+	assert.Nil(t, err)
+	_, err = st.GetStorage(addrs[0], key)
+	assert.Nil(t, err)
+	_, err = st.GetCode(addrs[0])
+	assert.Nil(t, err)
+	st.SetStorage(addrs[2], written, thor.BytesToBytes32([]byte("v")))
+
+	w, err := st.Witness()
+	assert.Nil(t, err)
+	assert.Equal(t, root, w.Root)
+	assert.Equal(t, [][]byte{code}, w.Codes)
+
+	// touched accounts and slots are provable with witness nodes
+	nodes := w.Nodes
+	for _, addr := range addrs[:3] {
+		proof, err := New(db, root).Prove(addr, key, written)
+		assert.Nil(t, err)
+		if addr == addrs[1] {
+			proof.StorageProof = nil // storage of addrs[1] not touched
+		}
+		proof.Proof = nodes
+		for _, sp := range proof.StorageProof {
+			sp.Proof = nodes
+		}
+		assert.Nil(t, VerifyProof(root, proof))
+	}
+
+	// untouched
+	proof, err := New(db, root).Prove(addrs[99])
+	assert.Nil(t, err)
+	proof.Proof = nodes
+	assert.NotNil(t, VerifyProof(root, proof))
+
+	// should be compact
+	full, err := New(db, root).Prove(addrs[0])
+	assert.Nil(t, err)
+	assert.True(t, len(nodes) < len(addrs)*len(full.Proof))
+}
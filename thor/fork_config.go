@@ -13,9 +13,16 @@ import (
 
 // ForkConfig config for a fork.
 type ForkConfig struct {
-	VIP191    uint32
-	ETH_CONST uint32
-	BLOCKLIST uint32
+	VIP191      uint32
+	ETH_CONST   uint32
+	BLOCKLIST   uint32
+	ENDORSEMENT uint32
+	NON_ATOMIC  uint32
+	FINALITY    uint32
+	ROTATION    uint32
+	INTERVAL    uint32
+	WEIGHTING   uint32
+	BLOCKSIZE   uint32
 }
 
 func (fc ForkConfig) String() string {
@@ -29,30 +36,58 @@ func (fc ForkConfig) String() string {
 	push("VIP191", fc.VIP191)
 	push("ETH_CONST", fc.ETH_CONST)
 	push("BLOCKLIST", fc.BLOCKLIST)
+	push("ENDORSEMENT", fc.ENDORSEMENT)
+	push("NON_ATOMIC", fc.NON_ATOMIC)
+	push("FINALITY", fc.FINALITY)
+	push("ROTATION", fc.ROTATION)
+	push("INTERVAL", fc.INTERVAL)
+	push("WEIGHTING", fc.WEIGHTING)
+	push("BLOCKSIZE", fc.BLOCKSIZE)
 
 	return strings.Join(strs, ", ")
 }
 
 // NoFork a special config without any forks.
 var NoFork = ForkConfig{
-	VIP191:    math.MaxUint32,
-	ETH_CONST: math.MaxUint32,
-	BLOCKLIST: math.MaxUint32,
+	VIP191:      math.MaxUint32,
+	ETH_CONST:   math.MaxUint32,
+	BLOCKLIST:   math.MaxUint32,
+	ENDORSEMENT: math.MaxUint32,
+	NON_ATOMIC:  math.MaxUint32,
+	FINALITY:    math.MaxUint32,
+	ROTATION:    math.MaxUint32,
+	INTERVAL:    math.MaxUint32,
+	WEIGHTING:   math.MaxUint32,
+	BLOCKSIZE:   math.MaxUint32,
 }
 
 // for well-known networks
 var forkConfigs = map[Bytes32]ForkConfig{
 	// mainnet
 	MustParseBytes32("0x00000000851caf3cfdb6e899cf5958bfb1ac3413d346d43539627e6be7ec1b4a"): {
-		VIP191:    3337300,
-		ETH_CONST: 3337300,
-		BLOCKLIST: 4817300,
+		VIP191:      3337300,
+		ETH_CONST:   3337300,
+		BLOCKLIST:   4817300,
+		ENDORSEMENT: math.MaxUint32,
+		NON_ATOMIC:  math.MaxUint32,
+		FINALITY:    math.MaxUint32,
+		ROTATION:    math.MaxUint32,
+		INTERVAL:    math.MaxUint32,
+		WEIGHTING:   math.MaxUint32,
+		BLOCKSIZE:   math.MaxUint32,
 	},
 	// testnet
 	MustParseBytes32("0x000000000b2bce3c70bc649a02749e8687721b09ed2e15997f466536b20bb127"): {
-		VIP191:    2898800,
-		ETH_CONST: 3192500,
-		BLOCKLIST: math.MaxUint32,
+		VIP191:      2898800,
+		ETH_CONST:   3192500,
+		BLOCKLIST:   math.MaxUint32,
+		ENDORSEMENT: math.MaxUint32,
+		NON_ATOMIC:  math.MaxUint32,
+		FINALITY:    math.MaxUint32,
+		ROTATION:    math.MaxUint32,
+		INTERVAL:    math.MaxUint32,
+		WEIGHTING:   math.MaxUint32,
+		BLOCKSIZE:   math.MaxUint32,
 	},
 }
 
@@ -14,7 +14,8 @@ import (
 
 // Constants of block chain.
 const (
-	BlockInterval uint64 = 10 // time interval between two consecutive blocks.
+	BlockInterval    uint64 = 10   // time interval between two consecutive blocks, or the default one since INTERVAL fork.
+	MaxBlockInterval uint64 = 3600 // upper bound of block interval set by governance.
 
 	TxGas                     uint64 = 5000
 	ClauseGas                 uint64 = params.TxGas - TxGas
@@ -30,7 +31,12 @@ const (
 
 	MaxTxWorkDelay uint32 = 30 // (unit: block) if tx delay exceeds this value, no energy can be exchanged.
 
+	MaxBlockSize uint64 = 2 * 1024 * 1024 // max RLP-encoded size of a block since BLOCKSIZE fork.
+
 	MaxBlockProposers uint64 = 101
+	MaxProposerWeight uint64 = 10 // upper bound of scheduling weight of a proposer since WEIGHTING fork.
+
+	CheckpointInterval uint32 = 180 // block interval between two finality checkpoints, which is an epoch
 
 	TolerableBlockPackingTime = 500 * time.Millisecond // the indicator to adjust target block gas limit
 
@@ -43,6 +49,8 @@ var (
 	KeyRewardRatio         = BytesToBytes32([]byte("reward-ratio"))
 	KeyBaseGasPrice        = BytesToBytes32([]byte("base-gas-price"))
 	KeyProposerEndorsement = BytesToBytes32([]byte("proposer-endorsement"))
+	KeyBlockInterval       = BytesToBytes32([]byte("block-interval"))
+	KeyWeightUnit          = BytesToBytes32([]byte("weight-unit"))
 
 	InitialRewardRatio         = big.NewInt(3e17) // 30%
 	InitialBaseGasPrice        = big.NewInt(1e15)
@@ -25,9 +25,14 @@ import (
 	"github.com/vechain/thor/thor"
 )
 
+// parallelHashThreshold is the min count of unhashed updates to hash root subtrees in parallel.
+// Below it, the overhead of goroutines outweighs the gain.
+const parallelHashThreshold = 100
+
 type hasher struct {
-	tmp sliceBuffer
-	sha hash.Hash
+	tmp      sliceBuffer
+	sha      hash.Hash
+	parallel bool // whether to hash children of root full node in parallel
 }
 
 type sliceBuffer []byte
@@ -57,6 +62,7 @@ func newHasher() *hasher {
 }
 
 func returnHasherToPool(h *hasher) {
+	h.parallel = false
 	hasherPool.Put(h)
 }
 
@@ -134,14 +140,20 @@ func (h *hasher) hashChildren(original node, db DatabaseWriter, path []byte) (no
 		// Hash the full node's children, caching the newly hashed subtrees
 		collapsed, cached := n.copy(), n.copy()
 
-		for i := 0; i < 16; i++ {
-			if n.Children[i] != nil {
-				collapsed.Children[i], cached.Children[i], err = h.hash(n.Children[i], db, append(path, byte(i)), false)
-				if err != nil {
-					return original, original, err
+		if h.parallel && len(path) == 0 {
+			if err := hashChildrenParallel(n, collapsed, cached); err != nil {
+				return original, original, err
+			}
+		} else {
+			for i := 0; i < 16; i++ {
+				if n.Children[i] != nil {
+					collapsed.Children[i], cached.Children[i], err = h.hash(n.Children[i], db, append(path, byte(i)), false)
+					if err != nil {
+						return original, original, err
+					}
+				} else {
+					collapsed.Children[i] = valueNode(nil) // Ensure that nil children are encoded as empty strings.
 				}
-			} else {
-				collapsed.Children[i] = valueNode(nil) // Ensure that nil children are encoded as empty strings.
 			}
 		}
 		cached.Children[16] = n.Children[16]
@@ -156,6 +168,36 @@ func (h *hasher) hashChildren(original node, db DatabaseWriter, path []byte) (no
 	}
 }
 
+// hashChildrenParallel hashes children of the root full node on separate goroutines.
+// It's only for hashing without writing to db.
+func hashChildrenParallel(n, collapsed, cached *fullNode) error {
+	var (
+		wg   sync.WaitGroup
+		errs [16]error
+	)
+	for i := 0; i < 16; i++ {
+		if n.Children[i] == nil {
+			collapsed.Children[i] = valueNode(nil) // Ensure that nil children are encoded as empty strings.
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			h := newHasher()
+			defer returnHasherToPool(h)
+			collapsed.Children[i], cached.Children[i], errs[i] = h.hash(n.Children[i], nil, []byte{byte(i)}, false)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h *hasher) store(n node, db DatabaseWriter, path []byte, force bool) (node, error) {
 	// Don't store hashes or empty nodes.
 	if _, isHash := n.(hashNode); n == nil || isHash {
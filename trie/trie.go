@@ -82,6 +82,9 @@ type Trie struct {
 	root         node
 	db           Database
 	originalRoot thor.Bytes32
+
+	// count of updates since last hashing, used to decide whether to hash in parallel
+	unhashed int
 }
 
 // newFlag returns the cache flag value for a newly created node.
@@ -195,6 +198,7 @@ func (t *Trie) Update(key, value []byte) {
 //
 // If a node was not found in the database, a MissingNodeError is returned.
 func (t *Trie) TryUpdate(key, value []byte) error {
+	t.unhashed++
 	k := keybytesToHex(key)
 	if len(value) != 0 {
 		_, n, err := t.insert(t.root, nil, k, valueNode(value))
@@ -291,6 +295,7 @@ func (t *Trie) Delete(key []byte) {
 // TryDelete removes any existing value for key from the trie.
 // If a node was not found in the database, a MissingNodeError is returned.
 func (t *Trie) TryDelete(key []byte) error {
+	t.unhashed++
 	k := keybytesToHex(key)
 	_, n, err := t.delete(t.root, nil, k)
 	if err != nil {
@@ -490,6 +495,10 @@ func (t *Trie) Commit() (root thor.Bytes32, err error) {
 // the changes made to db are written back to the trie's attached
 // database before using the trie.
 func (t *Trie) CommitTo(db DatabaseWriter) (root thor.Bytes32, err error) {
+	if t.unhashed >= parallelHashThreshold {
+		// hash subtrees in parallel ahead, then nodes are written with cached hashes
+		t.Hash()
+	}
 	hash, cached, err := t.hashRoot(db)
 	if err != nil {
 		return (thor.Bytes32{}), err
@@ -504,5 +513,12 @@ func (t *Trie) hashRoot(db DatabaseWriter) (node, node, error) {
 	}
 	h := newHasher()
 	defer returnHasherToPool(h)
-	return h.hash(t.root, db, nil, true)
+	// db writers are not assumed to be thread-safe, so only parallelize pure hashing
+	h.parallel = db == nil && t.unhashed >= parallelHashThreshold
+
+	hash, cached, err := h.hash(t.root, db, nil, true)
+	if err == nil {
+		t.unhashed = 0
+	}
+	return hash, cached, err
 }
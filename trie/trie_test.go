@@ -700,3 +700,41 @@ func TestDatabaseEx(t *testing.T) {
 	tr, _ = New(root, db)
 	doIter()
 }
+
+func TestParallelHash(t *testing.T) {
+	var (
+		serial   = newEmpty()
+		parallel = newEmpty()
+	)
+	for i := 0; i < parallelHashThreshold*5; i++ {
+		k := crypto.Keccak256([]byte(fmt.Sprint(i)))
+		v := []byte(fmt.Sprint(i))
+		serial.Update(k, v)
+		// hash on every update to keep serial hashing
+		serial.Hash()
+		parallel.Update(k, v)
+	}
+	if parallel.unhashed < parallelHashThreshold {
+		t.Fatalf("unhashed count %v should reach the threshold", parallel.unhashed)
+	}
+
+	if s, p := serial.Hash(), parallel.Hash(); s != p {
+		t.Errorf("hash mismatch: serial %v, parallel %v", s, p)
+	}
+	if parallel.unhashed != 0 {
+		t.Errorf("unhashed count should be reset")
+	}
+
+	for i := 0; i < parallelHashThreshold; i++ {
+		parallel.Delete(crypto.Keccak256([]byte(fmt.Sprint(i))))
+		serial.Delete(crypto.Keccak256([]byte(fmt.Sprint(i))))
+	}
+	sroot, _ := serial.Commit()
+	proot, err := parallel.Commit()
+	if err != nil {
+		t.Fatalf("commit failed %v", err)
+	}
+	if sroot != proot {
+		t.Errorf("root mismatch: serial %v, parallel %v", sroot, proot)
+	}
+}
@@ -13,9 +13,16 @@ import (
 
 // Builder to make it easy to build transaction.
 type Builder struct {
+	typ  byte
 	body body
 }
 
+// Type set tx type, which defaults to TypeLegacy. The type should be supported, see IsTypeSupported.
+func (b *Builder) Type(typ byte) *Builder {
+	b.typ = typ
+	return b
+}
+
 // ChainTag set chain tag.
 func (b *Builder) ChainTag(tag byte) *Builder {
 	b.body.ChainTag = tag
@@ -77,6 +84,6 @@ func (b *Builder) Features(feat Features) *Builder {
 
 // Build build tx object.
 func (b *Builder) Build() *Transaction {
-	tx := Transaction{body: b.body}
+	tx := Transaction{typ: b.typ, body: b.body}
 	return &tx
 }
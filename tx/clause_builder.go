@@ -0,0 +1,61 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/thor"
+)
+
+// ClauseBuilder to make it easy to build a clause, with calldata encoded by ABI.
+type ClauseBuilder struct {
+	clause *Clause
+	err    error
+}
+
+// NewClauseBuilder create a clause builder. The 'to' address is nil for contract creation.
+func NewClauseBuilder(to *thor.Address) *ClauseBuilder {
+	return &ClauseBuilder{clause: NewClause(to)}
+}
+
+// Value set value of VET to transfer.
+func (b *ClauseBuilder) Value(value *big.Int) *ClauseBuilder {
+	b.clause = b.clause.WithValue(value)
+	return b
+}
+
+// Data set raw calldata.
+func (b *ClauseBuilder) Data(data []byte) *ClauseBuilder {
+	b.clause = b.clause.WithData(data)
+	return b
+}
+
+// Method set calldata to call the named method with args, encoded by the ABI.
+// The error of encoding is deferred to Build.
+func (b *ClauseBuilder) Method(abi *abi.ABI, name string, args ...interface{}) *ClauseBuilder {
+	method, found := abi.MethodByName(name)
+	if !found {
+		b.err = fmt.Errorf("method %v not found", name)
+		return b
+	}
+	data, err := method.EncodeInput(args...)
+	if err != nil {
+		b.err = fmt.Errorf("encode input of %v: %v", name, err)
+		return b
+	}
+	return b.Data(data)
+}
+
+// Build build the clause.
+func (b *ClauseBuilder) Build() (*Clause, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.clause, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestClauseBuilder(t *testing.T) {
+	erc20, err := abi.New([]byte(`[{"constant":false,"inputs":[{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transfer","outputs":[{"name":"success","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"}]`))
+	assert.Nil(t, err)
+
+	to := thor.BytesToAddress([]byte("token"))
+	recipient := thor.BytesToAddress([]byte("recipient"))
+
+	clause, err := tx.NewClauseBuilder(&to).
+		Method(erc20, "transfer", recipient, big.NewInt(100)).
+		Value(big.NewInt(1)).
+		Build()
+	assert.Nil(t, err)
+
+	method, _ := erc20.MethodByName("transfer")
+	data, _ := method.EncodeInput(recipient, big.NewInt(100))
+	assert.Equal(t, &to, clause.To())
+	assert.Equal(t, big.NewInt(1), clause.Value())
+	assert.Equal(t, data, clause.Data())
+
+	_, err = tx.NewClauseBuilder(&to).Method(erc20, "approve").Build()
+	assert.EqualError(t, err, "method approve not found")
+
+	_, err = tx.NewClauseBuilder(&to).Method(erc20, "transfer", recipient).Build()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Tx types.
+//
+// A legacy tx is encoded as a RLP list of its body, which stays as it is.
+// Any other tx type is wrapped in an envelope, a RLP string of the type byte
+// followed by the non-empty type specific payload. Since a RLP list never decodes
+// as a string, typed txs can be introduced without breaking decoding of legacy txs.
+//
+// Type bytes of typed txs are in [MinTxType, MaxTxType], and an envelope is at least
+// two bytes long, so an encoded tx of any type always starts with a byte >= 0x80.
+// Bytes below 0x80 are left for tags of storage codecs, see block.Codec.
+const (
+	TypeLegacy = byte(0)
+
+	MinTxType = byte(0x01)
+	MaxTxType = byte(0x7f)
+)
+
+// ErrTxTypeNotSupported is returned when decoding a typed tx of unknown type.
+var ErrTxTypeNotSupported = errors.New("tx type not supported")
+
+// typeCodec encodes and decodes the envelope payload of a tx type.
+type typeCodec interface {
+	encode(body *body) ([]byte, error)
+	decode(data []byte, body *body) error
+}
+
+// typeCodecs the registry of tx types, indexed by type byte.
+var typeCodecs = make(map[byte]typeCodec)
+
+// registerType registers the codec of a tx type. It panics if the type byte is out of range,
+// or already registered.
+func registerType(typ byte, codec typeCodec) {
+	if typ < MinTxType || typ > MaxTxType {
+		panic(fmt.Sprintf("tx type %v out of range", typ))
+	}
+	if _, ok := typeCodecs[typ]; ok {
+		panic(fmt.Sprintf("tx type %v already registered", typ))
+	}
+	typeCodecs[typ] = codec
+}
+
+// IsTypeSupported returns whether the tx type can be encoded and decoded.
+func IsTypeSupported(typ byte) bool {
+	if typ == TypeLegacy {
+		return true
+	}
+	_, ok := typeCodecs[typ]
+	return ok
+}
+
+// Type returns the type of tx.
+func (t *Transaction) Type() byte {
+	return t.typ
+}
+
+// encodeEnvelope encodes the typed tx into an envelope.
+func (t *Transaction) encodeEnvelope() ([]byte, error) {
+	codec, ok := typeCodecs[t.typ]
+	if !ok {
+		return nil, ErrTxTypeNotSupported
+	}
+	payload, err := codec.encode(&t.body)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) == 0 {
+		return nil, errors.New("empty typed tx payload")
+	}
+	return append([]byte{t.typ}, payload...), nil
+}
+
+// decodeEnvelope decodes a typed tx envelope.
+func (t *Transaction) decodeEnvelope(s *rlp.Stream) error {
+	data, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	if len(data) < 2 {
+		return errors.New("typed tx too short")
+	}
+	typ := data[0]
+	if typ == TypeLegacy {
+		return errors.New("legacy tx must not be enveloped")
+	}
+	codec, ok := typeCodecs[typ]
+	if !ok {
+		return ErrTxTypeNotSupported
+	}
+	var body body
+	if err := codec.decode(data[1:], &body); err != nil {
+		return err
+	}
+	*t = Transaction{typ: typ, body: body}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestEnvelope(t *testing.T) {
+	trx := new(tx.Builder).ChainTag(1).Nonce(1).Build()
+	assert.Equal(t, tx.TypeLegacy, trx.Type())
+
+	// legacy tx encoding is untouched
+	data, _ := rlp.EncodeToBytes(trx)
+	assert.True(t, data[0] >= 0xc0)
+
+	var decoded tx.Transaction
+	assert.Nil(t, rlp.DecodeBytes(data, &decoded))
+	assert.Equal(t, trx.SigningHash(), decoded.SigningHash())
+
+	enveloped, _ := rlp.EncodeToBytes(append([]byte{tx.MaxTxType - 1}, data...))
+	assert.Equal(t, tx.ErrTxTypeNotSupported, rlp.DecodeBytes(enveloped, &decoded))
+
+	enveloped, _ = rlp.EncodeToBytes(append([]byte{tx.TypeLegacy}, data...))
+	assert.NotNil(t, rlp.DecodeBytes(enveloped, &decoded))
+
+	enveloped, _ = rlp.EncodeToBytes([]byte{tx.TypeForTest})
+	assert.NotNil(t, rlp.DecodeBytes(enveloped, &decoded))
+
+	assert.True(t, tx.IsTypeSupported(tx.TypeLegacy))
+	assert.True(t, tx.IsTypeSupported(tx.TypeForTest))
+	assert.False(t, tx.IsTypeSupported(tx.MaxTxType-1))
+}
+
+func TestTypedTx(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	build := func(typ byte) *tx.Transaction {
+		trx := new(tx.Builder).Type(typ).ChainTag(1).Gas(21000).Nonce(1).
+			Clause(tx.NewClause(&thor.Address{})).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+		return trx.WithSignature(sig)
+	}
+	legacy := build(tx.TypeLegacy)
+	typed := build(tx.TypeForTest)
+	assert.Equal(t, tx.TypeForTest, typed.Type())
+
+	// the type is committed
+	assert.NotEqual(t, legacy.SigningHash(), typed.SigningHash())
+	assert.NotEqual(t, legacy.ID(), typed.ID())
+
+	data, err := rlp.EncodeToBytes(typed)
+	assert.Nil(t, err)
+	// starts with a string header, above storage codec formats
+	assert.True(t, data[0] >= 0x80 && data[0] < 0xc0)
+
+	var decoded tx.Transaction
+	assert.Nil(t, rlp.DecodeBytes(data, &decoded))
+	assert.Equal(t, tx.TypeForTest, decoded.Type())
+	assert.Equal(t, typed.ID(), decoded.ID())
+	assert.Equal(t, typed.Size(), decoded.Size())
+	origin, _ := decoded.Origin()
+	assert.Equal(t, thor.Address(crypto.PubkeyToAddress(key.PublicKey)), origin)
+
+	// unsupported type can't be encoded
+	_, err = rlp.EncodeToBytes(new(tx.Builder).Type(tx.MaxTxType - 1).Build())
+	assert.Equal(t, tx.ErrTxTypeNotSupported, err)
+
+	// json
+	j, _ := json.Marshal(typed)
+	var fromJSON tx.Transaction
+	assert.Nil(t, json.Unmarshal(j, &fromJSON))
+	assert.Equal(t, typed.ID(), fromJSON.ID())
+	assert.Equal(t, tx.TypeForTest, fromJSON.Type())
+}
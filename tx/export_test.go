@@ -0,0 +1,25 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import "github.com/ethereum/go-ethereum/rlp"
+
+// TypeForTest is a tx type registered only in tests, with the body as payload.
+const TypeForTest = MaxTxType
+
+type testTypeCodec struct{}
+
+func (testTypeCodec) encode(body *body) ([]byte, error) {
+	return rlp.EncodeToBytes(body)
+}
+
+func (testTypeCodec) decode(data []byte, body *body) error {
+	return rlp.DecodeBytes(data, body)
+}
+
+func init() {
+	registerType(TypeForTest, testTypeCodec{})
+}
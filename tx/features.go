@@ -6,6 +6,8 @@ type Features uint32
 const (
 	// DelegationFeature See VIP-191 for more detail. (https://github.com/vechain/VIPs/blob/master/vips/VIP-191.md)
 	DelegationFeature Features = 1
+	// NonAtomicFeature lets clauses of tx be executed independently, a failed clause doesn't revert the others.
+	NonAtomicFeature Features = 2
 )
 
 // IsDelegated returns whether tx is delegated.
@@ -21,3 +23,17 @@ func (f *Features) SetDelegated(flag bool) {
 		*f &= (^DelegationFeature)
 	}
 }
+
+// IsNonAtomic returns whether clauses of tx are executed non-atomically.
+func (f Features) IsNonAtomic() bool {
+	return (f & NonAtomicFeature) == NonAtomicFeature
+}
+
+// SetNonAtomic set tx non-atomic flag.
+func (f *Features) SetNonAtomic(flag bool) {
+	if flag {
+		*f |= NonAtomicFeature
+	} else {
+		*f &= (^NonAtomicFeature)
+	}
+}
@@ -22,3 +22,15 @@ func TestFeatures(t *testing.T) {
 	f.SetDelegated(false)
 	assert.False(t, f.IsDelegated())
 }
+
+func TestNonAtomicFeature(t *testing.T) {
+	var f tx.Features
+	assert.False(t, f.IsNonAtomic())
+
+	f.SetNonAtomic(true)
+	assert.True(t, f.IsNonAtomic())
+	assert.False(t, f.IsDelegated())
+
+	f.SetNonAtomic(false)
+	assert.Zero(t, f)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+)
+
+type clauseJSON struct {
+	To    *thor.Address `json:"to"`
+	Value *hexutil.Big  `json:"value"`
+	Data  hexutil.Bytes `json:"data"`
+}
+
+// txJSON is the canonical json form of tx.
+// The id field is derived, and verified when unmarshaling.
+type txJSON struct {
+	ID           thor.Bytes32    `json:"id"`
+	Type         hexutil.Uint64  `json:"type,omitempty"`
+	ChainTag     hexutil.Uint64  `json:"chainTag"`
+	BlockRef     hexutil.Bytes   `json:"blockRef"`
+	Expiration   hexutil.Uint64  `json:"expiration"`
+	Clauses      []clauseJSON    `json:"clauses"`
+	GasPriceCoef hexutil.Uint64  `json:"gasPriceCoef"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	DependsOn    *thor.Bytes32   `json:"dependsOn"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	Features     hexutil.Uint64  `json:"features"`
+	Reserved     []hexutil.Bytes `json:"reserved,omitempty"`
+	Signature    hexutil.Bytes   `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *Transaction) MarshalJSON() ([]byte, error) {
+	clauses := make([]clauseJSON, 0, len(t.body.Clauses))
+	for _, c := range t.body.Clauses {
+		clauses = append(clauses, clauseJSON{
+			c.body.To,
+			(*hexutil.Big)(c.body.Value),
+			c.body.Data,
+		})
+	}
+	var reserved []hexutil.Bytes
+	for _, raw := range t.body.Reserved.Unused {
+		reserved = append(reserved, hexutil.Bytes(raw))
+	}
+	blockRef := t.BlockRef()
+
+	return json.Marshal(&txJSON{
+		ID:           t.ID(),
+		Type:         hexutil.Uint64(t.typ),
+		ChainTag:     hexutil.Uint64(t.body.ChainTag),
+		BlockRef:     blockRef[:],
+		Expiration:   hexutil.Uint64(t.body.Expiration),
+		Clauses:      clauses,
+		GasPriceCoef: hexutil.Uint64(t.body.GasPriceCoef),
+		Gas:          hexutil.Uint64(t.body.Gas),
+		DependsOn:    t.body.DependsOn,
+		Nonce:        hexutil.Uint64(t.body.Nonce),
+		Features:     hexutil.Uint64(t.body.Reserved.Features),
+		Reserved:     reserved,
+		Signature:    t.body.Signature,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	var obj txJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	if obj.Type > math.MaxUint8 || !IsTypeSupported(byte(obj.Type)) {
+		return ErrTxTypeNotSupported
+	}
+	if obj.ChainTag > math.MaxUint8 {
+		return errors.New("chainTag overflow")
+	}
+	if len(obj.BlockRef) != 8 {
+		return errors.New("blockRef should be 8 bytes")
+	}
+	if obj.Expiration > math.MaxUint32 {
+		return errors.New("expiration overflow")
+	}
+	if obj.GasPriceCoef > math.MaxUint8 {
+		return errors.New("gasPriceCoef overflow")
+	}
+	if obj.Features > math.MaxUint32 {
+		return errors.New("features overflow")
+	}
+
+	clauses := make([]*Clause, 0, len(obj.Clauses))
+	for _, c := range obj.Clauses {
+		value := (*big.Int)(c.Value)
+		if value == nil {
+			value = &big.Int{}
+		} else if value.Sign() < 0 {
+			return errors.New("clause value should not be negative")
+		}
+		clauses = append(clauses, &Clause{clauseBody{c.To, value, c.Data}})
+	}
+	var unused []rlp.RawValue
+	for _, raw := range obj.Reserved {
+		unused = append(unused, rlp.RawValue(raw))
+	}
+
+	tx := Transaction{typ: byte(obj.Type), body: body{
+		ChainTag:     byte(obj.ChainTag),
+		BlockRef:     binary.BigEndian.Uint64(obj.BlockRef),
+		Expiration:   uint32(obj.Expiration),
+		Clauses:      clauses,
+		GasPriceCoef: uint8(obj.GasPriceCoef),
+		Gas:          uint64(obj.Gas),
+		DependsOn:    obj.DependsOn,
+		Nonce:        uint64(obj.Nonce),
+		Reserved:     reserved{Features(obj.Features), unused},
+		Signature:    obj.Signature,
+	}}
+	if tx.ID() != obj.ID {
+		return errors.New("id mismatch")
+	}
+	*t = Transaction{typ: tx.typ, body: tx.body}
+	return nil
+}
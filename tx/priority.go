@@ -0,0 +1,31 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/vechain/thor/thor"
+)
+
+// SortByPriority sorts txs in the order they are packed, by overall gas price from high to low.
+// Txs of the same price keep their relative order, so txs are expected to be in arrival order.
+// Proved work is evaluated against the chain identified by getBlockID, as of block headBlockNum.
+func (txs Transactions) SortByPriority(baseGasPrice *big.Int, headBlockNum uint32, getBlockID func(uint32) (thor.Bytes32, error)) error {
+	prices := make(map[*Transaction]*big.Int, len(txs))
+	for _, tx := range txs {
+		provedWork, err := tx.ProvedWork(headBlockNum, getBlockID)
+		if err != nil {
+			return err
+		}
+		prices[tx] = tx.OverallGasPrice(baseGasPrice, provedWork)
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return prices[txs[i]].Cmp(prices[txs[j]]) > 0
+	})
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestSortByPriority(t *testing.T) {
+	newTx := func(coef uint8, nonce uint64) *tx.Transaction {
+		return new(tx.Builder).GasPriceCoef(coef).Gas(21000).Nonce(nonce).Build()
+	}
+	getBlockID := func(uint32) (thor.Bytes32, error) { return thor.Bytes32{}, nil }
+
+	var (
+		tx1 = newTx(10, 1)
+		tx2 = newTx(20, 2)
+		tx3 = newTx(10, 3)
+		tx4 = newTx(30, 4)
+	)
+	txs := tx.Transactions{tx1, tx2, tx3, tx4}
+	assert.Nil(t, txs.SortByPriority(big.NewInt(1000), 0, getBlockID))
+	assert.Equal(t, tx.Transactions{tx4, tx2, tx1, tx3}, txs)
+
+	// proved work requires block id
+	txs = tx.Transactions{new(tx.Builder).BlockRef(tx.NewBlockRef(1)).Build()}
+	assert.NotNil(t, txs.SortByPriority(big.NewInt(1000), 10, func(uint32) (thor.Bytes32, error) {
+		return thor.Bytes32{}, errors.New("not found")
+	}))
+}
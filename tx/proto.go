@@ -0,0 +1,153 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/vechain/thor/internal/protoenc"
+	"github.com/vechain/thor/thor"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// field numbers defined in tx.proto
+const (
+	pbClauseTo    = 1
+	pbClauseValue = 2
+	pbClauseData  = 3
+
+	pbTxChainTag     = 1
+	pbTxBlockRef     = 2
+	pbTxExpiration   = 3
+	pbTxClauses      = 4
+	pbTxGasPriceCoef = 5
+	pbTxGas          = 6
+	pbTxDependsOn    = 7
+	pbTxNonce        = 8
+	pbTxFeatures     = 9
+	pbTxSignature    = 10
+	pbTxType         = 11
+)
+
+func (c *Clause) marshalProto() []byte {
+	var b []byte
+	if c.body.To != nil {
+		b = protoenc.AppendBytesField(b, pbClauseTo, c.body.To.Bytes())
+	}
+	b = protoenc.AppendBytesField(b, pbClauseValue, c.body.Value.Bytes())
+	return protoenc.AppendBytesField(b, pbClauseData, c.body.Data)
+}
+
+func (c *Clause) unmarshalProto(data []byte) error {
+	body := clauseBody{Value: &big.Int{}}
+	if err := protoenc.ConsumeFields(data, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case pbClauseTo:
+			if len(data) != 20 {
+				return errors.New("clause to should be 20 bytes")
+			}
+			to := thor.BytesToAddress(data)
+			body.To = &to
+		case pbClauseValue:
+			body.Value = new(big.Int).SetBytes(data)
+		case pbClauseData:
+			body.Data = append([]byte(nil), data...)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	*c = Clause{body}
+	return nil
+}
+
+// MarshalProto encodes the tx in protobuf format, as defined in tx.proto.
+// Txs with unused reserved fields can't be encoded, since they are not defined in tx.proto.
+func (t *Transaction) MarshalProto() ([]byte, error) {
+	if len(t.body.Reserved.Unused) > 0 {
+		return nil, errors.New("unused reserved fields not supported by protobuf")
+	}
+	var b []byte
+	b = protoenc.AppendVarintField(b, pbTxChainTag, uint64(t.body.ChainTag))
+	b = protoenc.AppendVarintField(b, pbTxBlockRef, t.body.BlockRef)
+	b = protoenc.AppendVarintField(b, pbTxExpiration, uint64(t.body.Expiration))
+	for _, c := range t.body.Clauses {
+		b = protoenc.AppendRepeatedBytesField(b, pbTxClauses, c.marshalProto())
+	}
+	b = protoenc.AppendVarintField(b, pbTxGasPriceCoef, uint64(t.body.GasPriceCoef))
+	b = protoenc.AppendVarintField(b, pbTxGas, t.body.Gas)
+	if t.body.DependsOn != nil {
+		b = protoenc.AppendBytesField(b, pbTxDependsOn, t.body.DependsOn.Bytes())
+	}
+	b = protoenc.AppendVarintField(b, pbTxNonce, t.body.Nonce)
+	b = protoenc.AppendVarintField(b, pbTxFeatures, uint64(t.body.Reserved.Features))
+	b = protoenc.AppendBytesField(b, pbTxSignature, t.body.Signature)
+	return protoenc.AppendVarintField(b, pbTxType, uint64(t.typ)), nil
+}
+
+// UnmarshalProto decodes the tx from protobuf format.
+func (t *Transaction) UnmarshalProto(data []byte) error {
+	var (
+		typ  byte
+		body body
+	)
+	if err := protoenc.ConsumeFields(data, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case pbTxType:
+			if v > math.MaxUint8 || !IsTypeSupported(byte(v)) {
+				return ErrTxTypeNotSupported
+			}
+			typ = byte(v)
+		case pbTxChainTag:
+			if v > math.MaxUint8 {
+				return errors.New("chain tag overflow")
+			}
+			body.ChainTag = byte(v)
+		case pbTxBlockRef:
+			body.BlockRef = v
+		case pbTxExpiration:
+			if v > math.MaxUint32 {
+				return errors.New("expiration overflow")
+			}
+			body.Expiration = uint32(v)
+		case pbTxClauses:
+			var c Clause
+			if err := c.unmarshalProto(data); err != nil {
+				return err
+			}
+			body.Clauses = append(body.Clauses, &c)
+		case pbTxGasPriceCoef:
+			if v > math.MaxUint8 {
+				return errors.New("gas price coef overflow")
+			}
+			body.GasPriceCoef = uint8(v)
+		case pbTxGas:
+			body.Gas = v
+		case pbTxDependsOn:
+			if len(data) != 32 {
+				return errors.New("depends on should be 32 bytes")
+			}
+			dep := thor.BytesToBytes32(data)
+			body.DependsOn = &dep
+		case pbTxNonce:
+			body.Nonce = v
+		case pbTxFeatures:
+			if v > math.MaxUint32 {
+				return errors.New("features overflow")
+			}
+			body.Reserved.Features = Features(v)
+		case pbTxSignature:
+			body.Signature = append([]byte(nil), data...)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	*t = Transaction{typ: typ, body: body}
+	return nil
+}
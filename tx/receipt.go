@@ -27,6 +27,9 @@ type Receipt struct {
 	Reverted bool
 	// outputs of clauses in tx
 	Outputs []*Output
+	// indexes of reverted clauses of non-atomic tx, whose outputs are left empty.
+	// Tail of the list, so receipts of atomic txs are encoded as before.
+	RevertedClauses []uint32 `rlp:"tail"`
 }
 
 // Output output of clause execution.
@@ -0,0 +1,45 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"github.com/vechain/thor/thor/bloom"
+)
+
+// ReceiptBloomBitsPerKey bits per key of receipt bloom filters.
+const ReceiptBloomBitsPerKey = 20
+
+// Bloom returns the bloom filter of the receipt, which contains
+// addresses and topics of events, senders and recipients of transfers.
+func (r *Receipt) Bloom() *bloom.Filter {
+	var g bloom.Generator
+	r.addBloomKeys(&g)
+	return g.Generate(ReceiptBloomBitsPerKey, bloom.K(ReceiptBloomBitsPerKey))
+}
+
+func (r *Receipt) addBloomKeys(g *bloom.Generator) {
+	for _, output := range r.Outputs {
+		for _, event := range output.Events {
+			g.Add(event.Address.Bytes())
+			for _, topic := range event.Topics {
+				g.Add(topic.Bytes())
+			}
+		}
+		for _, transfer := range output.Transfers {
+			g.Add(transfer.Sender.Bytes())
+			g.Add(transfer.Recipient.Bytes())
+		}
+	}
+}
+
+// Bloom returns the bloom filter aggregated from all receipts.
+func (rs Receipts) Bloom() *bloom.Filter {
+	var g bloom.Generator
+	for _, r := range rs {
+		r.addBloomKeys(&g)
+	}
+	return g.Generate(ReceiptBloomBitsPerKey, bloom.K(ReceiptBloomBitsPerKey))
+}
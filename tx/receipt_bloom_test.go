@@ -0,0 +1,43 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestReceiptBloom(t *testing.T) {
+	var (
+		contract  = thor.BytesToAddress([]byte("contract"))
+		topic     = thor.BytesToBytes32([]byte("topic"))
+		sender    = thor.BytesToAddress([]byte("sender"))
+		recipient = thor.BytesToAddress([]byte("recipient"))
+	)
+	r1 := &tx.Receipt{Outputs: []*tx.Output{{
+		Events: tx.Events{{Address: contract, Topics: []thor.Bytes32{topic}}},
+	}}}
+	r2 := &tx.Receipt{Outputs: []*tx.Output{{
+		Transfers: tx.Transfers{{Sender: sender, Recipient: recipient, Amount: big.NewInt(1)}},
+	}}}
+
+	b1 := r1.Bloom()
+	assert.True(t, b1.Contains(contract.Bytes()))
+	assert.True(t, b1.Contains(topic.Bytes()))
+
+	b2 := r2.Bloom()
+	assert.True(t, b2.Contains(sender.Bytes()))
+	assert.True(t, b2.Contains(recipient.Bytes()))
+
+	agg := tx.Receipts{r1, r2}.Bloom()
+	for _, key := range [][]byte{contract.Bytes(), topic.Bytes(), sender.Bytes(), recipient.Bytes()} {
+		assert.True(t, agg.Contains(key))
+	}
+}
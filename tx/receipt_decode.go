@@ -0,0 +1,46 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"fmt"
+
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/thor"
+)
+
+// DecodedEvent is an event decoded against contract ABI.
+type DecodedEvent struct {
+	Address thor.Address
+	Name    string
+	Args    []*abi.EventArg
+}
+
+// DecodeOutputs decodes events of each output against ABIs of contracts.
+// The result is aligned with Outputs and their Events. An event is left nil
+// if ABI of its contract is not given, or it's not defined in the ABI.
+func (r *Receipt) DecodeOutputs(abis map[thor.Address]*abi.ABI) ([][]*DecodedEvent, error) {
+	decoded := make([][]*DecodedEvent, len(r.Outputs))
+	for i, output := range r.Outputs {
+		decoded[i] = make([]*DecodedEvent, len(output.Events))
+		for j, event := range output.Events {
+			contractABI := abis[event.Address]
+			if contractABI == nil || len(event.Topics) == 0 {
+				continue
+			}
+			abiEvent, found := contractABI.EventByID(event.Topics[0])
+			if !found {
+				continue
+			}
+			args, err := abiEvent.DecodeArgs(event.Topics, event.Data)
+			if err != nil {
+				return nil, fmt.Errorf("output #%v event #%v: %v", i, j, err)
+			}
+			decoded[i][j] = &DecodedEvent{event.Address, abiEvent.Name(), args}
+		}
+	}
+	return decoded, nil
+}
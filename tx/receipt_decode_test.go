@@ -0,0 +1,80 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+const testEventsABI = `[
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Memo","anonymous":false,"inputs":[
+		{"name":"tag","type":"string","indexed":true},
+		{"name":"data","type":"bytes","indexed":false}]}
+]`
+
+func TestReceiptDecodeOutputs(t *testing.T) {
+	contractABI, err := abi.New([]byte(testEventsABI))
+	assert.Nil(t, err)
+
+	var (
+		contract = thor.BytesToAddress([]byte("contract"))
+		unknown  = thor.BytesToAddress([]byte("unknown"))
+		from     = thor.BytesToAddress([]byte("from"))
+		to       = thor.BytesToAddress([]byte("to"))
+		tagHash  = thor.Bytes32(crypto.Keccak256Hash([]byte("tag")))
+	)
+	transfer, _ := contractABI.EventByName("Transfer")
+	memo, _ := contractABI.EventByName("Memo")
+
+	transferData, _ := transfer.Encode(big.NewInt(100))
+	memoData, _ := memo.Encode([]byte("hello"))
+
+	receipt := &tx.Receipt{Outputs: []*tx.Output{
+		{Events: tx.Events{
+			{Address: contract, Topics: []thor.Bytes32{transfer.ID(), thor.BytesToBytes32(from.Bytes()), thor.BytesToBytes32(to.Bytes())}, Data: transferData},
+			{Address: unknown, Topics: []thor.Bytes32{transfer.ID()}},
+		}},
+		{Events: tx.Events{
+			{Address: contract, Topics: []thor.Bytes32{memo.ID(), tagHash}, Data: memoData},
+		}},
+	}}
+
+	decoded, err := receipt.DecodeOutputs(map[thor.Address]*abi.ABI{contract: contractABI})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]*tx.DecodedEvent{
+		{
+			{contract, "Transfer", []*abi.EventArg{
+				{Name: "from", Indexed: true, Value: common.Address(from)},
+				{Name: "to", Indexed: true, Value: common.Address(to)},
+				{Name: "value", Value: big.NewInt(100)},
+			}},
+			nil,
+		},
+		{
+			{contract, "Memo", []*abi.EventArg{
+				{Name: "tag", Indexed: true, Value: tagHash},
+				{Name: "data", Value: []byte("hello")},
+			}},
+		},
+	}, decoded)
+
+	// missing indexed topic
+	receipt.Outputs[0].Events[0].Topics = receipt.Outputs[0].Events[0].Topics[:2]
+	_, err = receipt.DecodeOutputs(map[thor.Address]*abi.ABI{contract: contractABI})
+	assert.NotNil(t, err)
+}
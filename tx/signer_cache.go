@@ -0,0 +1,35 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/vechain/thor/thor"
+)
+
+const signerCacheSize = 16384
+
+// signerCache caches recovered signers across tx instances, since the same tx
+// is usually decoded several times (from p2p, pool and block).
+// The tx ID can't be the key, as it's derived from the signer, so it's keyed by
+// the signing hash and signature.
+var signerCache, _ = lru.New(signerCacheSize)
+
+func recoverSigner(signingHash thor.Bytes32, sig []byte) (thor.Address, error) {
+	key := string(signingHash[:]) + string(sig)
+	if cached, ok := signerCache.Get(key); ok {
+		return cached.(thor.Address), nil
+	}
+
+	pub, err := crypto.SigToPub(signingHash[:], sig)
+	if err != nil {
+		return thor.Address{}, err
+	}
+	signer := thor.Address(crypto.PubkeyToAddress(*pub))
+	signerCache.Add(key, signer)
+	return signer, nil
+}
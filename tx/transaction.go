@@ -29,6 +29,7 @@ var (
 
 // Transaction is an immutable tx type.
 type Transaction struct {
+	typ  byte
 	body body
 
 	cache struct {
@@ -170,6 +171,10 @@ func (t *Transaction) SigningHash() (hash thor.Bytes32) {
 	defer func() { t.cache.signingHash.Store(hash) }()
 
 	hw := thor.NewBlake2b()
+	// typed txs commit the type, so legacy txs hash as before
+	if t.typ != TypeLegacy {
+		hw.Write([]byte{t.typ})
+	}
 	rlp.Encode(hw, []interface{}{
 		t.body.ChainTag,
 		t.body.BlockRef,
@@ -230,11 +235,10 @@ func (t *Transaction) Origin() (thor.Address, error) {
 		return cached.(thor.Address), nil
 	}
 
-	pub, err := crypto.SigToPub(t.SigningHash().Bytes(), t.body.Signature[:65])
+	origin, err := recoverSigner(t.SigningHash(), t.body.Signature[:65])
 	if err != nil {
 		return thor.Address{}, err
 	}
-	origin := thor.Address(crypto.PubkeyToAddress(*pub))
 	t.cache.origin.Store(origin)
 	return origin, nil
 }
@@ -284,6 +288,7 @@ func (t *Transaction) Delegator() (*thor.Address, error) {
 // For delegated tx, sig is joined with signatures of originator and delegator.
 func (t *Transaction) WithSignature(sig []byte) *Transaction {
 	newTx := Transaction{
+		typ:  t.typ,
 		body: t.body,
 	}
 	// copy sig
@@ -307,12 +312,26 @@ func (t *Transaction) TestFeatures(supported Features) error {
 
 // EncodeRLP implements rlp.Encoder
 func (t *Transaction) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &t.body)
+	if t.typ == TypeLegacy {
+		return rlp.Encode(w, &t.body)
+	}
+	data, err := t.encodeEnvelope()
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, data)
 }
 
 // DecodeRLP implements rlp.Decoder
 func (t *Transaction) DecodeRLP(s *rlp.Stream) error {
-	_, size, _ := s.Kind()
+	kind, size, err := s.Kind()
+	if err != nil {
+		return err
+	}
+	if kind != rlp.List {
+		return t.decodeEnvelope(s)
+	}
+
 	var body body
 	if err := s.Decode(&body); err != nil {
 		return err
@@ -453,7 +472,9 @@ func (t *Transaction) validateSignatureLength() error {
 	return nil
 }
 
-// IntrinsicGas calculate intrinsic gas cost for tx with such clauses.
+// IntrinsicGas calculate intrinsic gas cost for tx with such clauses, as consensus does.
+// It's TxGas, plus ClauseGas (or ClauseGasContractCreation) and data gas for each clause.
+// Data gas is 4 per zero byte and 68 per non-zero byte. Tx without clauses costs as one clause.
 func IntrinsicGas(clauses ...*Clause) (uint64, error) {
 	if len(clauses) == 0 {
 		return thor.TxGas + thor.ClauseGas, nil
@@ -7,6 +7,7 @@ package tx_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -127,6 +128,11 @@ func TestIntrinsicGas(t *testing.T) {
 	gas, err = tx.IntrinsicGas(tx.NewClause(&thor.Address{}), tx.NewClause(&thor.Address{}))
 	assert.Nil(t, err)
 	assert.Equal(t, thor.TxGas+thor.ClauseGas*2, gas)
+
+	// 2 zero bytes and 3 non-zero bytes
+	gas, err = tx.IntrinsicGas(tx.NewClause(&thor.Address{}).WithData([]byte{0, 1, 0, 2, 3}))
+	assert.Nil(t, err)
+	assert.Equal(t, thor.TxGas+thor.ClauseGas+4*2+68*3, gas)
 }
 
 func BenchmarkTxMining(b *testing.B) {
@@ -141,3 +147,77 @@ func BenchmarkTxMining(b *testing.B) {
 		}
 	}
 }
+
+func TestTxJSON(t *testing.T) {
+	to, _ := thor.ParseAddress("0x7567d83b7b8d80addcb281a71d54fc7b3364ffed")
+	dep := thor.Bytes32{1}
+	trx := new(tx.Builder).ChainTag(1).
+		BlockRef(tx.BlockRef{0, 0, 0, 0, 0xaa, 0xbb, 0xcc, 0xdd}).
+		Expiration(32).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(10000)).WithData([]byte{0x60})).
+		Clause(tx.NewClause(nil)).
+		GasPriceCoef(128).
+		Gas(21000).
+		DependsOn(&dep).
+		Nonce(12345678).Build()
+	key, _ := crypto.GenerateKey()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+	trx = trx.WithSignature(sig)
+
+	data, err := json.Marshal(trx)
+	assert.Nil(t, err)
+
+	var obj map[string]interface{}
+	assert.Nil(t, json.Unmarshal(data, &obj))
+	assert.Equal(t, trx.ID().String(), obj["id"])
+	assert.Equal(t, "0x1", obj["chainTag"])
+	assert.Equal(t, "0x00000000aabbccdd", obj["blockRef"])
+	assert.Equal(t, "0x5208", obj["gas"])
+
+	var decoded tx.Transaction
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, trx.ID(), decoded.ID())
+	enc1, _ := rlp.EncodeToBytes(trx)
+	enc2, _ := rlp.EncodeToBytes(&decoded)
+	assert.Equal(t, enc1, enc2)
+
+	obj["nonce"] = "0x1"
+	data, _ = json.Marshal(obj)
+	assert.EqualError(t, json.Unmarshal(data, &decoded), "id mismatch")
+}
+
+func TestTxProto(t *testing.T) {
+	to, _ := thor.ParseAddress("0x7567d83b7b8d80addcb281a71d54fc7b3364ffed")
+	dep := thor.Bytes32{1}
+	trx := new(tx.Builder).ChainTag(1).
+		BlockRef(tx.BlockRef{0, 0, 0, 0, 0xaa, 0xbb, 0xcc, 0xdd}).
+		Expiration(32).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(10000)).WithData([]byte{0x60})).
+		Clause(tx.NewClause(nil)).
+		GasPriceCoef(128).
+		Gas(21000).
+		DependsOn(&dep).
+		Nonce(12345678).Build()
+	key, _ := crypto.GenerateKey()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+	trx = trx.WithSignature(sig)
+
+	data, err := trx.MarshalProto()
+	assert.Nil(t, err)
+
+	var decoded tx.Transaction
+	assert.Nil(t, decoded.UnmarshalProto(data))
+	assert.Equal(t, trx.ID(), decoded.ID())
+	enc1, _ := rlp.EncodeToBytes(trx)
+	enc2, _ := rlp.EncodeToBytes(&decoded)
+	assert.Equal(t, enc1, enc2)
+
+	assert.NotNil(t, decoded.UnmarshalProto(data[:len(data)-1]))
+
+	typed := new(tx.Builder).Type(tx.TypeForTest).ChainTag(1).Nonce(1).Build()
+	data, err = typed.MarshalProto()
+	assert.Nil(t, err)
+	assert.Nil(t, decoded.UnmarshalProto(data))
+	assert.Equal(t, tx.TypeForTest, decoded.Type())
+	assert.Equal(t, typed.ID(), decoded.ID())
+}
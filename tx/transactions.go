@@ -7,6 +7,7 @@ package tx
 
 import (
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/trie"
 )
@@ -27,6 +28,30 @@ func (txs Transactions) RootHash() thor.Bytes32 {
 	return trie.DeriveRoot(derivableTxs(txs))
 }
 
+// Signers recovers origins of all txs in parallel.
+// The error of the first tx failed to recover is returned.
+func (txs Transactions) Signers() ([]thor.Address, error) {
+	var (
+		signers = make([]thor.Address, len(txs))
+		errs    = make([]error, len(txs))
+	)
+	<-co.Parallel(func(queue chan<- func()) {
+		for i, tx := range txs {
+			i, tx := i, tx
+			queue <- func() {
+				signers[i], errs[i] = tx.Origin()
+			}
+		}
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return signers, nil
+}
+
 // implements types.DerivableList
 type derivableTxs Transactions
 
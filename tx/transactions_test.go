@@ -0,0 +1,45 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestTransactionsSigners(t *testing.T) {
+	var (
+		txs  tx.Transactions
+		want []thor.Address
+	)
+	for i := 0; i < 10; i++ {
+		key, _ := crypto.GenerateKey()
+		trx := new(tx.Builder).ChainTag(1).Nonce(uint64(i)).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), key)
+		txs = append(txs, trx.WithSignature(sig))
+		want = append(want, thor.Address(crypto.PubkeyToAddress(key.PublicKey)))
+	}
+
+	signers, err := txs.Signers()
+	assert.Nil(t, err)
+	assert.Equal(t, want, signers)
+
+	// signer of a fresh decoded instance comes from cache
+	origin, err := txs[3].WithSignature(txs[3].Signature()).Origin()
+	assert.Nil(t, err)
+	assert.Equal(t, want[3], origin)
+
+	signers, err = tx.Transactions{}.Signers()
+	assert.Nil(t, err)
+	assert.Empty(t, signers)
+
+	_, err = append(txs, new(tx.Builder).Build()).Signers()
+	assert.NotNil(t, err)
+}
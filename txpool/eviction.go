@@ -0,0 +1,70 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// EvictionCandidate describes a pending tx which may be evicted when the pool is over limit.
+type EvictionCandidate struct {
+	Tx              *tx.Transaction
+	Origin          thor.Address
+	Executable      bool
+	OverallGasPrice *big.Int // nil if not executable
+	TimeAdded       time.Time
+
+	obj *txObject
+}
+
+// EvictionStrategy decides which txs to be evicted when the pool is over limit.
+type EvictionStrategy interface {
+	// Sort sorts candidates by retention priority from high to low.
+	// Candidates at the tail are evicted first.
+	Sort(candidates []*EvictionCandidate)
+}
+
+// EvictionStrategyFunc adapts a function to EvictionStrategy.
+type EvictionStrategyFunc func(candidates []*EvictionCandidate)
+
+// Sort implements EvictionStrategy.
+func (f EvictionStrategyFunc) Sort(candidates []*EvictionCandidate) {
+	f(candidates)
+}
+
+// PriceEvictionStrategy keeps executable txs with higher overall gas price,
+// and evicts non-executable txs ahead of any executable one.
+var PriceEvictionStrategy EvictionStrategy = EvictionStrategyFunc(func(candidates []*EvictionCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if ci.Executable != cj.Executable {
+			return ci.Executable
+		}
+		if !ci.Executable {
+			return false
+		}
+		return ci.OverallGasPrice.Cmp(cj.OverallGasPrice) > 0
+	})
+})
+
+func newEvictionCandidate(txObj *txObject, executable bool) *EvictionCandidate {
+	c := &EvictionCandidate{
+		Tx:         txObj.Transaction,
+		Origin:     txObj.Origin(),
+		Executable: executable,
+		TimeAdded:  time.Unix(0, txObj.timeAdded),
+		obj:        txObj,
+	}
+	if c.Executable {
+		c.OverallGasPrice = txObj.overallGasPrice
+	}
+	return c
+}
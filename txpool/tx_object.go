@@ -8,6 +8,7 @@ package txpool
 import (
 	"math/big"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,6 +18,7 @@ import (
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/xenv"
 )
 
 type txObject struct {
@@ -27,6 +29,7 @@ type txObject struct {
 	executable      bool
 	overallGasPrice *big.Int // don't touch this value, it's only be used in pool's housekeeping
 	localSubmitted  bool     // tx is submitted locally on this node, or synced remotely from p2p.
+	estimatedGas    uint64   // gas used by a trial execution, 0 if not estimated. accessed atomically
 }
 
 func resolveTx(tx *tx.Transaction, localSubmitted bool) (*txObject, error) {
@@ -47,13 +50,14 @@ func (o *txObject) Origin() thor.Address {
 	return o.resolved.Origin
 }
 
-func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock *block.Header) (bool, error) {
+// Executable returns whether the tx is executable in the block next to the head block, whose interval is blockInterval.
+func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock *block.Header, blockInterval uint64) (bool, error) {
 	switch {
 	case o.Gas() > headBlock.GasLimit():
 		return false, errors.New("gas too large")
 	case o.IsExpired(headBlock.Number()):
 		return false, errors.New("expired")
-	case o.BlockRef().Number() > headBlock.Number()+uint32(5*60/thor.BlockInterval):
+	case o.BlockRef().Number() > headBlock.Number()+uint32(5*60/blockInterval):
 		// reject deferred tx which will be applied after 5mins
 		return false, errors.New("block ref out of schedule")
 	}
@@ -86,15 +90,45 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 	checkpoint := state.NewCheckpoint()
 	defer state.RevertTo(checkpoint)
 
-	if _, _, _, _, err := o.resolved.BuyGas(state, headBlock.Timestamp()+thor.BlockInterval); err != nil {
+	if _, _, _, _, err := o.resolved.BuyGas(state, headBlock.Timestamp()+blockInterval); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
+// EstimatedGas returns gas used by the trial execution, or 0 if not estimated.
+func (o *txObject) EstimatedGas() uint64 {
+	return atomic.LoadUint64(&o.estimatedGas)
+}
+
+// estimateGas executes the tx on the state as if in the block next to the head block, and
+// records the gas used. The state is reverted after execution.
+func (o *txObject) estimateGas(chain *chain.Chain, state *state.State, headBlock *block.Header, blockInterval uint64, forkConfig thor.ForkConfig) error {
+	checkpoint := state.NewCheckpoint()
+	defer state.RevertTo(checkpoint)
+
+	rt := runtime.New(chain, state, &xenv.BlockContext{
+		Number:     headBlock.Number() + 1,
+		Time:       headBlock.Timestamp() + blockInterval,
+		GasLimit:   headBlock.GasLimit(),
+		TotalScore: headBlock.TotalScore() + 1,
+	}, forkConfig)
+	receipt, err := rt.ExecuteTransaction(o.Transaction)
+	if err != nil {
+		return err
+	}
+	atomic.StoreUint64(&o.estimatedGas, receipt.GasUsed)
+	return nil
+}
+
+// sortTxObjsByOverallGasPriceDesc sorts tx objects by overall gas price from high to low, then by arrival.
+// It's consistent with tx.Transactions.SortByPriority.
 func sortTxObjsByOverallGasPriceDesc(txObjs []*txObject) {
 	sort.Slice(txObjs, func(i, j int) bool {
 		gp1, gp2 := txObjs[i].overallGasPrice, txObjs[j].overallGasPrice
-		return gp1.Cmp(gp2) >= 0
+		if c := gp1.Cmp(gp2); c != 0 {
+			return c > 0
+		}
+		return txObjs[i].timeAdded < txObjs[j].timeAdded
 	})
 }
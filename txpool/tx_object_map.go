@@ -18,13 +18,27 @@ type txObjectMap struct {
 	lock      sync.RWMutex
 	mapByHash map[thor.Bytes32]*txObject
 	mapByID   map[thor.Bytes32]*txObject
+	mapByKey  map[replaceKey]*txObject
 	quota     map[thor.Address]int
+	size      int
+}
+
+// replaceKey identifies txs which are considered replaceable by each other.
+type replaceKey struct {
+	origin   thor.Address
+	nonce    uint64
+	blockRef tx.BlockRef
+}
+
+func replaceKeyOf(txObj *txObject) replaceKey {
+	return replaceKey{txObj.Origin(), txObj.Nonce(), txObj.BlockRef()}
 }
 
 func newTxObjectMap() *txObjectMap {
 	return &txObjectMap{
 		mapByHash: make(map[thor.Bytes32]*txObject),
 		mapByID:   make(map[thor.Bytes32]*txObject),
+		mapByKey:  make(map[replaceKey]*txObject),
 		quota:     make(map[thor.Address]int),
 	}
 }
@@ -36,7 +50,10 @@ func (m *txObjectMap) ContainsHash(txHash thor.Bytes32) bool {
 	return found
 }
 
-func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int) error {
+// Add adds the tx object into the map.
+// If there is a tx object with the same origin, nonce and block ref, the new one replaces it
+// only if its gas price coef is higher by at least priceBump percent.
+func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int, priceBump int) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -45,13 +62,23 @@ func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int) error {
 		return nil
 	}
 
+	key := replaceKeyOf(txObj)
+	if existing, found := m.mapByKey[key]; found {
+		if !isPriceBumped(existing.GasPriceCoef(), txObj.GasPriceCoef(), priceBump) {
+			return errors.New("replacement tx underpriced")
+		}
+		m.removeByHash(existing.Hash())
+	}
+
 	if m.quota[txObj.Origin()] >= limitPerAccount {
 		return errors.New("account quota exceeded")
 	}
 
 	m.quota[txObj.Origin()]++
+	m.size += int(txObj.Size())
 	m.mapByHash[hash] = txObj
 	m.mapByID[txObj.ID()] = txObj
+	m.mapByKey[key] = txObj
 	return nil
 }
 
@@ -64,15 +91,22 @@ func (m *txObjectMap) GetByID(id thor.Bytes32) *txObject {
 func (m *txObjectMap) RemoveByHash(txHash thor.Bytes32) bool {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+	return m.removeByHash(txHash)
+}
 
+func (m *txObjectMap) removeByHash(txHash thor.Bytes32) bool {
 	if txObj, ok := m.mapByHash[txHash]; ok {
 		if m.quota[txObj.Origin()] > 1 {
 			m.quota[txObj.Origin()]--
 		} else {
 			delete(m.quota, txObj.Origin())
 		}
+		m.size -= int(txObj.Size())
 		delete(m.mapByHash, txHash)
 		delete(m.mapByID, txObj.ID())
+		if key := replaceKeyOf(txObj); m.mapByKey[key] == txObj {
+			delete(m.mapByKey, key)
+		}
 		return true
 	}
 	return false
@@ -110,8 +144,12 @@ func (m *txObjectMap) Fill(txObjs []*txObject) {
 		// skip account limit check
 
 		m.quota[txObj.Origin()]++
+		m.size += int(txObj.Size())
 		m.mapByHash[txObj.Hash()] = txObj
 		m.mapByID[txObj.ID()] = txObj
+		if key := replaceKeyOf(txObj); m.mapByKey[key] == nil {
+			m.mapByKey[key] = txObj
+		}
 	}
 }
 
@@ -121,3 +159,19 @@ func (m *txObjectMap) Len() int {
 
 	return len(m.mapByHash)
 }
+
+// Size returns total size of txs in bytes.
+func (m *txObjectMap) Size() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.size
+}
+
+// isPriceBumped returns whether newCoef is higher than oldCoef by at least bump percent.
+func isPriceBumped(oldCoef, newCoef uint8, bump int) bool {
+	if newCoef <= oldCoef {
+		return false
+	}
+	return int(newCoef)*100 >= int(oldCoef)*(100+bump)
+}
@@ -30,14 +30,14 @@ func TestTxObjMap(t *testing.T) {
 	m := newTxObjectMap()
 	assert.Zero(t, m.Len())
 
-	assert.Nil(t, m.Add(txObj1, 1))
-	assert.Nil(t, m.Add(txObj1, 1), "should no error if exists")
+	assert.Nil(t, m.Add(txObj1, 1, 0))
+	assert.Nil(t, m.Add(txObj1, 1, 0), "should no error if exists")
 	assert.Equal(t, 1, m.Len())
 
-	assert.Equal(t, errors.New("account quota exceeded"), m.Add(txObj2, 1))
+	assert.Equal(t, errors.New("account quota exceeded"), m.Add(txObj2, 1, 0))
 	assert.Equal(t, 1, m.Len())
 
-	assert.Nil(t, m.Add(txObj3, 1))
+	assert.Nil(t, m.Add(txObj3, 1, 0))
 	assert.Equal(t, 2, m.Len())
 
 	assert.True(t, m.ContainsHash(tx1.Hash()))
@@ -52,3 +52,43 @@ func TestTxObjMap(t *testing.T) {
 	assert.Equal(t, tx.Transactions{tx3}, m.ToTxs())
 
 }
+
+func TestTxObjMapReplace(t *testing.T) {
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	acc := genesis.DevAccounts()[0]
+
+	newTxWithCoef := func(coef uint8) *tx.Transaction {
+		return signTx(new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Expiration(100).
+			GasPriceCoef(coef).
+			Gas(21000).
+			Nonce(1).
+			Build(), acc)
+	}
+
+	txObj1, _ := resolveTx(newTxWithCoef(100), false)
+	txObj2, _ := resolveTx(newTxWithCoef(105), false)
+	txObj3, _ := resolveTx(newTxWithCoef(110), false)
+
+	m := newTxObjectMap()
+	assert.Nil(t, m.Add(txObj1, 1, 10))
+	assert.Equal(t, errors.New("replacement tx underpriced"), m.Add(txObj2, 1, 10))
+	assert.True(t, m.ContainsHash(txObj1.Hash()))
+
+	assert.Nil(t, m.Add(txObj3, 1, 10), "replacement should not count in quota")
+	assert.Equal(t, []*txObject{txObj3}, m.ToTxObjects())
+	assert.Nil(t, m.GetByID(txObj1.ID()))
+
+	assert.True(t, m.RemoveByHash(txObj3.Hash()))
+	assert.Nil(t, m.Add(txObj2, 1, 10))
+}
+
+func TestIsPriceBumped(t *testing.T) {
+	assert.False(t, isPriceBumped(100, 100, 0))
+	assert.True(t, isPriceBumped(100, 101, 0))
+	assert.False(t, isPriceBumped(100, 109, 10))
+	assert.True(t, isPriceBumped(100, 110, 10))
+	assert.True(t, isPriceBumped(0, 1, 10))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/poa"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -39,8 +40,14 @@ type Options struct {
 	Limit                  int
 	LimitPerAccount        int
 	MaxLifetime            time.Duration
+	PriceBump              int              // min gas price coef bump in percent to replace a pending tx
+	LimitBytes             int              // max total size of txs in bytes, 0 means unlimited
+	MaxExpiration          uint32           // max blocks a tx can remain valid beyond head, 0 means unlimited
+	EvictionStrategy       EvictionStrategy // defaults to PriceEvictionStrategy
 	BlocklistCacheFilePath string
 	BlocklistFetchURL      string
+	EstimateGas            bool            // estimates gas of executable txs by trial execution
+	ForkConfig             thor.ForkConfig // to get block interval and execute txs for gas estimation
 }
 
 // TxEvent will be posted when tx is added or status changed.
@@ -104,7 +111,12 @@ func (p *TxPool) housekeeping() {
 				headBlock = newHeadBlock
 				headBlockChanged = true
 			}
-			if !isChainSynced(uint64(time.Now().Unix()), headBlock.Timestamp()) {
+			interval, err := p.blockInterval(p.stater.NewState(headBlock.StateRoot()), headBlock)
+			if err != nil {
+				log.Warn("failed to get block interval", "err", err)
+				continue
+			}
+			if !isChainSynced(uint64(time.Now().Unix()), headBlock.Timestamp(), interval) {
 				// skip washing txs if not synced
 				continue
 			}
@@ -115,12 +127,13 @@ func (p *TxPool) housekeeping() {
 			// 3. new tx added while pool size is small
 			if headBlockChanged ||
 				poolLen > p.options.Limit ||
+				p.isOverLimitBytes(p.all.Size()) ||
 				(poolLen < 200 && atomic.LoadUint32(&p.addedAfterWash) > 0) {
 
 				atomic.StoreUint32(&p.addedAfterWash, 0)
 
 				startTime := mclock.Now()
-				executables, removed, err := p.wash(headBlock)
+				executables, removed, err := p.wash(headBlock, interval)
 				elapsed := mclock.Now() - startTime
 
 				ctx := []interface{}{
@@ -225,6 +238,9 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmi
 		return badTxError{"chain tag mismatch"}
 	case newTx.Size() > maxTxSize:
 		return txRejectedError{"size too large"}
+	case p.options.MaxExpiration > 0 &&
+		uint64(newTx.BlockRef().Number())+uint64(newTx.Expiration()) > uint64(headBlock.Number())+uint64(p.options.MaxExpiration):
+		return txRejectedError{"expiration too long"}
 	}
 
 	if err := newTx.TestFeatures(headBlock.TxsFeatures()); err != nil {
@@ -236,9 +252,14 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmi
 		return badTxError{err.Error()}
 	}
 
-	if isChainSynced(uint64(time.Now().Unix()), headBlock.Timestamp()) {
-		state := p.stater.NewState(headBlock.StateRoot())
-		executable, err := txObj.Executable(p.repo.NewChain(headBlock.ID()), state, headBlock)
+	state := p.stater.NewState(headBlock.StateRoot())
+	interval, err := p.blockInterval(state, headBlock)
+	if err != nil {
+		return err
+	}
+
+	if isChainSynced(uint64(time.Now().Unix()), headBlock.Timestamp(), interval) {
+		executable, err := txObj.Executable(p.repo.NewChain(headBlock.ID()), state, headBlock, interval)
 		if err != nil {
 			return txRejectedError{err.Error()}
 		}
@@ -247,7 +268,7 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmi
 			return txRejectedError{"tx is not executable"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
+		if err := p.all.Add(txObj, p.options.LimitPerAccount, p.options.PriceBump); err != nil {
 			return txRejectedError{err.Error()}
 		}
 
@@ -259,11 +280,11 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, localSubmi
 	} else {
 		// we skip steps that rely on head block when chain is not synced,
 		// but check the pool's limit
-		if p.all.Len() >= p.options.Limit {
+		if p.all.Len() >= p.options.Limit || p.isOverLimitBytes(p.all.Size()+int(newTx.Size())) {
 			return txRejectedError{"pool is full"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
+		if err := p.all.Add(txObj, p.options.LimitPerAccount, p.options.PriceBump); err != nil {
 			return txRejectedError{err.Error()}
 		}
 		log.Debug("tx added", "id", newTx.ID())
@@ -306,6 +327,23 @@ func (p *TxPool) Remove(txHash thor.Bytes32, txID thor.Bytes32) bool {
 	return false
 }
 
+// TimeAdded returns the time in unix nano when the tx entered the pool, or 0 if not in the pool.
+func (p *TxPool) TimeAdded(txID thor.Bytes32) int64 {
+	if txObj := p.all.GetByID(txID); txObj != nil {
+		return txObj.timeAdded
+	}
+	return 0
+}
+
+// EstimatedGas returns gas used by the trial execution of the tx, or 0 if not estimated.
+// Gas is estimated once the tx becomes executable, if enabled by options.
+func (p *TxPool) EstimatedGas(txID thor.Bytes32) uint64 {
+	if txObj := p.all.GetByID(txID); txObj != nil {
+		return txObj.EstimatedGas()
+	}
+	return 0
+}
+
 // Executables returns executable txs.
 func (p *TxPool) Executables() tx.Transactions {
 	if sorted := p.executables.Load(); sorted != nil {
@@ -337,7 +375,7 @@ func (p *TxPool) Dump() tx.Transactions {
 
 // wash to evict txs that are over limit, out of lifetime, out of energy, settled, expired or dep broken.
 // this method should only be called in housekeeping go routine
-func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, removed int, err error) {
+func (p *TxPool) wash(headBlock *block.Header, interval uint64) (executables tx.Transactions, removed int, err error) {
 	all := p.all.ToTxObjects()
 	var toRemove []*txObject
 	defer func() {
@@ -386,7 +424,7 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 			continue
 		}
 		// settled, out of energy or dep broken
-		executable, err := txObj.Executable(chain, state, headBlock)
+		executable, err := txObj.Executable(chain, state, headBlock, interval)
 		if err != nil {
 			toRemove = append(toRemove, txObj)
 			log.Debug("tx washed out", "id", txObj.ID(), "err", err)
@@ -401,6 +439,11 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 				continue
 			}
 			txObj.overallGasPrice = txObj.OverallGasPrice(baseGasPrice, provedWork)
+			if p.options.EstimateGas && txObj.EstimatedGas() == 0 {
+				if err := txObj.estimateGas(chain, state, headBlock, interval, p.options.ForkConfig); err != nil {
+					log.Debug("failed to estimate gas", "id", txObj.ID(), "err", err)
+				}
+			}
 			if txObj.localSubmitted {
 				localExecutableObjs = append(localExecutableObjs, txObj)
 			} else {
@@ -415,27 +458,39 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 		}
 	}
 
-	// sort objs by price from high to low.
-	sortTxObjsByOverallGasPriceDesc(executableObjs)
+	// remove over limit txs, in order decided by the eviction strategy
+	candidates := make([]*EvictionCandidate, 0, len(executableObjs)+len(nonExecutableObjs))
+	for _, txObj := range executableObjs {
+		candidates = append(candidates, newEvictionCandidate(txObj, true))
+	}
+	for _, txObj := range nonExecutableObjs {
+		candidates = append(candidates, newEvictionCandidate(txObj, false))
+	}
+	p.evictionStrategy().Sort(candidates)
 
-	limit := p.options.Limit
+	var (
+		limit      = p.options.Limit
+		limitBytes = p.options.LimitBytes
+		size       = 0
+	)
+	// local txs are never evicted, but they occupy the space
+	for _, txObj := range localExecutableObjs {
+		size += int(txObj.Size())
+	}
+	for _, txObj := range localNonExecutableObjs {
+		size += int(txObj.Size())
+	}
 
-	// remove over limit txs, from non-executables to low priced
-	if len(executableObjs) > limit {
-		for _, txObj := range nonExecutableObjs {
-			toRemove = append(toRemove, txObj)
-			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
+	executableObjs = executableObjs[:0]
+	for i, c := range candidates {
+		if i >= limit || (limitBytes > 0 && size+int(c.Tx.Size()) > limitBytes) {
+			toRemove = append(toRemove, c.obj)
+			log.Debug("tx washed out due to pool limit", "id", c.Tx.ID(), "executable", c.Executable)
+			continue
 		}
-		for _, txObj := range executableObjs[limit:] {
-			toRemove = append(toRemove, txObj)
-			log.Debug("executable tx washed out due to pool limit", "id", txObj.ID())
-		}
-		executableObjs = executableObjs[:limit]
-	} else if len(executableObjs)+len(nonExecutableObjs) > limit {
-		// executableObjs + nonExecutableObjs over pool limit
-		for _, txObj := range nonExecutableObjs[limit-len(executableObjs):] {
-			toRemove = append(toRemove, txObj)
-			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
+		size += int(c.Tx.Size())
+		if c.Executable {
+			executableObjs = append(executableObjs, c.obj)
 		}
 	}
 
@@ -464,10 +519,26 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	return executables, 0, nil
 }
 
-func isChainSynced(nowTimestamp, blockTimestamp uint64) bool {
+func (p *TxPool) evictionStrategy() EvictionStrategy {
+	if p.options.EvictionStrategy != nil {
+		return p.options.EvictionStrategy
+	}
+	return PriceEvictionStrategy
+}
+
+func (p *TxPool) isOverLimitBytes(size int) bool {
+	return p.options.LimitBytes > 0 && size > p.options.LimitBytes
+}
+
+// blockInterval returns the interval of the block next to the head block, whose state is st.
+func (p *TxPool) blockInterval(st *state.State, headBlock *block.Header) (uint64, error) {
+	return poa.BlockIntervalOf(st, p.options.ForkConfig, headBlock.Number()+1)
+}
+
+func isChainSynced(nowTimestamp, blockTimestamp, blockInterval uint64) bool {
 	timeDiff := nowTimestamp - blockTimestamp
 	if blockTimestamp > nowTimestamp {
 		timeDiff = blockTimestamp - nowTimestamp
 	}
-	return timeDiff < thor.BlockInterval*6
+	return timeDiff < blockInterval*6
 }
@@ -72,7 +72,7 @@ func TestWashTxs(t *testing.T) {
 	pool := newPool(1, LIMIT_PER_ACCOUNT)
 	defer pool.Close()
 
-	txs, _, err := pool.wash(pool.repo.BestBlock().Header())
+	txs, _, err := pool.wash(pool.repo.BestBlock().Header(), thor.BlockInterval)
 	assert.Nil(t, err)
 	assert.Zero(t, len(txs))
 	assert.Zero(t, len(pool.Executables()))
@@ -80,7 +80,7 @@ func TestWashTxs(t *testing.T) {
 	tx1 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
 	assert.Nil(t, pool.AddLocal(tx1)) // this tx won't participate in the wash out.
 
-	txs, _, err = pool.wash(pool.repo.BestBlock().Header())
+	txs, _, err = pool.wash(pool.repo.BestBlock().Header(), thor.BlockInterval)
 	assert.Nil(t, err)
 	assert.Equal(t, Tx.Transactions{tx1}, txs)
 
@@ -93,19 +93,19 @@ func TestWashTxs(t *testing.T) {
 		Build()
 	pool.repo.AddBlock(b1, nil)
 
-	txs, _, err = pool.wash(pool.repo.BestBlock().Header())
+	txs, _, err = pool.wash(pool.repo.BestBlock().Header(), thor.BlockInterval)
 	assert.Nil(t, err)
 	assert.Equal(t, Tx.Transactions{tx1}, txs)
 
 	tx2 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[1])
 	txObj2, _ := resolveTx(tx2, false)
-	assert.Nil(t, pool.all.Add(txObj2, LIMIT_PER_ACCOUNT)) // this tx will participate in the wash out.
+	assert.Nil(t, pool.all.Add(txObj2, LIMIT_PER_ACCOUNT, 0)) // this tx will participate in the wash out.
 
 	tx3 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[2])
 	txObj3, _ := resolveTx(tx3, false)
-	assert.Nil(t, pool.all.Add(txObj3, LIMIT_PER_ACCOUNT)) // this tx will participate in the wash out.
+	assert.Nil(t, pool.all.Add(txObj3, LIMIT_PER_ACCOUNT, 0)) // this tx will participate in the wash out.
 
-	txs, removedCount, err := pool.wash(pool.repo.BestBlock().Header())
+	txs, removedCount, err := pool.wash(pool.repo.BestBlock().Header(), thor.BlockInterval)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(txs))
 	assert.Equal(t, 1, removedCount)
@@ -191,3 +191,67 @@ func TestBeforeVIP191Add(t *testing.T) {
 
 	assert.Equal(t, "tx rejected: unsupported features", err.Error())
 }
+
+func TestWashTxsOverLimitBytes(t *testing.T) {
+	pool := newPool(LIMIT, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+
+	tx1 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
+	tx2 := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[1])
+	pool.options.LimitBytes = int(tx1.Size()+tx2.Size()) - 1
+
+	var evicted int
+	pool.options.EvictionStrategy = EvictionStrategyFunc(func(candidates []*EvictionCandidate) {
+		// keep tx2
+		if len(candidates) == 2 && candidates[0].Tx != tx2 {
+			candidates[0], candidates[1] = candidates[1], candidates[0]
+		}
+		evicted = len(candidates) - 1
+	})
+
+	for _, trx := range []*tx.Transaction{tx1, tx2} {
+		txObj, _ := resolveTx(trx, false)
+		assert.Nil(t, pool.all.Add(txObj, LIMIT_PER_ACCOUNT, 0))
+	}
+	assert.Equal(t, int(tx1.Size()+tx2.Size()), pool.all.Size())
+
+	txs, removedCount, err := pool.wash(pool.repo.BestBlock().Header(), thor.BlockInterval)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, evicted)
+	assert.Equal(t, 1, removedCount)
+	assert.Equal(t, Tx.Transactions{tx2}, txs)
+	assert.Equal(t, int(tx2.Size()), pool.all.Size())
+}
+
+func TestMaxExpiration(t *testing.T) {
+	pool := newPool(LIMIT, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+	pool.options.MaxExpiration = 100
+
+	acc := genesis.DevAccounts()[0]
+	assert.Nil(t, pool.Add(newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc)))
+	assert.Equal(t, "tx rejected: expiration too long",
+		pool.Add(newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 101, nil, tx.Features(0), acc)).Error())
+}
+
+func TestEstimateGas(t *testing.T) {
+	pool := newPool(LIMIT, LIMIT_PER_ACCOUNT)
+	defer pool.Close()
+
+	trx := newTx(pool.repo.ChainTag(), nil, 100000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
+	txObj, _ := resolveTx(trx, false)
+	assert.Nil(t, pool.all.Add(txObj, LIMIT_PER_ACCOUNT, 0))
+
+	// disabled
+	_, _, err := pool.wash(pool.repo.BestBlock().Header(), thor.BlockInterval)
+	assert.Nil(t, err)
+	assert.Zero(t, pool.EstimatedGas(trx.ID()))
+
+	pool.options.EstimateGas = true
+	_, _, err = pool.wash(pool.repo.BestBlock().Header(), thor.BlockInterval)
+	assert.Nil(t, err)
+	intrinsicGas, _ := trx.IntrinsicGas()
+	assert.Equal(t, intrinsicGas, pool.EstimatedGas(trx.ID()))
+
+	assert.Zero(t, pool.EstimatedGas(thor.Bytes32{}))
+}
@@ -0,0 +1,365 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package typeddata implements hashing and signing of structured typed data, in the manner of EIP-712.
+// The domain is bound to the genesis ID instead of chain ID, so that signatures can't be replayed
+// across networks.
+package typeddata
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vechain/thor/thor"
+)
+
+const domainTypeName = "EIP712Domain"
+
+var (
+	arrayTypeRegexp = regexp.MustCompile(`^(.+)\[(\d*)\]$`)
+	intTypeRegexp   = regexp.MustCompile(`^u?int(\d*)$`)
+	bytesTypeRegexp = regexp.MustCompile(`^bytes(\d+)$`)
+)
+
+// Field is a named and typed member of struct type.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Types maps struct type names to their fields.
+type Types map[string][]Field
+
+// Domain is the domain of typed data, which separates signatures of different dApps and networks.
+type Domain struct {
+	Name              string        `json:"name"`
+	Version           string        `json:"version"`
+	GenesisID         thor.Bytes32  `json:"genesisId"`
+	VerifyingContract *thor.Address `json:"verifyingContract,omitempty"`
+}
+
+func (d *Domain) fields() []Field {
+	fields := []Field{
+		{"name", "string"},
+		{"version", "string"},
+		{"genesisId", "bytes32"},
+	}
+	if d.VerifyingContract != nil {
+		fields = append(fields, Field{"verifyingContract", "address"})
+	}
+	return fields
+}
+
+func (d *Domain) message() map[string]interface{} {
+	msg := map[string]interface{}{
+		"name":      d.Name,
+		"version":   d.Version,
+		"genesisId": d.GenesisID,
+	}
+	if d.VerifyingContract != nil {
+		msg["verifyingContract"] = *d.VerifyingContract
+	}
+	return msg
+}
+
+// Separator returns the domain separator.
+func (d *Domain) Separator() (thor.Bytes32, error) {
+	types := Types{domainTypeName: d.fields()}
+	return types.HashStruct(domainTypeName, d.message())
+}
+
+// TypedData is the structured data to be signed.
+type TypedData struct {
+	Types       Types                  `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      Domain                 `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+// SigningHash returns the hash to be signed, keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)).
+func (td *TypedData) SigningHash() (thor.Bytes32, error) {
+	separator, err := td.Domain.Separator()
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	msgHash, err := td.Types.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	return thor.Bytes32(crypto.Keccak256Hash([]byte{0x19, 0x01}, separator[:], msgHash[:])), nil
+}
+
+// Sign signs typed data with the private key.
+func Sign(td *TypedData, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	hash, err := td.SigningHash()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash[:], privateKey)
+}
+
+// Recover recovers the signer of typed data.
+func Recover(td *TypedData, sig []byte) (thor.Address, error) {
+	hash, err := td.SigningHash()
+	if err != nil {
+		return thor.Address{}, err
+	}
+	pub, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return thor.Address{}, err
+	}
+	return thor.Address(crypto.PubkeyToAddress(*pub)), nil
+}
+
+// Verify returns whether typed data is signed by the given signer.
+func Verify(td *TypedData, sig []byte, signer thor.Address) bool {
+	recovered, err := Recover(td, sig)
+	return err == nil && recovered == signer
+}
+
+// EncodeType returns the encoded type string, e.g. "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+func (types Types) EncodeType(primaryType string) (string, error) {
+	deps := make(map[string]bool)
+	if err := types.collectDeps(primaryType, deps); err != nil {
+		return "", err
+	}
+	delete(deps, primaryType)
+
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	for _, name := range append([]string{primaryType}, sorted...) {
+		b.WriteString(name)
+		b.WriteByte('(')
+		for i, field := range types[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(field.Type)
+			b.WriteByte(' ')
+			b.WriteString(field.Name)
+		}
+		b.WriteByte(')')
+	}
+	return b.String(), nil
+}
+
+func (types Types) collectDeps(typ string, deps map[string]bool) error {
+	if deps[typ] {
+		return nil
+	}
+	fields, ok := types[typ]
+	if !ok {
+		return fmt.Errorf("undefined type %q", typ)
+	}
+	deps[typ] = true
+	for _, field := range fields {
+		elemType := field.Type
+		for {
+			m := arrayTypeRegexp.FindStringSubmatch(elemType)
+			if m == nil {
+				break
+			}
+			elemType = m[1]
+		}
+		if _, isStruct := types[elemType]; isStruct {
+			if err := types.collectDeps(elemType, deps); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// TypeHash returns keccak256 of the encoded type.
+func (types Types) TypeHash(primaryType string) (thor.Bytes32, error) {
+	encoded, err := types.EncodeType(primaryType)
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	return thor.Bytes32(crypto.Keccak256Hash([]byte(encoded))), nil
+}
+
+// HashStruct returns keccak256(typeHash ‖ encodeData(data)).
+func (types Types) HashStruct(primaryType string, data map[string]interface{}) (thor.Bytes32, error) {
+	typeHash, err := types.TypeHash(primaryType)
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	var buf bytes.Buffer
+	buf.Write(typeHash[:])
+	for _, field := range types[primaryType] {
+		value, ok := data[field.Name]
+		if !ok {
+			return thor.Bytes32{}, fmt.Errorf("%v: missing field %q", primaryType, field.Name)
+		}
+		encoded, err := types.encodeValue(field.Type, value)
+		if err != nil {
+			return thor.Bytes32{}, fmt.Errorf("%v.%v: %v", primaryType, field.Name, err)
+		}
+		buf.Write(encoded)
+	}
+	return thor.Bytes32(crypto.Keccak256Hash(buf.Bytes())), nil
+}
+
+// encodeValue encodes value into 32 bytes.
+func (types Types) encodeValue(typ string, value interface{}) ([]byte, error) {
+	if _, isStruct := types[typ]; isStruct {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected struct %v", typ)
+		}
+		hash, err := types.HashStruct(typ, data)
+		if err != nil {
+			return nil, err
+		}
+		return hash[:], nil
+	}
+
+	if m := arrayTypeRegexp.FindStringSubmatch(typ); m != nil {
+		elems, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected array %v", typ)
+		}
+		if m[2] != "" {
+			if n, _ := strconv.Atoi(m[2]); n != len(elems) {
+				return nil, fmt.Errorf("expected %v elements, got %v", n, len(elems))
+			}
+		}
+		var buf bytes.Buffer
+		for _, elem := range elems {
+			encoded, err := types.encodeValue(m[1], elem)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		return crypto.Keccak256(buf.Bytes()), nil
+	}
+
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.New("expected string")
+		}
+		return crypto.Keccak256([]byte(s)), nil
+	case "bytes":
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256(b), nil
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, errors.New("expected bool")
+		}
+		if b {
+			return math.PaddedBigBytes(big.NewInt(1), 32), nil
+		}
+		return make([]byte, 32), nil
+	case "address":
+		var addr thor.Address
+		switch v := value.(type) {
+		case thor.Address:
+			addr = v
+		case string:
+			parsed, err := thor.ParseAddress(v)
+			if err != nil {
+				return nil, err
+			}
+			addr = parsed
+		default:
+			return nil, errors.New("expected address")
+		}
+		return thor.BytesToBytes32(addr[:]).Bytes(), nil
+	}
+
+	if m := bytesTypeRegexp.FindStringSubmatch(typ); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if n < 1 || n > 32 {
+			return nil, fmt.Errorf("invalid type %v", typ)
+		}
+		var b []byte
+		if v, ok := value.(thor.Bytes32); ok {
+			b = v[:]
+		} else {
+			var err error
+			if b, err = toBytes(value); err != nil {
+				return nil, err
+			}
+		}
+		if len(b) != n {
+			return nil, fmt.Errorf("expected %v bytes, got %v", n, len(b))
+		}
+		encoded := make([]byte, 32)
+		copy(encoded, b)
+		return encoded, nil
+	}
+
+	if m := intTypeRegexp.FindStringSubmatch(typ); m != nil {
+		x, err := toBig(value)
+		if err != nil {
+			return nil, err
+		}
+		if x.Sign() < 0 && strings.HasPrefix(typ, "u") {
+			return nil, errors.New("negative unsigned integer")
+		}
+		return math.PaddedBigBytes(math.U256(new(big.Int).Set(x)), 32), nil
+	}
+	return nil, fmt.Errorf("unsupported type %v", typ)
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return hexutil.Decode(v)
+	default:
+		return nil, errors.New("expected bytes")
+	}
+}
+
+func toBig(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case float64:
+		if v != float64(int64(v)) {
+			return nil, errors.New("expected integer")
+		}
+		return big.NewInt(int64(v)), nil
+	case string:
+		x, ok := math.ParseBig256(v)
+		if !ok {
+			return nil, errors.New("invalid integer")
+		}
+		return x, nil
+	default:
+		return nil, errors.New("expected integer")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package typeddata_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/typeddata"
+)
+
+// the example of EIP-712
+const mailJSON = `{
+	"types": {
+		"Person": [{"name": "name", "type": "string"}, {"name": "wallet", "type": "address"}],
+		"Mail": [{"name": "from", "type": "Person"}, {"name": "to", "type": "Person"}, {"name": "contents", "type": "string"}]
+	},
+	"primaryType": "Mail",
+	"domain": {"name": "Ether Mail", "version": "1"},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestHashStruct(t *testing.T) {
+	var td typeddata.TypedData
+	assert.Nil(t, json.Unmarshal([]byte(mailJSON), &td))
+
+	encoded, err := td.Types.EncodeType("Mail")
+	assert.Nil(t, err)
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encoded)
+
+	hash, err := td.Types.HashStruct("Mail", td.Message)
+	assert.Nil(t, err)
+	assert.Equal(t, "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hash.String())
+
+	_, err = td.Types.HashStruct("Mail", map[string]interface{}{"contents": "x"})
+	assert.NotNil(t, err)
+	_, err = td.Types.HashStruct("Unknown", td.Message)
+	assert.NotNil(t, err)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	var td typeddata.TypedData
+	assert.Nil(t, json.Unmarshal([]byte(mailJSON), &td))
+	td.Domain.GenesisID = thor.Bytes32{1}
+
+	key, _ := crypto.GenerateKey()
+	signer := thor.Address(crypto.PubkeyToAddress(key.PublicKey))
+
+	sig, err := typeddata.Sign(&td, key)
+	assert.Nil(t, err)
+	assert.True(t, typeddata.Verify(&td, sig, signer))
+
+	// not replayable on another network
+	td.Domain.GenesisID = thor.Bytes32{2}
+	assert.False(t, typeddata.Verify(&td, sig, signer))
+
+	// bound to verifying contract
+	td.Domain.GenesisID = thor.Bytes32{1}
+	contract := thor.BytesToAddress([]byte("contract"))
+	td.Domain.VerifyingContract = &contract
+	assert.False(t, typeddata.Verify(&td, sig, signer))
+}
+
+func TestEncodeValues(t *testing.T) {
+	types := typeddata.Types{
+		"Values": {
+			{"u", "uint256"},
+			{"i", "int8"},
+			{"b", "bool"},
+			{"b4", "bytes4"},
+			{"bs", "bytes"},
+			{"list", "uint32[2]"},
+		},
+	}
+	values := map[string]interface{}{
+		"u":    "0x10",
+		"i":    float64(-1),
+		"b":    true,
+		"b4":   "0x01020304",
+		"bs":   []byte{1},
+		"list": []interface{}{1, 2},
+	}
+	_, err := types.HashStruct("Values", values)
+	assert.Nil(t, err)
+
+	values["list"] = []interface{}{1}
+	_, err = types.HashStruct("Values", values)
+	assert.NotNil(t, err)
+
+	values["list"] = []interface{}{1, 2}
+	values["b4"] = "0x0102"
+	_, err = types.HashStruct("Values", values)
+	assert.NotNil(t, err)
+}